@@ -15,7 +15,9 @@ var (
 
 func main() {
 	if err := cli.Execute(version, commit, date); err != nil {
-		fmt.Fprintln(os.Stderr, err)
+		if !cli.IsReported(err) {
+			fmt.Fprintln(os.Stderr, err)
+		}
 		os.Exit(1)
 	}
 }
@@ -9,17 +9,20 @@ type DBCommand struct {
 	detector      interfaces.ProjectDetector
 	newRenderer   RendererFactory
 	flushRenderer RendererFlusher
+	newPrompter   PrompterFactory
 }
 
 func NewDBCommand(
 	detector interfaces.ProjectDetector,
 	newRenderer RendererFactory,
 	flushRenderer RendererFlusher,
+	newPrompter PrompterFactory,
 ) *DBCommand {
 	return &DBCommand{
 		detector:      detector,
 		newRenderer:   newRenderer,
 		flushRenderer: flushRenderer,
+		newPrompter:   newPrompter,
 	}
 }
 
@@ -57,7 +60,7 @@ This command must be run from within a Tracks project (containing .tracks.yaml).
 	statusCmd := NewDBStatusCommand(c.detector, c.newRenderer, c.flushRenderer)
 	cmd.AddCommand(statusCmd.Command())
 
-	resetCmd := NewDBResetCommand(c.detector, c.newRenderer, c.flushRenderer)
+	resetCmd := NewDBResetCommand(c.detector, c.newRenderer, c.flushRenderer, c.newPrompter)
 	cmd.AddCommand(resetCmd.Command())
 
 	return cmd
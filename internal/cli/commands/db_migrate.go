@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/anomalousventures/tracks/internal/cli/interfaces"
@@ -8,13 +9,55 @@ import (
 	"github.com/spf13/cobra"
 )
 
-// DatabaseManagerFactory creates a DatabaseManager for a given driver.
-type DatabaseManagerFactory func(driver string) interfaces.DatabaseManager
+// DatabaseManagerFactory creates a DatabaseManager for a given driver and
+// project env_prefix.
+type DatabaseManagerFactory func(driver, envPrefix string) interfaces.DatabaseManager
 
 // DefaultDatabaseManagerFactory returns the production database manager factory.
 func DefaultDatabaseManagerFactory() DatabaseManagerFactory {
-	return func(driver string) interfaces.DatabaseManager {
-		return database.NewManager(driver)
+	return func(driver, envPrefix string) interfaces.DatabaseManager {
+		return database.NewManagerWithEnvPrefix(driver, envPrefix)
+	}
+}
+
+// errDatabaseURLNotSet returns the error shown when no database URL is found
+// for the project's env_prefix.
+func errDatabaseURLNotSet(envPrefix string) error {
+	return withCode(CodeDatabaseURLNotSet, fmt.Errorf("%s is not set (set it in .env or environment variables)", database.DatabaseURLEnvKey(envPrefix)))
+}
+
+// checkDatabaseURL returns the error shown when the resolved database URL
+// cannot be migrated: it is not set, or it is the sqlite3 driver default, an
+// in-memory database that would be thrown away when the command exits.
+func checkDatabaseURL(dbManager interfaces.DatabaseManager, envPrefix string) error {
+	dbURL := dbManager.GetDatabaseURL()
+	if dbURL == "" {
+		return errDatabaseURLNotSet(envPrefix)
+	}
+	if dbManager.GetDatabaseURLSource() == database.URLSourceDefault && database.IsInMemoryURL(dbURL) {
+		return withCode(CodeDatabaseURLNotSet, fmt.Errorf("%s is not set and the driver default is an in-memory database that would be discarded (set it in .env or environment variables)", database.DatabaseURLEnvKey(envPrefix)))
+	}
+	return nil
+}
+
+// urlSourceSection shows where the database URL of a migration command was
+// resolved from, so a driver default is never used unnoticed.
+func urlSourceSection(dbManager interfaces.DatabaseManager, envPrefix string) interfaces.Section {
+	return interfaces.Section{Body: "Database URL: " + describeURLSource(dbManager.GetDatabaseURLSource(), envPrefix)}
+}
+
+// describeURLSource formats a DatabaseManager URL source for display.
+func describeURLSource(source, envPrefix string) string {
+	key := database.DatabaseURLEnvKey(envPrefix)
+	switch source {
+	case database.URLSourceEnv:
+		return "environment variable " + key
+	case database.URLSourceDotEnv:
+		return key + " in .env"
+	case database.URLSourceDefault:
+		return "driver default (" + key + " not set)"
+	default:
+		return "not set"
 	}
 }
 
@@ -60,10 +103,7 @@ func (c *DBMigrateCommand) Command() *cobra.Command {
 		Long: `Run pending database migrations for your Tracks project.
 
 Applies all pending migrations in order, or a specific number with --steps.
-Use --dry-run to preview which migrations would be applied.
-
-Note: This command only supports Postgres projects directly.
-For SQLite/go-libsql projects, use: make migrate-up`,
+Use --dry-run to preview which migrations would be applied.`,
 		Example: `  # Run all pending migrations
   tracks db migrate
 
@@ -90,36 +130,31 @@ func (c *DBMigrateCommand) runE(cmd *cobra.Command, _ []string) error {
 	// Detect project
 	project, projectDir, err := c.detector.Detect(ctx, ".")
 	if err != nil {
-		return fmt.Errorf("not in a Tracks project directory (missing .tracks.yaml): %w", err)
-	}
-
-	// Check for supported driver
-	if project.DBDriver != "postgres" {
-		return fmt.Errorf("tracks db migrate only supports Postgres projects (found: %s). For SQLite/go-libsql projects, use: make migrate-up", project.DBDriver)
+		return errNotInProject(err)
 	}
 
 	// Create database manager
-	dbManager := c.newDBManager(project.DBDriver)
+	dbManager := c.newDBManager(project.DBDriver, project.EnvPrefix)
 	if err := dbManager.LoadEnv(ctx, projectDir); err != nil {
-		return fmt.Errorf("failed to load environment: %w", err)
+		return withCode(CodeConfig, fmt.Errorf("failed to load environment: %w", err))
 	}
 
-	// Check for DATABASE_URL
-	if dbManager.GetDatabaseURL() == "" {
-		return fmt.Errorf("DATABASE_URL is not set (set it in .env or environment variables)")
+	// Check for database URL
+	if err := checkDatabaseURL(dbManager, project.EnvPrefix); err != nil {
+		return err
 	}
 
 	// Get migrations directory
 	migrationsDir := database.GetMigrationsDir(projectDir, project.DBDriver)
 
 	if dryRun {
-		return c.dryRun(cmd, dbManager, migrationsDir)
+		return c.dryRun(cmd, dbManager, migrationsDir, project.EnvPrefix)
 	}
 
-	return c.migrate(cmd, dbManager, migrationsDir, steps)
+	return c.migrate(cmd, dbManager, migrationsDir, project.EnvPrefix, steps)
 }
 
-func (c *DBMigrateCommand) dryRun(cmd *cobra.Command, dbManager interfaces.DatabaseManager, migrationsDir string) error {
+func (c *DBMigrateCommand) dryRun(cmd *cobra.Command, dbManager interfaces.DatabaseManager, migrationsDir, envPrefix string) error {
 	r := c.newRenderer(cmd)
 	ctx := cmd.Context()
 	defer c.flushRenderer(cmd, r)
@@ -127,36 +162,31 @@ func (c *DBMigrateCommand) dryRun(cmd *cobra.Command, dbManager interfaces.Datab
 	// Connect to database
 	db, err := dbManager.Connect(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to connect to database: %w", err)
+		return withCode(CodeDatabaseConnection, fmt.Errorf("failed to connect to database: %w", err))
 	}
 	defer dbManager.Close()
 
 	// Create migration runner
 	runner, err := database.NewMigrationRunner(db, dbManager.GetDriver(), migrationsDir)
 	if err != nil {
-		return fmt.Errorf("failed to initialize migrations: %w", err)
+		return withCode(CodeMigrationFailed, fmt.Errorf("failed to initialize migrations: %w", err))
 	}
 
-	// Get migration status
-	statuses, err := runner.Status(ctx)
+	pending, err := pendingMigrations(ctx, runner, 0)
 	if err != nil {
-		return fmt.Errorf("failed to get migration status: %w", err)
+		return err
 	}
 
-	// Count pending
-	var pending []database.MigrationStatus
-	for _, s := range statuses {
-		if !s.Applied {
-			pending = append(pending, s)
-		}
-	}
+	r.Result(DBMigrateResult{DryRun: true, Migrations: migrations(pending)})
 
 	if len(pending) == 0 {
 		r.Title("No pending migrations")
+		r.Section(urlSourceSection(dbManager, envPrefix))
 		return nil
 	}
 
 	r.Title("Dry run - migrations that would be applied:")
+	r.Section(urlSourceSection(dbManager, envPrefix))
 	var body string
 	for _, m := range pending {
 		body += fmt.Sprintf("  • %s\n", m.Name)
@@ -167,7 +197,7 @@ func (c *DBMigrateCommand) dryRun(cmd *cobra.Command, dbManager interfaces.Datab
 	return nil
 }
 
-func (c *DBMigrateCommand) migrate(cmd *cobra.Command, dbManager interfaces.DatabaseManager, migrationsDir string, steps int) error {
+func (c *DBMigrateCommand) migrate(cmd *cobra.Command, dbManager interfaces.DatabaseManager, migrationsDir, envPrefix string, steps int) error {
 	r := c.newRenderer(cmd)
 	ctx := cmd.Context()
 	defer c.flushRenderer(cmd, r)
@@ -175,35 +205,76 @@ func (c *DBMigrateCommand) migrate(cmd *cobra.Command, dbManager interfaces.Data
 	// Connect to database
 	db, err := dbManager.Connect(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to connect to database: %w", err)
+		return withCode(CodeDatabaseConnection, fmt.Errorf("failed to connect to database: %w", err))
 	}
 	defer dbManager.Close()
 
 	// Create migration runner
 	runner, err := database.NewMigrationRunner(db, dbManager.GetDriver(), migrationsDir)
 	if err != nil {
-		return fmt.Errorf("failed to initialize migrations: %w", err)
+		return withCode(CodeMigrationFailed, fmt.Errorf("failed to initialize migrations: %w", err))
 	}
 
 	r.Title("Running migrations...")
+	r.Section(urlSourceSection(dbManager, envPrefix))
 
-	// Run migrations
-	result, err := runner.Up(ctx, steps)
+	pending, err := pendingMigrations(ctx, runner, steps)
 	if err != nil {
-		return fmt.Errorf("migration failed: %w", err)
+		return err
 	}
 
-	if len(result.Applied) == 0 {
+	if len(pending) == 0 {
 		r.Section(interfaces.Section{Body: "No pending migrations"})
+		r.Result(DBMigrateResult{Migrations: migrations(nil)})
 		return nil
 	}
 
+	// Apply one migration at a time so progress can name each as it runs
+	progress := r.Progress(interfaces.ProgressSpec{
+		Label: "Applying migrations",
+		Total: int64(len(pending)),
+	})
+	defer progress.Done()
+
+	var applied []database.MigrationStatus
+	for _, m := range pending {
+		progress.Status(m.Name)
+		result, err := runner.Up(ctx, 1)
+		if err != nil {
+			return withCode(CodeMigrationFailed, fmt.Errorf("migration failed: %w", err))
+		}
+		applied = append(applied, result.Applied...)
+		progress.Increment(1)
+	}
+	progress.Done()
+
 	var body string
-	for _, m := range result.Applied {
+	for _, m := range applied {
 		body += fmt.Sprintf("  ✓ %s\n", m.Name)
 	}
-	body += fmt.Sprintf("\nSuccessfully applied %d migration(s).", len(result.Applied))
+	body += fmt.Sprintf("\nSuccessfully applied %d migration(s).", len(applied))
 	r.Section(interfaces.Section{Body: body})
+	r.Result(DBMigrateResult{Migrations: migrations(applied)})
 
 	return nil
 }
+
+// pendingMigrations returns the migrations Up would apply, in order: all
+// pending migrations, or at most steps of them when steps is positive.
+func pendingMigrations(ctx context.Context, runner *database.MigrationRunner, steps int) ([]database.MigrationStatus, error) {
+	statuses, err := runner.Status(ctx)
+	if err != nil {
+		return nil, withCode(CodeMigrationFailed, fmt.Errorf("failed to get migration status: %w", err))
+	}
+
+	var pending []database.MigrationStatus
+	for _, s := range statuses {
+		if !s.Applied {
+			pending = append(pending, s)
+		}
+	}
+	if steps > 0 && len(pending) > steps {
+		pending = pending[:steps]
+	}
+	return pending, nil
+}
@@ -2,7 +2,10 @@ package commands
 
 import (
 	"bytes"
+	"database/sql"
 	"errors"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -15,6 +18,7 @@ import (
 func setupDBMigrateTestCommand(t *testing.T) (*cobra.Command, *mocks.MockProjectDetector, *mocks.MockRenderer) {
 	mockDetector := mocks.NewMockProjectDetector(t)
 	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Result", mock.Anything).Return().Maybe()
 	mockRenderer.On("Title", mock.Anything).Return().Maybe()
 	mockRenderer.On("Section", mock.Anything).Return().Maybe()
 	mockRenderer.On("Flush").Return(nil).Maybe()
@@ -37,6 +41,7 @@ func setupDBMigrateTestCommand(t *testing.T) (*cobra.Command, *mocks.MockProject
 func TestNewDBMigrateCommand(t *testing.T) {
 	mockDetector := mocks.NewMockProjectDetector(t)
 	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Result", mock.Anything).Return().Maybe()
 
 	factory := func(*cobra.Command) interfaces.Renderer {
 		return mockRenderer
@@ -95,46 +100,30 @@ func TestDBMigrateCommand_NotInProject(t *testing.T) {
 	}
 }
 
-func TestDBMigrateCommand_UnsupportedDriver(t *testing.T) {
-	cobraCmd, mockDetector, _ := setupDBMigrateTestCommand(t)
-
-	mockDetector.On("Detect", mock.Anything, ".").
-		Return(&interfaces.TracksProject{
-			Name:       "testproject",
-			ModulePath: "example.com/testproject",
-			DBDriver:   "sqlite3",
-		}, "/tmp/testproject", nil)
-
-	err := cobraCmd.Execute()
-
-	if err == nil {
-		t.Fatal("expected error for unsupported driver")
-	}
-	if !strings.Contains(err.Error(), "only supports Postgres") {
-		t.Errorf("expected 'only supports Postgres' error, got: %v", err)
-	}
-	if !strings.Contains(err.Error(), "make migrate-up") {
-		t.Errorf("expected helpful suggestion for make migrate-up, got: %v", err)
-	}
-}
-
-func TestDBMigrateCommand_UnsupportedDriver_LibSQL(t *testing.T) {
-	cobraCmd, mockDetector, _ := setupDBMigrateTestCommand(t)
-
-	mockDetector.On("Detect", mock.Anything, ".").
-		Return(&interfaces.TracksProject{
-			Name:       "testproject",
-			ModulePath: "example.com/testproject",
-			DBDriver:   "go-libsql",
-		}, "/tmp/testproject", nil)
-
-	err := cobraCmd.Execute()
-
-	if err == nil {
-		t.Fatal("expected error for unsupported driver")
-	}
-	if !strings.Contains(err.Error(), "only supports Postgres") {
-		t.Errorf("expected 'only supports Postgres' error, got: %v", err)
+func TestDBMigrateCommand_SQLiteDrivers(t *testing.T) {
+	for _, driver := range []string{"sqlite3", "go-libsql"} {
+		t.Run(driver, func(t *testing.T) {
+			cobraCmd, mockDetector, mockDBManager, _ := setupDBMigrateWithMockedDB(t)
+
+			mockDetector.On("Detect", mock.Anything, ".").
+				Return(&interfaces.TracksProject{
+					Name:       "testproject",
+					ModulePath: "example.com/testproject",
+					DBDriver:   driver,
+				}, "/tmp/testproject", nil)
+
+			mockDBManager.On("LoadEnv", mock.Anything, "/tmp/testproject").
+				Return(errors.New("env file not found"))
+
+			err := cobraCmd.Execute()
+
+			if err == nil {
+				t.Fatal("expected error for LoadEnv failure")
+			}
+			if strings.Contains(err.Error(), "only supports Postgres") {
+				t.Errorf("expected %s to be supported, got: %v", driver, err)
+			}
+		})
 	}
 }
 
@@ -161,6 +150,7 @@ func setupDBMigrateWithMockedDB(t *testing.T) (*cobra.Command, *mocks.MockProjec
 	mockDetector := mocks.NewMockProjectDetector(t)
 	mockDBManager := mocks.NewMockDatabaseManager(t)
 	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Result", mock.Anything).Return().Maybe()
 	mockRenderer.On("Title", mock.Anything).Return().Maybe()
 	mockRenderer.On("Section", mock.Anything).Return().Maybe()
 	mockRenderer.On("Flush").Return(nil).Maybe()
@@ -171,7 +161,7 @@ func setupDBMigrateWithMockedDB(t *testing.T) (*cobra.Command, *mocks.MockProjec
 	flusher := func(*cobra.Command, interfaces.Renderer) {
 		mockRenderer.Flush()
 	}
-	dbFactory := func(_ string) interfaces.DatabaseManager {
+	dbFactory := func(_, _ string) interfaces.DatabaseManager {
 		return mockDBManager
 	}
 
@@ -229,6 +219,31 @@ func TestDBMigrateCommand_EmptyDatabaseURL(t *testing.T) {
 	}
 }
 
+func TestDBMigrateCommand_InMemoryDefault(t *testing.T) {
+	cobraCmd, mockDetector, mockDBManager, _ := setupDBMigrateWithMockedDB(t)
+
+	mockDetector.On("Detect", mock.Anything, ".").
+		Return(&interfaces.TracksProject{
+			Name:       "testproject",
+			ModulePath: "example.com/testproject",
+			DBDriver:   "sqlite3",
+			EnvPrefix:  "APP",
+		}, "/tmp/testproject", nil)
+
+	mockDBManager.On("LoadEnv", mock.Anything, "/tmp/testproject").Return(nil)
+	mockDBManager.On("GetDatabaseURL").Return(":memory:")
+	mockDBManager.On("GetDatabaseURLSource").Return("default")
+
+	err := cobraCmd.Execute()
+
+	if ErrorCode(err) != CodeDatabaseURLNotSet {
+		t.Fatalf("expected %s, got %v", CodeDatabaseURLNotSet, err)
+	}
+	if !strings.Contains(err.Error(), "APP_DATABASE_URL is not set") || !strings.Contains(err.Error(), "in-memory") {
+		t.Errorf("expected the in-memory default to be refused, got: %v", err)
+	}
+}
+
 func TestDBMigrateCommand_ConnectError(t *testing.T) {
 	cobraCmd, mockDetector, mockDBManager, _ := setupDBMigrateWithMockedDB(t)
 
@@ -241,6 +256,7 @@ func TestDBMigrateCommand_ConnectError(t *testing.T) {
 
 	mockDBManager.On("LoadEnv", mock.Anything, "/tmp/testproject").Return(nil)
 	mockDBManager.On("GetDatabaseURL").Return("postgres://localhost/test")
+	mockDBManager.On("GetDatabaseURLSource").Return("environment").Maybe()
 	mockDBManager.On("Connect", mock.Anything).Return(nil, errors.New("connection refused"))
 
 	err := cobraCmd.Execute()
@@ -266,6 +282,7 @@ func TestDBMigrateCommand_ConnectError_DryRun(t *testing.T) {
 
 	mockDBManager.On("LoadEnv", mock.Anything, "/tmp/testproject").Return(nil)
 	mockDBManager.On("GetDatabaseURL").Return("postgres://localhost/test")
+	mockDBManager.On("GetDatabaseURLSource").Return("environment").Maybe()
 	mockDBManager.On("Connect", mock.Anything).Return(nil, errors.New("connection refused"))
 
 	err := cobraCmd.Execute()
@@ -277,3 +294,109 @@ func TestDBMigrateCommand_ConnectError_DryRun(t *testing.T) {
 		t.Errorf("expected 'failed to connect to database' error, got: %v", err)
 	}
 }
+
+func TestDBMigrateCommand_UsesProjectEnvPrefix(t *testing.T) {
+	mockDetector := mocks.NewMockProjectDetector(t)
+	mockDBManager := mocks.NewMockDatabaseManager(t)
+	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Result", mock.Anything).Return().Maybe()
+	mockRenderer.On("Flush").Return(nil).Maybe()
+
+	factory := func(*cobra.Command) interfaces.Renderer {
+		return mockRenderer
+	}
+	flusher := func(*cobra.Command, interfaces.Renderer) {
+		mockRenderer.Flush()
+	}
+	var gotDriver, gotPrefix string
+	dbFactory := func(driver, envPrefix string) interfaces.DatabaseManager {
+		gotDriver, gotPrefix = driver, envPrefix
+		return mockDBManager
+	}
+
+	cobraCmd := NewDBMigrateCommandWithFactory(mockDetector, factory, flusher, dbFactory).Command()
+	cobraCmd.SetOut(new(bytes.Buffer))
+	cobraCmd.SetErr(new(bytes.Buffer))
+
+	mockDetector.On("Detect", mock.Anything, ".").
+		Return(&interfaces.TracksProject{
+			Name:       "testproject",
+			ModulePath: "example.com/testproject",
+			DBDriver:   "postgres",
+			EnvPrefix:  "MYAPP",
+		}, "/tmp/testproject", nil)
+
+	mockDBManager.On("LoadEnv", mock.Anything, "/tmp/testproject").Return(nil)
+	mockDBManager.On("GetDatabaseURL").Return("")
+
+	err := cobraCmd.Execute()
+
+	if gotDriver != "postgres" || gotPrefix != "MYAPP" {
+		t.Errorf("factory called with (%q, %q), want (\"postgres\", \"MYAPP\")", gotDriver, gotPrefix)
+	}
+	if err == nil {
+		t.Fatal("expected error for empty database URL")
+	}
+	if !strings.Contains(err.Error(), "MYAPP_DATABASE_URL is not set") {
+		t.Errorf("expected 'MYAPP_DATABASE_URL is not set' error, got: %v", err)
+	}
+}
+
+func TestDBMigrateCommand_Progress(t *testing.T) {
+	projectDir := t.TempDir()
+	migrationsDir := filepath.Join(projectDir, "internal", "db", "migrations", "sqlite")
+	if err := os.MkdirAll(migrationsDir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	migrations := map[string]string{
+		"00001_users.sql": "-- +goose Up\nCREATE TABLE users (id INTEGER PRIMARY KEY);\n-- +goose Down\nDROP TABLE users;\n",
+		"00002_posts.sql": "-- +goose Up\nCREATE TABLE posts (id INTEGER PRIMARY KEY);\n-- +goose Down\nDROP TABLE posts;\n",
+		"00003_tags.sql":  "-- +goose Up\nCREATE TABLE tags (id INTEGER PRIMARY KEY);\n-- +goose Down\nDROP TABLE tags;\n",
+	}
+	for name, body := range migrations {
+		if err := os.WriteFile(filepath.Join(migrationsDir, name), []byte(body), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	db, err := sql.Open("sqlite", filepath.Join(projectDir, "app.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	cobraCmd, mockDetector, mockDBManager, mockRenderer := setupDBMigrateWithMockedDB(t)
+	mockProgress := mocks.NewMockProgress(t)
+
+	mockDetector.On("Detect", mock.Anything, ".").
+		Return(&interfaces.TracksProject{
+			Name:       "testproject",
+			ModulePath: "example.com/testproject",
+			DBDriver:   "sqlite3",
+		}, projectDir, nil)
+	mockDBManager.On("LoadEnv", mock.Anything, projectDir).Return(nil)
+	mockDBManager.On("GetDatabaseURL").Return("file:app.db")
+	mockDBManager.On("GetDatabaseURLSource").Return("environment").Maybe()
+	mockDBManager.On("Connect", mock.Anything).Return(db, nil)
+	mockDBManager.On("GetDriver").Return("sqlite3")
+	mockDBManager.On("Close").Return(nil)
+
+	mockRenderer.On("Progress", interfaces.ProgressSpec{Label: "Applying migrations", Total: 2}).
+		Return(mockProgress).Once()
+	var statuses []string
+	mockProgress.On("Status", mock.Anything).Run(func(args mock.Arguments) {
+		statuses = append(statuses, args.String(0))
+	}).Return().Times(2)
+	mockProgress.On("Increment", int64(1)).Return().Times(2)
+	mockProgress.On("Done").Return()
+
+	cobraCmd.SetArgs([]string{"--steps", "2"})
+	if err := cobraCmd.Execute(); err != nil {
+		t.Fatalf("migrate failed: %v", err)
+	}
+
+	want := []string{"00001_users.sql", "00002_posts.sql"}
+	if strings.Join(statuses, ",") != strings.Join(want, ",") {
+		t.Errorf("progress statuses = %v, want %v", statuses, want)
+	}
+}
@@ -1,9 +1,8 @@
 package commands
 
 import (
-	"bufio"
+	"errors"
 	"fmt"
-	"strings"
 
 	"github.com/anomalousventures/tracks/internal/cli/interfaces"
 	"github.com/anomalousventures/tracks/internal/database"
@@ -14,6 +13,7 @@ type DBResetCommand struct {
 	detector      interfaces.ProjectDetector
 	newRenderer   RendererFactory
 	flushRenderer RendererFlusher
+	newPrompter   PrompterFactory
 	newDBManager  DatabaseManagerFactory
 }
 
@@ -21,11 +21,13 @@ func NewDBResetCommand(
 	detector interfaces.ProjectDetector,
 	newRenderer RendererFactory,
 	flushRenderer RendererFlusher,
+	newPrompter PrompterFactory,
 ) *DBResetCommand {
 	return &DBResetCommand{
 		detector:      detector,
 		newRenderer:   newRenderer,
 		flushRenderer: flushRenderer,
+		newPrompter:   newPrompter,
 		newDBManager:  DefaultDatabaseManagerFactory(),
 	}
 }
@@ -34,12 +36,14 @@ func NewDBResetCommandWithFactory(
 	detector interfaces.ProjectDetector,
 	newRenderer RendererFactory,
 	flushRenderer RendererFlusher,
+	newPrompter PrompterFactory,
 	newDBManager DatabaseManagerFactory,
 ) *DBResetCommand {
 	return &DBResetCommand{
 		detector:      detector,
 		newRenderer:   newRenderer,
 		flushRenderer: flushRenderer,
+		newPrompter:   newPrompter,
 		newDBManager:  newDBManager,
 	}
 }
@@ -52,15 +56,16 @@ func (c *DBResetCommand) Command() *cobra.Command {
 
 WARNING: This will delete all data in the database!
 
-Prompts for confirmation unless --force is specified.
-
-Note: This command only supports Postgres projects directly.
-For SQLite/go-libsql projects, use: make migrate-reset`,
+Prompts for confirmation unless --force or --yes is specified. Without a
+terminal, in CI or with JSON output, it fails instead of prompting.`,
 		Example: `  # Reset database (prompts for confirmation)
   tracks db reset
 
   # Reset database without confirmation
-  tracks db reset --force`,
+  tracks db reset --force
+
+  # Reset database from a script
+  tracks db reset --yes --json`,
 		RunE: c.runE,
 	}
 
@@ -79,55 +84,63 @@ func (c *DBResetCommand) runE(cmd *cobra.Command, _ []string) error {
 
 	project, projectDir, err := c.detector.Detect(ctx, ".")
 	if err != nil {
-		return fmt.Errorf("not in a Tracks project directory (missing .tracks.yaml): %w", err)
-	}
-
-	if project.DBDriver != "postgres" {
-		return fmt.Errorf("tracks db reset only supports Postgres projects (found: %s). For SQLite/go-libsql projects, use: make migrate-reset", project.DBDriver)
+		return errNotInProject(err)
 	}
 
-	dbManager := c.newDBManager(project.DBDriver)
+	dbManager := c.newDBManager(project.DBDriver, project.EnvPrefix)
 	if err := dbManager.LoadEnv(ctx, projectDir); err != nil {
-		return fmt.Errorf("failed to load environment: %w", err)
+		return withCode(CodeConfig, fmt.Errorf("failed to load environment: %w", err))
 	}
 
-	dbURL := dbManager.GetDatabaseURL()
-	if dbURL == "" {
-		return fmt.Errorf("DATABASE_URL is not set (set it in .env or environment variables)")
+	if err := checkDatabaseURL(dbManager, project.EnvPrefix); err != nil {
+		return err
 	}
+	dbURL := dbManager.GetDatabaseURL()
+
+	r := c.newRenderer(cmd)
+	defer c.flushRenderer(cmd, r)
 
 	if !force {
-		confirmed, err := c.promptForConfirmation(cmd, dbURL)
-		if err != nil {
+		confirmed, err := c.newPrompter(cmd).Confirm(ctx, interfaces.ConfirmPrompt{
+			Title: "Reset the database?",
+			Description: fmt.Sprintf(`This will delete all data in %s (from %s):
+  - Drop all tables
+  - Re-run all migrations
+  - Delete all existing data`, database.SanitizeURL(dbURL), describeURLSource(dbManager.GetDatabaseURLSource(), project.EnvPrefix)),
+		})
+		switch {
+		case errors.Is(err, interfaces.ErrPromptUnavailable):
+			return withCode(CodeConfirmationRequired, err)
+		case errors.Is(err, interfaces.ErrPromptCanceled):
+			confirmed = false
+		case err != nil:
 			return fmt.Errorf("failed to read confirmation: %w", err)
 		}
 		if !confirmed {
-			fmt.Fprintln(cmd.OutOrStdout(), "Reset cancelled.")
+			r.Section(interfaces.Section{Body: "Reset cancelled."})
 			return nil
 		}
 	}
 
-	r := c.newRenderer(cmd)
-	defer c.flushRenderer(cmd, r)
-
 	migrationsDir := database.GetMigrationsDir(projectDir, project.DBDriver)
 
 	db, err := dbManager.Connect(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to connect to database: %w", err)
+		return withCode(CodeDatabaseConnection, fmt.Errorf("failed to connect to database: %w", err))
 	}
 	defer dbManager.Close()
 
 	runner, err := database.NewMigrationRunner(db, dbManager.GetDriver(), migrationsDir)
 	if err != nil {
-		return fmt.Errorf("failed to initialize migrations: %w", err)
+		return withCode(CodeMigrationFailed, fmt.Errorf("failed to initialize migrations: %w", err))
 	}
 
 	r.Title("Resetting database...")
+	r.Section(urlSourceSection(dbManager, project.EnvPrefix))
 
 	result, err := runner.Reset(ctx)
 	if err != nil {
-		return fmt.Errorf("reset failed: %w", err)
+		return withCode(CodeMigrationFailed, fmt.Errorf("reset failed: %w", err))
 	}
 
 	var body string
@@ -141,31 +154,7 @@ func (c *DBResetCommand) runE(cmd *cobra.Command, _ []string) error {
 		body += fmt.Sprintf("\nReset complete. Applied %d migration(s).", len(result.Applied))
 	}
 	r.Section(interfaces.Section{Body: body})
+	r.Result(DBResetResult{Migrations: migrations(result.Applied)})
 
 	return nil
 }
-
-func (c *DBResetCommand) promptForConfirmation(cmd *cobra.Command, dbURL string) (bool, error) {
-	warning := `
-⚠️  WARNING: This will delete all data in the database!
-
-Database: %s
-
-This action will:
-  - Drop all tables
-  - Re-run all migrations
-  - Delete all existing data
-
-Are you sure? (y/N): `
-
-	fmt.Fprintf(cmd.OutOrStdout(), warning, database.SanitizeURL(dbURL))
-
-	reader := bufio.NewReader(cmd.InOrStdin())
-	response, err := reader.ReadString('\n')
-	if err != nil {
-		return false, err
-	}
-
-	response = strings.TrimSpace(strings.ToLower(response))
-	return response == "y" || response == "yes", nil
-}
@@ -49,10 +49,7 @@ func (c *DBRollbackCommand) Command() *cobra.Command {
 		Short: "Roll back database migrations",
 		Long: `Roll back database migrations for your Tracks project.
 
-Rolls back the last applied migration by default, or multiple with --steps.
-
-Note: This command only supports Postgres projects directly.
-For SQLite/go-libsql projects, use: make migrate-down`,
+Rolls back the last applied migration by default, or multiple with --steps.`,
 		Example: `  # Roll back the last migration
   tracks db rollback
 
@@ -76,29 +73,24 @@ func (c *DBRollbackCommand) runE(cmd *cobra.Command, _ []string) error {
 	// Detect project
 	project, projectDir, err := c.detector.Detect(ctx, ".")
 	if err != nil {
-		return fmt.Errorf("not in a Tracks project directory (missing .tracks.yaml): %w", err)
-	}
-
-	// Check for supported driver
-	if project.DBDriver != "postgres" {
-		return fmt.Errorf("tracks db rollback only supports Postgres projects (found: %s). For SQLite/go-libsql projects, use: make migrate-down", project.DBDriver)
+		return errNotInProject(err)
 	}
 
 	// Create database manager
-	dbManager := c.newDBManager(project.DBDriver)
+	dbManager := c.newDBManager(project.DBDriver, project.EnvPrefix)
 	if err := dbManager.LoadEnv(ctx, projectDir); err != nil {
-		return fmt.Errorf("failed to load environment: %w", err)
+		return withCode(CodeConfig, fmt.Errorf("failed to load environment: %w", err))
 	}
 
-	// Check for DATABASE_URL
-	if dbManager.GetDatabaseURL() == "" {
-		return fmt.Errorf("DATABASE_URL is not set (set it in .env or environment variables)")
+	// Check for database URL
+	if err := checkDatabaseURL(dbManager, project.EnvPrefix); err != nil {
+		return err
 	}
 
 	// Connect to database
 	db, err := dbManager.Connect(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to connect to database: %w", err)
+		return withCode(CodeDatabaseConnection, fmt.Errorf("failed to connect to database: %w", err))
 	}
 	defer dbManager.Close()
 
@@ -108,17 +100,20 @@ func (c *DBRollbackCommand) runE(cmd *cobra.Command, _ []string) error {
 	// Create migration runner
 	runner, err := database.NewMigrationRunner(db, dbManager.GetDriver(), migrationsDir)
 	if err != nil {
-		return fmt.Errorf("failed to initialize migrations: %w", err)
+		return withCode(CodeMigrationFailed, fmt.Errorf("failed to initialize migrations: %w", err))
 	}
 
 	r.Title("Rolling back migrations...")
+	r.Section(urlSourceSection(dbManager, project.EnvPrefix))
 
 	// Run rollback
 	result, err := runner.Down(ctx, steps)
 	if err != nil {
-		return fmt.Errorf("rollback failed: %w", err)
+		return withCode(CodeMigrationFailed, fmt.Errorf("rollback failed: %w", err))
 	}
 
+	r.Result(DBRollbackResult{Migrations: migrations(result.Applied)})
+
 	if len(result.Applied) == 0 {
 		r.Section(interfaces.Section{Body: "No migrations to roll back"})
 		return nil
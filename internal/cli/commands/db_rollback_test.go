@@ -15,6 +15,7 @@ import (
 func setupDBRollbackTestCommand(t *testing.T) (*cobra.Command, *mocks.MockProjectDetector, *mocks.MockRenderer) {
 	mockDetector := mocks.NewMockProjectDetector(t)
 	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Result", mock.Anything).Return().Maybe()
 	mockRenderer.On("Title", mock.Anything).Return().Maybe()
 	mockRenderer.On("Section", mock.Anything).Return().Maybe()
 	mockRenderer.On("Flush").Return(nil).Maybe()
@@ -37,6 +38,7 @@ func setupDBRollbackTestCommand(t *testing.T) (*cobra.Command, *mocks.MockProjec
 func TestNewDBRollbackCommand(t *testing.T) {
 	mockDetector := mocks.NewMockProjectDetector(t)
 	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Result", mock.Anything).Return().Maybe()
 
 	factory := func(*cobra.Command) interfaces.Renderer {
 		return mockRenderer
@@ -95,46 +97,30 @@ func TestDBRollbackCommand_NotInProject(t *testing.T) {
 	}
 }
 
-func TestDBRollbackCommand_UnsupportedDriver(t *testing.T) {
-	cobraCmd, mockDetector, _ := setupDBRollbackTestCommand(t)
-
-	mockDetector.On("Detect", mock.Anything, ".").
-		Return(&interfaces.TracksProject{
-			Name:       "testproject",
-			ModulePath: "example.com/testproject",
-			DBDriver:   "sqlite3",
-		}, "/tmp/testproject", nil)
-
-	err := cobraCmd.Execute()
-
-	if err == nil {
-		t.Fatal("expected error for unsupported driver")
-	}
-	if !strings.Contains(err.Error(), "only supports Postgres") {
-		t.Errorf("expected 'only supports Postgres' error, got: %v", err)
-	}
-	if !strings.Contains(err.Error(), "make migrate-down") {
-		t.Errorf("expected helpful suggestion for make migrate-down, got: %v", err)
-	}
-}
+func TestDBRollbackCommand_SQLiteDrivers(t *testing.T) {
+	for _, driver := range []string{"sqlite3", "go-libsql"} {
+		t.Run(driver, func(t *testing.T) {
+			cobraCmd, mockDetector, mockDBManager, _ := setupDBRollbackWithMockedDB(t)
 
-func TestDBRollbackCommand_UnsupportedDriver_LibSQL(t *testing.T) {
-	cobraCmd, mockDetector, _ := setupDBRollbackTestCommand(t)
+			mockDetector.On("Detect", mock.Anything, ".").
+				Return(&interfaces.TracksProject{
+					Name:       "testproject",
+					ModulePath: "example.com/testproject",
+					DBDriver:   driver,
+				}, "/tmp/testproject", nil)
 
-	mockDetector.On("Detect", mock.Anything, ".").
-		Return(&interfaces.TracksProject{
-			Name:       "testproject",
-			ModulePath: "example.com/testproject",
-			DBDriver:   "go-libsql",
-		}, "/tmp/testproject", nil)
+			mockDBManager.On("LoadEnv", mock.Anything, "/tmp/testproject").
+				Return(errors.New("env file not found"))
 
-	err := cobraCmd.Execute()
+			err := cobraCmd.Execute()
 
-	if err == nil {
-		t.Fatal("expected error for unsupported driver")
-	}
-	if !strings.Contains(err.Error(), "only supports Postgres") {
-		t.Errorf("expected 'only supports Postgres' error, got: %v", err)
+			if err == nil {
+				t.Fatal("expected error for LoadEnv failure")
+			}
+			if strings.Contains(err.Error(), "only supports Postgres") {
+				t.Errorf("expected %s to be supported, got: %v", driver, err)
+			}
+		})
 	}
 }
 
@@ -157,6 +143,7 @@ func setupDBRollbackWithMockedDB(t *testing.T) (*cobra.Command, *mocks.MockProje
 	mockDetector := mocks.NewMockProjectDetector(t)
 	mockDBManager := mocks.NewMockDatabaseManager(t)
 	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Result", mock.Anything).Return().Maybe()
 	mockRenderer.On("Title", mock.Anything).Return().Maybe()
 	mockRenderer.On("Section", mock.Anything).Return().Maybe()
 	mockRenderer.On("Flush").Return(nil).Maybe()
@@ -167,7 +154,7 @@ func setupDBRollbackWithMockedDB(t *testing.T) (*cobra.Command, *mocks.MockProje
 	flusher := func(*cobra.Command, interfaces.Renderer) {
 		mockRenderer.Flush()
 	}
-	dbFactory := func(_ string) interfaces.DatabaseManager {
+	dbFactory := func(_, _ string) interfaces.DatabaseManager {
 		return mockDBManager
 	}
 
@@ -237,6 +224,7 @@ func TestDBRollbackCommand_ConnectError(t *testing.T) {
 
 	mockDBManager.On("LoadEnv", mock.Anything, "/tmp/testproject").Return(nil)
 	mockDBManager.On("GetDatabaseURL").Return("postgres://localhost/test")
+	mockDBManager.On("GetDatabaseURLSource").Return("environment").Maybe()
 	mockDBManager.On("Connect", mock.Anything).Return(nil, errors.New("connection refused"))
 
 	err := cobraCmd.Execute()
@@ -48,10 +48,7 @@ func (c *DBStatusCommand) Command() *cobra.Command {
 		Short: "Show database migration status",
 		Long: `Show the status of database migrations for your Tracks project.
 
-Displays which migrations have been applied and which are pending.
-
-Note: This command only supports Postgres projects directly.
-For SQLite/go-libsql projects, use: make migrate-status`,
+Displays which migrations have been applied and which are pending.`,
 		Example: `  # Show migration status
   tracks db status`,
 		RunE: c.runE,
@@ -67,39 +64,42 @@ func (c *DBStatusCommand) runE(cmd *cobra.Command, _ []string) error {
 
 	project, projectDir, err := c.detector.Detect(ctx, ".")
 	if err != nil {
-		return fmt.Errorf("not in a Tracks project directory (missing .tracks.yaml): %w", err)
-	}
-
-	if project.DBDriver != "postgres" {
-		return fmt.Errorf("tracks db status only supports Postgres projects (found: %s). For SQLite/go-libsql projects, use: make migrate-status", project.DBDriver)
+		return errNotInProject(err)
 	}
 
-	dbManager := c.newDBManager(project.DBDriver)
+	dbManager := c.newDBManager(project.DBDriver, project.EnvPrefix)
 	if err := dbManager.LoadEnv(ctx, projectDir); err != nil {
-		return fmt.Errorf("failed to load environment: %w", err)
+		return withCode(CodeConfig, fmt.Errorf("failed to load environment: %w", err))
 	}
 
 	dbURL := dbManager.GetDatabaseURL()
 	if dbURL == "" {
-		return fmt.Errorf("DATABASE_URL is not set (set it in .env or environment variables)")
+		return errDatabaseURLNotSet(project.EnvPrefix)
 	}
 
 	migrationsDir := database.GetMigrationsDir(projectDir, project.DBDriver)
 
 	db, err := dbManager.Connect(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to connect to database: %w", err)
+		return withCode(CodeDatabaseConnection, fmt.Errorf("failed to connect to database: %w", err))
 	}
 	defer dbManager.Close()
 
 	runner, err := database.NewMigrationRunner(db, dbManager.GetDriver(), migrationsDir)
 	if err != nil {
-		return fmt.Errorf("failed to initialize migrations: %w", err)
+		return withCode(CodeMigrationFailed, fmt.Errorf("failed to initialize migrations: %w", err))
 	}
 
 	statuses, err := runner.Status(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to get migration status: %w", err)
+		return withCode(CodeMigrationFailed, fmt.Errorf("failed to get migration status: %w", err))
+	}
+
+	result := DBStatusResult{
+		Database:   database.SanitizeURL(dbURL),
+		Source:     dbManager.GetDatabaseURLSource(),
+		Driver:     project.DBDriver,
+		Migrations: make([]MigrationStatus, 0, len(statuses)),
 	}
 
 	var applied, pending []database.MigrationStatus
@@ -109,10 +109,18 @@ func (c *DBStatusCommand) runE(cmd *cobra.Command, _ []string) error {
 		} else {
 			pending = append(pending, s)
 		}
+		result.Migrations = append(result.Migrations, MigrationStatus{
+			Version:   s.Version,
+			Name:      s.Name,
+			Applied:   s.Applied,
+			AppliedAt: s.AppliedAt,
+		})
 	}
+	result.Applied, result.Pending = len(applied), len(pending)
 
 	var body string
 	body += fmt.Sprintf("Database: %s\n", database.SanitizeURL(dbURL))
+	body += fmt.Sprintf("Source: %s\n", describeURLSource(dbManager.GetDatabaseURLSource(), project.EnvPrefix))
 	body += fmt.Sprintf("Driver: %s\n\n", project.DBDriver)
 
 	if len(applied) > 0 {
@@ -134,6 +142,7 @@ func (c *DBStatusCommand) runE(cmd *cobra.Command, _ []string) error {
 	body += fmt.Sprintf("Total: %d applied, %d pending", len(applied), len(pending))
 
 	r.Section(interfaces.Section{Body: body})
+	r.Result(result)
 
 	return nil
 }
@@ -14,6 +14,7 @@ import (
 func setupDBTestCommand(t *testing.T) (*cobra.Command, *mocks.MockProjectDetector, *mocks.MockRenderer) {
 	mockDetector := mocks.NewMockProjectDetector(t)
 	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Result", mock.Anything).Return().Maybe()
 	mockRenderer.On("Title", mock.Anything).Return().Maybe()
 	mockRenderer.On("Section", mock.Anything).Return().Maybe()
 	mockRenderer.On("Flush").Return(nil).Maybe()
@@ -25,7 +26,7 @@ func setupDBTestCommand(t *testing.T) (*cobra.Command, *mocks.MockProjectDetecto
 		mockRenderer.Flush()
 	}
 
-	cmd := NewDBCommand(mockDetector, factory, flusher)
+	cmd := NewDBCommand(mockDetector, factory, flusher, newTestPrompter(mocks.NewMockPrompter(t)))
 	cobraCmd := cmd.Command()
 	cobraCmd.SetOut(new(bytes.Buffer))
 	cobraCmd.SetErr(new(bytes.Buffer))
@@ -36,13 +37,14 @@ func setupDBTestCommand(t *testing.T) (*cobra.Command, *mocks.MockProjectDetecto
 func TestNewDBCommand(t *testing.T) {
 	mockDetector := mocks.NewMockProjectDetector(t)
 	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Result", mock.Anything).Return().Maybe()
 
 	factory := func(*cobra.Command) interfaces.Renderer {
 		return mockRenderer
 	}
 	flusher := func(*cobra.Command, interfaces.Renderer) {}
 
-	dbCmd := NewDBCommand(mockDetector, factory, flusher)
+	dbCmd := NewDBCommand(mockDetector, factory, flusher, newTestPrompter(mocks.NewMockPrompter(t)))
 
 	if dbCmd == nil {
 		t.Fatal("NewDBCommand returned nil")
@@ -58,13 +60,14 @@ func TestNewDBCommand(t *testing.T) {
 func TestDBCommand_Command(t *testing.T) {
 	mockDetector := mocks.NewMockProjectDetector(t)
 	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Result", mock.Anything).Return().Maybe()
 
 	factory := func(*cobra.Command) interfaces.Renderer {
 		return mockRenderer
 	}
 	flusher := func(*cobra.Command, interfaces.Renderer) {}
 
-	dbCmd := NewDBCommand(mockDetector, factory, flusher)
+	dbCmd := NewDBCommand(mockDetector, factory, flusher, newTestPrompter(mocks.NewMockPrompter(t)))
 	cobraCmd := dbCmd.Command()
 
 	if cobraCmd == nil {
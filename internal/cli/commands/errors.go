@@ -0,0 +1,90 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Error codes identify why a command failed in JSON and NDJSON output, so
+// scripts can branch on the code instead of parsing the message.
+const (
+	// CodeInvalidArgument is a bad argument or flag value.
+	CodeInvalidArgument = "invalid_argument"
+
+	// CodeNotInProject is a project command run outside a Tracks project.
+	CodeNotInProject = "not_in_project"
+
+	// CodeNotInModule is 'tracks init' run outside a Go module.
+	CodeNotInModule = "not_in_module"
+
+	// CodeNoManifest is a project without a generation manifest.
+	CodeNoManifest = "no_manifest"
+
+	// CodeConfig is a project .env or configuration that cannot be loaded.
+	CodeConfig = "config_error"
+
+	// CodeDatabaseURLNotSet is a project without a database URL.
+	CodeDatabaseURLNotSet = "database_url_not_set"
+
+	// CodeDatabaseConnection is a database that cannot be reached.
+	CodeDatabaseConnection = "database_connection_failed"
+
+	// CodeMigrationFailed is a migration that failed to apply, roll back or
+	// report its status.
+	CodeMigrationFailed = "migration_failed"
+
+	// CodeGenerationFailed is project, resource or migration generation that
+	// failed.
+	CodeGenerationFailed = "generation_failed"
+
+	// CodeConflict is an upgrade that left conflicts to resolve.
+	CodeConflict = "conflict"
+
+	// CodeConfirmationRequired is a destructive command that needs
+	// confirmation but cannot prompt, e.g. in CI or with JSON output.
+	CodeConfirmationRequired = "confirmation_required"
+
+	// CodeTemplateCheckFailed is a template that does not render.
+	CodeTemplateCheckFailed = "template_check_failed"
+
+	// CodeUnknown is any other error, including cobra's argument errors.
+	CodeUnknown = "unknown"
+)
+
+// CodedError is an error with a machine-readable code.
+type CodedError struct {
+	Code string
+	Err  error
+}
+
+func (e *CodedError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *CodedError) Unwrap() error {
+	return e.Err
+}
+
+// withCode attaches code to err.
+func withCode(code string, err error) error {
+	return &CodedError{Code: code, Err: err}
+}
+
+// ErrorCode returns the code of the first CodedError in err's chain, or
+// CodeUnknown.
+func ErrorCode(err error) string {
+	var coded *CodedError
+	if errors.As(err, &coded) {
+		return coded.Code
+	}
+	return CodeUnknown
+}
+
+// errNotInProject returns the error shown when a command that needs a
+// project runs outside one. err is the detector's error, if any.
+func errNotInProject(err error) error {
+	if err != nil {
+		return withCode(CodeNotInProject, fmt.Errorf("not in a Tracks project directory (missing .tracks.yaml): %w", err))
+	}
+	return withCode(CodeNotInProject, fmt.Errorf("not in a Tracks project (no .tracks.yaml found)"))
+}
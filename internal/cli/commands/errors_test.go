@@ -0,0 +1,45 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestErrorCode(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{name: "coded", err: withCode(CodeConflict, errors.New("2 file(s) have conflicts")), want: CodeConflict},
+		{name: "wrapped", err: fmt.Errorf("context: %w", withCode(CodeMigrationFailed, errors.New("boom"))), want: CodeMigrationFailed},
+		{name: "plain", err: errors.New("accepts 1 arg(s), received 0"), want: CodeUnknown},
+		{name: "not in project", err: errNotInProject(nil), want: CodeNotInProject},
+		{name: "database URL", err: errDatabaseURLNotSet("APP"), want: CodeDatabaseURLNotSet},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ErrorCode(tt.err); got != tt.want {
+				t.Errorf("ErrorCode() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCodedErrorKeepsMessageAndChain(t *testing.T) {
+	cause := errors.New("no such file")
+	err := errNotInProject(cause)
+
+	if !errors.Is(err, cause) {
+		t.Error("expected the detector's error in the chain")
+	}
+	if want := "not in a Tracks project directory (missing .tracks.yaml): no such file"; err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+	if !strings.Contains(errNotInProject(nil).Error(), "no .tracks.yaml found") {
+		t.Errorf("unexpected message: %v", errNotInProject(nil))
+	}
+}
@@ -0,0 +1,78 @@
+package commands
+
+import (
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	"github.com/spf13/cobra"
+)
+
+// GenerateCommand represents the 'generate' parent command for code generators.
+type GenerateCommand struct {
+	detector           interfaces.ProjectDetector
+	resourceGenerator  interfaces.ResourceGenerator
+	migrationGenerator interfaces.MigrationGenerator
+	ciGenerator        interfaces.CIGenerator
+	newRenderer        RendererFactory
+	flushRenderer      RendererFlusher
+}
+
+// NewGenerateCommand creates a new instance of the 'generate' command with injected dependencies.
+func NewGenerateCommand(
+	detector interfaces.ProjectDetector,
+	resourceGenerator interfaces.ResourceGenerator,
+	migrationGenerator interfaces.MigrationGenerator,
+	ciGenerator interfaces.CIGenerator,
+	newRenderer RendererFactory,
+	flushRenderer RendererFlusher,
+) *GenerateCommand {
+	return &GenerateCommand{
+		detector:           detector,
+		resourceGenerator:  resourceGenerator,
+		migrationGenerator: migrationGenerator,
+		ciGenerator:        ciGenerator,
+		newRenderer:        newRenderer,
+		flushRenderer:      flushRenderer,
+	}
+}
+
+// Command returns the cobra.Command for the 'generate' subcommand.
+func (c *GenerateCommand) Command() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "generate",
+		Aliases: []string{"g"},
+		Short:   "Generate code in your Tracks project",
+		Long: `Generate code in your Tracks project.
+
+Generators add new files to an existing project and wire them into the
+server, routes and dependency setup using the TRACKS markers in the
+generated code.
+
+This command must be run from within a Tracks project (containing .tracks.yaml).`,
+		Example: `  # Generate a CRUD resource
+  tracks generate resource post title:string body:text published:bool
+
+  # Same, using the short alias
+  tracks g resource post title:string
+
+  # Generate an empty migration
+  tracks generate migration add_slug_to_posts
+
+  # Generate a GitLab CI pipeline
+  tracks generate ci gitlab`,
+		Run: c.run,
+	}
+
+	resourceCmd := NewGenerateResourceCommand(c.detector, c.resourceGenerator, c.newRenderer, c.flushRenderer)
+	cmd.AddCommand(resourceCmd.Command())
+
+	migrationCmd := NewGenerateMigrationCommand(c.detector, c.migrationGenerator, c.newRenderer, c.flushRenderer)
+	cmd.AddCommand(migrationCmd.Command())
+
+	ciCmd := NewGenerateCICommand(c.detector, c.ciGenerator, c.newRenderer, c.flushRenderer)
+	cmd.AddCommand(ciCmd.Command())
+
+	return cmd
+}
+
+func (c *GenerateCommand) run(cmd *cobra.Command, _ []string) {
+	_ = cmd.Help()
+}
@@ -0,0 +1,128 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	"github.com/anomalousventures/tracks/internal/generator"
+	"github.com/spf13/cobra"
+)
+
+// GenerateCICommand represents the 'generate ci' subcommand.
+type GenerateCICommand struct {
+	detector      interfaces.ProjectDetector
+	generator     interfaces.CIGenerator
+	newRenderer   RendererFactory
+	flushRenderer RendererFlusher
+}
+
+// NewGenerateCICommand creates a new instance of the 'generate ci' command with injected dependencies.
+func NewGenerateCICommand(
+	detector interfaces.ProjectDetector,
+	generator interfaces.CIGenerator,
+	newRenderer RendererFactory,
+	flushRenderer RendererFlusher,
+) *GenerateCICommand {
+	return &GenerateCICommand{
+		detector:      detector,
+		generator:     generator,
+		newRenderer:   newRenderer,
+		flushRenderer: flushRenderer,
+	}
+}
+
+// Command returns the cobra.Command for the 'generate ci' subcommand.
+func (c *GenerateCICommand) Command() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ci <github|gitlab>",
+		Short: "Generate a CI pipeline",
+		Long: `Generate the CI pipeline of a provider in an existing project.
+
+The pipeline runs 'make ci', which lints and runs the tests with the race
+detector and coverage, so every provider checks the same things and the
+checks can be run locally. The ci target is added to the Makefile if it
+is missing, and the provider is recorded in .tracks.yaml so 'tracks upgrade'
+keeps the pipeline up to date.
+
+An existing pipeline file that differs from the template is kept unless
+--force is given. Tracks then leaves the Makefile and .tracks.yaml alone,
+since it does not manage a pipeline it did not write. The Go version is the
+go_version of .tracks.yaml, or the go directive of go.mod.`,
+		Example: `  # GitLab CI for a project on a self-hosted GitLab
+  tracks generate ci gitlab
+
+  # Replace an existing GitHub Actions workflow
+  tracks generate ci github --force`,
+		Args:      cobra.ExactArgs(1),
+		ValidArgs: []string{generator.CIGitHub, generator.CIGitLab},
+		RunE:      c.runE,
+	}
+
+	cmd.Flags().Bool("force", false, "Replace an existing pipeline file")
+
+	return cmd
+}
+
+func (c *GenerateCICommand) runE(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	force, _ := cmd.Flags().GetBool("force")
+
+	project, projectDir, err := c.detector.Detect(ctx, ".")
+	if err != nil {
+		return errNotInProject(err)
+	}
+	if project == nil {
+		return errNotInProject(nil)
+	}
+
+	cfg := generator.CIConfig{
+		Provider:       args[0],
+		ProjectDir:     projectDir,
+		ProjectName:    project.Name,
+		ModulePath:     project.ModulePath,
+		DatabaseDriver: project.DBDriver,
+		EnvPrefix:      project.EnvPrefix,
+		GoVersion:      project.GoVersion,
+		Features:       project.Features,
+		Force:          force,
+	}
+
+	if err := c.generator.Validate(cfg); err != nil {
+		return withCode(CodeInvalidArgument, fmt.Errorf("validation failed: %w", err))
+	}
+
+	files, err := c.generator.Generate(ctx, cfg)
+	if err != nil {
+		return withCode(CodeGenerationFailed, fmt.Errorf("failed to generate CI pipeline: %w", err))
+	}
+
+	r := c.newRenderer(cmd)
+	defer c.flushRenderer(cmd, r)
+
+	kept := len(files) > 0 && files[0].Detail == generator.DetailExists
+	title := fmt.Sprintf("Generated CI pipeline: %s", cfg.Provider)
+	if kept {
+		title = fmt.Sprintf("Kept the existing CI pipeline: %s", cfg.Provider)
+	}
+	r.Title(title)
+	r.Table(generatedFilesTable(files))
+	r.Result(GenerateCIResult{Provider: cfg.Provider, Files: generatedFiles(files)})
+
+	steps := []string{"Run 'make ci' to check the pipeline passes locally", "Commit and push to run the pipeline"}
+	if kept {
+		steps = []string{fmt.Sprintf("Compare %s with the Tracks pipeline and rerun with --force to replace it", files[0].Path)}
+	} else if previous := project.Features.CI; previous != "" && previous != generator.CINone && previous != cfg.Provider {
+		steps = append(steps, fmt.Sprintf("Remove the %s pipeline if you no longer use it", previous))
+	}
+	var body strings.Builder
+	for i, step := range steps {
+		if i > 0 {
+			body.WriteString("\n")
+		}
+		fmt.Fprintf(&body, "  %d. %s", i+1, step)
+	}
+	r.Section(interfaces.Section{Title: "Next steps", Body: body.String()})
+
+	return nil
+}
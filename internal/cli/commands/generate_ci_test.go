@@ -0,0 +1,149 @@
+package commands
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	"github.com/anomalousventures/tracks/internal/generator"
+	"github.com/anomalousventures/tracks/tests/mocks"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/mock"
+)
+
+func setupGenerateCITestCommand(t *testing.T) (*cobra.Command, *mocks.MockProjectDetector, *mocks.MockCIGenerator, *mocks.MockRenderer) {
+	mockDetector := mocks.NewMockProjectDetector(t)
+	mockGenerator := mocks.NewMockCIGenerator(t)
+	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Result", mock.Anything).Return().Maybe()
+
+	factory := func(*cobra.Command) interfaces.Renderer {
+		return mockRenderer
+	}
+	flusher := func(*cobra.Command, interfaces.Renderer) {
+		mockRenderer.Flush()
+	}
+
+	cmd := NewGenerateCICommand(mockDetector, mockGenerator, factory, flusher)
+	cobraCmd := cmd.Command()
+	cobraCmd.SetOut(new(bytes.Buffer))
+	cobraCmd.SetErr(new(bytes.Buffer))
+
+	return cobraCmd, mockDetector, mockGenerator, mockRenderer
+}
+
+func TestGenerateCICommand_Command(t *testing.T) {
+	cobraCmd, _, _, _ := setupGenerateCITestCommand(t)
+
+	if !strings.HasPrefix(cobraCmd.Use, "ci") {
+		t.Errorf("expected Use to start with 'ci', got %q", cobraCmd.Use)
+	}
+
+	if cobraCmd.Short == "" || cobraCmd.Long == "" || cobraCmd.Example == "" {
+		t.Error("expected Short, Long and Example to be set")
+	}
+
+	if cobraCmd.Flags().Lookup("force") == nil {
+		t.Error("expected --force flag")
+	}
+}
+
+func TestGenerateCICommand_NotInProject(t *testing.T) {
+	cobraCmd, mockDetector, _, _ := setupGenerateCITestCommand(t)
+
+	mockDetector.On("Detect", mock.Anything, ".").
+		Return(nil, "", errors.New("no .tracks.yaml found")).Once()
+
+	cobraCmd.SetArgs([]string{"gitlab"})
+	err := cobraCmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "not in a Tracks project") {
+		t.Fatalf("expected project error, got %v", err)
+	}
+}
+
+func TestGenerateCICommand_Success(t *testing.T) {
+	cobraCmd, mockDetector, mockGenerator, mockRenderer := setupGenerateCITestCommand(t)
+
+	features := interfaces.ProjectFeatures{Docker: true, CI: "github"}
+	project := &interfaces.TracksProject{
+		Name:       "testapp",
+		ModulePath: "github.com/example/testapp",
+		DBDriver:   "postgres",
+		EnvPrefix:  "APP",
+		Features:   features,
+	}
+	mockDetector.On("Detect", mock.Anything, ".").Return(project, "/tmp/testapp", nil).Once()
+
+	expectedCfg := generator.CIConfig{
+		Provider:       "gitlab",
+		ProjectDir:     "/tmp/testapp",
+		ProjectName:    "testapp",
+		ModulePath:     "github.com/example/testapp",
+		DatabaseDriver: "postgres",
+		EnvPrefix:      "APP",
+		Features:       features,
+		Force:          true,
+	}
+	files := []interfaces.GeneratedFile{
+		{Path: ".gitlab-ci.yml", Action: interfaces.FileActionCreate},
+		{Path: "Makefile", Action: interfaces.FileActionUpdate},
+	}
+	mockGenerator.On("Validate", expectedCfg).Return(nil).Once()
+	mockGenerator.On("Generate", mock.Anything, expectedCfg).Return(files, nil).Once()
+
+	mockRenderer.On("Title", "Generated CI pipeline: gitlab").Once()
+	mockRenderer.On("Table", mock.MatchedBy(func(tbl interfaces.Table) bool {
+		return len(tbl.Rows) == 2 && tbl.Rows[0][0] == ".gitlab-ci.yml"
+	})).Once()
+	mockRenderer.On("Section", mock.MatchedBy(func(s interfaces.Section) bool {
+		return strings.Contains(s.Body, "make ci") && strings.Contains(s.Body, "Remove the github pipeline")
+	})).Once()
+	mockRenderer.On("Flush").Return(nil).Once()
+
+	cobraCmd.SetArgs([]string{"gitlab", "--force"})
+	if err := cobraCmd.Execute(); err != nil {
+		t.Fatalf("execution failed: %v", err)
+	}
+}
+
+func TestGenerateCICommand_ValidationError(t *testing.T) {
+	cobraCmd, mockDetector, mockGenerator, _ := setupGenerateCITestCommand(t)
+
+	project := &interfaces.TracksProject{Name: "testapp", DBDriver: "postgres"}
+	mockDetector.On("Detect", mock.Anything, ".").Return(project, "/tmp/testapp", nil).Once()
+	mockGenerator.On("Validate", mock.Anything).Return(errors.New(`unknown CI provider "jenkins"`)).Once()
+
+	cobraCmd.SetArgs([]string{"jenkins"})
+	err := cobraCmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "unknown CI provider") {
+		t.Fatalf("expected validation error, got %v", err)
+	}
+}
+
+func TestGenerateCICommand_ExistingPipeline(t *testing.T) {
+	cobraCmd, mockDetector, mockGenerator, mockRenderer := setupGenerateCITestCommand(t)
+
+	project := &interfaces.TracksProject{Name: "testapp", DBDriver: "postgres", GoVersion: "1.24"}
+	mockDetector.On("Detect", mock.Anything, ".").Return(project, "/tmp/testapp", nil).Once()
+	files := []interfaces.GeneratedFile{
+		{Path: ".gitlab-ci.yml", Action: interfaces.FileActionSkip, Detail: generator.DetailExists},
+	}
+	mockGenerator.On("Validate", mock.Anything).Return(nil).Once()
+	mockGenerator.On("Generate", mock.Anything, mock.MatchedBy(func(cfg generator.CIConfig) bool {
+		return cfg.GoVersion == "1.24"
+	})).Return(files, nil).Once()
+
+	mockRenderer.On("Title", "Kept the existing CI pipeline: gitlab").Once()
+	mockRenderer.On("Table", mock.Anything).Once()
+	mockRenderer.On("Section", mock.MatchedBy(func(s interfaces.Section) bool {
+		return strings.Contains(s.Body, "rerun with --force") && !strings.Contains(s.Body, "make ci")
+	})).Once()
+	mockRenderer.On("Flush").Return(nil).Once()
+
+	cobraCmd.SetArgs([]string{"gitlab"})
+	if err := cobraCmd.Execute(); err != nil {
+		t.Fatalf("execution failed: %v", err)
+	}
+}
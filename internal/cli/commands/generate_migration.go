@@ -0,0 +1,128 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	"github.com/anomalousventures/tracks/internal/generator"
+	"github.com/spf13/cobra"
+)
+
+// GenerateMigrationCommand represents the 'generate migration' subcommand.
+type GenerateMigrationCommand struct {
+	detector      interfaces.ProjectDetector
+	generator     interfaces.MigrationGenerator
+	newRenderer   RendererFactory
+	flushRenderer RendererFlusher
+}
+
+// NewGenerateMigrationCommand creates a new instance of the 'generate migration' command with injected dependencies.
+func NewGenerateMigrationCommand(
+	detector interfaces.ProjectDetector,
+	generator interfaces.MigrationGenerator,
+	newRenderer RendererFactory,
+	flushRenderer RendererFlusher,
+) *GenerateMigrationCommand {
+	return &GenerateMigrationCommand{
+		detector:      detector,
+		generator:     generator,
+		newRenderer:   newRenderer,
+		flushRenderer: flushRenderer,
+	}
+}
+
+// Command returns the cobra.Command for the 'generate migration' subcommand.
+func (c *GenerateMigrationCommand) Command() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migration <name> [create_table <table> <field:type>...]",
+		Short: "Generate a database migration",
+		Long: `Generate a timestamped goose migration in your project's migrations directory.
+
+Without further arguments the migration is an empty Up/Down skeleton. With
+the create_table shorthand it is pre-filled with a CREATE TABLE statement
+using the project's conventions: a TEXT primary key for UUIDv7 ids,
+created_at/updated_at timestamps and an updated_at trigger, written in the
+dialect of the project's database driver.
+
+Field types: string, text, int, float, bool, time.`,
+		Example: `  # Empty migration
+  tracks generate migration add_slug_to_posts
+
+  # Migration that creates a table
+  tracks generate migration create_tags create_table tags label:string color:string`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: c.runE,
+	}
+
+	return cmd
+}
+
+func (c *GenerateMigrationCommand) runE(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	cfg, err := parseMigrationArgs(args)
+	if err != nil {
+		return withCode(CodeInvalidArgument, err)
+	}
+
+	project, projectDir, err := c.detector.Detect(ctx, ".")
+	if err != nil {
+		return errNotInProject(err)
+	}
+	if project == nil {
+		return errNotInProject(nil)
+	}
+
+	cfg.ProjectDir = projectDir
+	cfg.DatabaseDriver = project.DBDriver
+
+	if err := c.generator.Validate(cfg); err != nil {
+		return withCode(CodeInvalidArgument, fmt.Errorf("validation failed: %w", err))
+	}
+
+	files, err := c.generator.Generate(ctx, cfg)
+	if err != nil {
+		return withCode(CodeGenerationFailed, fmt.Errorf("failed to generate migration: %w", err))
+	}
+
+	r := c.newRenderer(cmd)
+	defer c.flushRenderer(cmd, r)
+
+	r.Title(fmt.Sprintf("Generated migration: %s", cfg.Name))
+	r.Table(generatedFilesTable(files))
+	r.Result(GenerateMigrationResult{Name: cfg.Name, Files: generatedFiles(files)})
+
+	r.Section(interfaces.Section{
+		Title: "Next steps",
+		Body:  "  1. Edit the migration if needed\n  2. tracks db migrate",
+	})
+
+	return nil
+}
+
+// parseMigrationArgs turns `<name> [create_table <table> <field:type>...]`
+// into a MigrationConfig.
+func parseMigrationArgs(args []string) (generator.MigrationConfig, error) {
+	cfg := generator.MigrationConfig{Name: args[0]}
+
+	rest := args[1:]
+	if len(rest) == 0 {
+		return cfg, nil
+	}
+
+	if rest[0] != "create_table" {
+		return cfg, fmt.Errorf("unexpected argument %q: expected create_table <table> <field:type>...", rest[0])
+	}
+	if len(rest) < 3 {
+		return cfg, fmt.Errorf("create_table requires a table name and at least one field (e.g., create_table posts title:string)")
+	}
+
+	fields, err := generator.ParseFields(rest[2:])
+	if err != nil {
+		return cfg, fmt.Errorf("invalid fields: %w", err)
+	}
+
+	cfg.Table = rest[1]
+	cfg.Fields = fields
+	return cfg, nil
+}
@@ -0,0 +1,156 @@
+package commands
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	"github.com/anomalousventures/tracks/internal/generator"
+	"github.com/anomalousventures/tracks/tests/mocks"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/mock"
+)
+
+func setupGenerateMigrationTestCommand(t *testing.T) (*cobra.Command, *mocks.MockProjectDetector, *mocks.MockMigrationGenerator, *mocks.MockRenderer) {
+	mockDetector := mocks.NewMockProjectDetector(t)
+	mockGenerator := mocks.NewMockMigrationGenerator(t)
+	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Result", mock.Anything).Return().Maybe()
+
+	factory := func(*cobra.Command) interfaces.Renderer {
+		return mockRenderer
+	}
+	flusher := func(*cobra.Command, interfaces.Renderer) {
+		mockRenderer.Flush()
+	}
+
+	cmd := NewGenerateMigrationCommand(mockDetector, mockGenerator, factory, flusher)
+	cobraCmd := cmd.Command()
+	cobraCmd.SetOut(new(bytes.Buffer))
+	cobraCmd.SetErr(new(bytes.Buffer))
+
+	return cobraCmd, mockDetector, mockGenerator, mockRenderer
+}
+
+func TestGenerateMigrationCommand_Command(t *testing.T) {
+	cobraCmd, _, _, _ := setupGenerateMigrationTestCommand(t)
+
+	if !strings.HasPrefix(cobraCmd.Use, "migration") {
+		t.Errorf("expected Use to start with 'migration', got %q", cobraCmd.Use)
+	}
+
+	if cobraCmd.Short == "" || cobraCmd.Long == "" || cobraCmd.Example == "" {
+		t.Error("expected Short, Long and Example to be set")
+	}
+}
+
+func TestParseMigrationArgs(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		want    generator.MigrationConfig
+		wantErr string
+	}{
+		{
+			name: "blank",
+			args: []string{"add_index"},
+			want: generator.MigrationConfig{Name: "add_index"},
+		},
+		{
+			name: "create_table",
+			args: []string{"create_tags", "create_table", "tags", "label:string", "weight:int"},
+			want: generator.MigrationConfig{
+				Name:   "create_tags",
+				Table:  "tags",
+				Fields: []generator.Field{{Name: "label", Type: generator.FieldString}, {Name: "weight", Type: generator.FieldInt}},
+			},
+		},
+		{name: "unknown keyword", args: []string{"x", "drop_table", "tags"}, wantErr: "unexpected argument"},
+		{name: "missing fields", args: []string{"x", "create_table", "tags"}, wantErr: "at least one field"},
+		{name: "bad field", args: []string{"x", "create_table", "tags", "label:uuid"}, wantErr: "invalid fields"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseMigrationArgs(tt.args)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Name != tt.want.Name || got.Table != tt.want.Table || len(got.Fields) != len(tt.want.Fields) {
+				t.Errorf("parseMigrationArgs() = %+v, want %+v", got, tt.want)
+			}
+			for i := range got.Fields {
+				if got.Fields[i] != tt.want.Fields[i] {
+					t.Errorf("field %d = %+v, want %+v", i, got.Fields[i], tt.want.Fields[i])
+				}
+			}
+		})
+	}
+}
+
+func TestGenerateMigrationCommand_NotInProject(t *testing.T) {
+	cobraCmd, mockDetector, _, _ := setupGenerateMigrationTestCommand(t)
+
+	mockDetector.On("Detect", mock.Anything, ".").
+		Return(nil, "", errors.New("no .tracks.yaml found")).Once()
+
+	cobraCmd.SetArgs([]string{"add_index"})
+	err := cobraCmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "not in a Tracks project") {
+		t.Fatalf("expected project error, got %v", err)
+	}
+}
+
+func TestGenerateMigrationCommand_Success(t *testing.T) {
+	cobraCmd, mockDetector, mockGenerator, mockRenderer := setupGenerateMigrationTestCommand(t)
+
+	project := &interfaces.TracksProject{Name: "testapp", DBDriver: "go-libsql"}
+	mockDetector.On("Detect", mock.Anything, ".").Return(project, "/tmp/testapp", nil).Once()
+
+	expectedCfg := generator.MigrationConfig{
+		Name:           "create_tags",
+		Table:          "tags",
+		Fields:         []generator.Field{{Name: "label", Type: generator.FieldString}},
+		ProjectDir:     "/tmp/testapp",
+		DatabaseDriver: "go-libsql",
+	}
+	files := []interfaces.GeneratedFile{{Path: "internal/db/migrations/sqlite/20250102030405_create_tags.sql", Action: interfaces.FileActionCreate}}
+	mockGenerator.On("Validate", expectedCfg).Return(nil).Once()
+	mockGenerator.On("Generate", mock.Anything, expectedCfg).Return(files, nil).Once()
+
+	mockRenderer.On("Title", "Generated migration: create_tags").Once()
+	mockRenderer.On("Table", mock.MatchedBy(func(tbl interfaces.Table) bool {
+		return len(tbl.Rows) == 1 && tbl.Rows[0][0] == files[0].Path
+	})).Once()
+	mockRenderer.On("Section", mock.MatchedBy(func(s interfaces.Section) bool {
+		return strings.Contains(s.Body, "tracks db migrate")
+	})).Once()
+	mockRenderer.On("Flush").Return(nil).Once()
+
+	cobraCmd.SetArgs([]string{"create_tags", "create_table", "tags", "label:string"})
+	if err := cobraCmd.Execute(); err != nil {
+		t.Fatalf("execution failed: %v", err)
+	}
+}
+
+func TestGenerateMigrationCommand_ValidationError(t *testing.T) {
+	cobraCmd, mockDetector, mockGenerator, _ := setupGenerateMigrationTestCommand(t)
+
+	project := &interfaces.TracksProject{Name: "testapp", DBDriver: "postgres"}
+	mockDetector.On("Detect", mock.Anything, ".").Return(project, "/tmp/testapp", nil).Once()
+	mockGenerator.On("Validate", mock.Anything).Return(errors.New("migration add_index already exists")).Once()
+
+	cobraCmd.SetArgs([]string{"add_index"})
+	err := cobraCmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "already exists") {
+		t.Fatalf("expected validation error, got %v", err)
+	}
+}
@@ -0,0 +1,160 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	"github.com/anomalousventures/tracks/internal/generator"
+	"github.com/anomalousventures/tracks/internal/generator/injector"
+	"github.com/spf13/cobra"
+)
+
+// GenerateResourceCommand represents the 'generate resource' subcommand.
+type GenerateResourceCommand struct {
+	detector      interfaces.ProjectDetector
+	generator     interfaces.ResourceGenerator
+	newRenderer   RendererFactory
+	flushRenderer RendererFlusher
+}
+
+// NewGenerateResourceCommand creates a new instance of the 'generate resource' command with injected dependencies.
+func NewGenerateResourceCommand(
+	detector interfaces.ProjectDetector,
+	generator interfaces.ResourceGenerator,
+	newRenderer RendererFactory,
+	flushRenderer RendererFlusher,
+) *GenerateResourceCommand {
+	return &GenerateResourceCommand{
+		detector:      detector,
+		generator:     generator,
+		newRenderer:   newRenderer,
+		flushRenderer: flushRenderer,
+	}
+}
+
+// Command returns the cobra.Command for the 'generate resource' subcommand.
+func (c *GenerateResourceCommand) Command() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resource <name> <field:type> [field:type...]",
+		Short: "Generate a CRUD resource",
+		Long: `Generate a complete CRUD resource in your Tracks project.
+
+Creates a migration, SQLC queries, domain interfaces, repository, service,
+HTTP handler, routes and templ pages, then registers the resource in
+cmd/server/main.go, internal/http/server.go and internal/http/routes.go.
+
+Field types: string, text, int, float, bool, time.
+
+After writing the files, 'make generate' runs to produce SQLC code, templ
+components and mocks, and the test files are written last. Use
+--skip-generate to only write the application files.`,
+		Example: `  # Generate a blog post resource
+  tracks generate resource post title:string body:text published:bool
+
+  # Field types can be combined freely
+  tracks g resource product name:string price:float stock:int available_at:time
+
+  # Overwrite previously generated files
+  tracks generate resource post title:string --force`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: c.runE,
+	}
+
+	cmd.Flags().BoolP("force", "f", false, "Overwrite existing resource files")
+	cmd.Flags().Bool("skip-generate", false, "Skip 'make generate' and the generated tests")
+
+	return cmd
+}
+
+func (c *GenerateResourceCommand) runE(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	force, _ := cmd.Flags().GetBool("force")
+	skipGenerate, _ := cmd.Flags().GetBool("skip-generate")
+
+	fields, err := generator.ParseFields(args[1:])
+	if err != nil {
+		return withCode(CodeInvalidArgument, fmt.Errorf("invalid fields: %w", err))
+	}
+
+	project, projectDir, err := c.detector.Detect(ctx, ".")
+	if err != nil {
+		return errNotInProject(err)
+	}
+	if project == nil {
+		return errNotInProject(nil)
+	}
+
+	cfg := generator.ResourceConfig{
+		Name:           args[0],
+		Fields:         fields,
+		ProjectDir:     projectDir,
+		ModulePath:     project.ModulePath,
+		DatabaseDriver: project.DBDriver,
+		Force:          force,
+		SkipGenerate:   skipGenerate,
+	}
+
+	if err := c.generator.Validate(cfg); err != nil {
+		return withCode(CodeInvalidArgument, fmt.Errorf("validation failed: %w", err))
+	}
+
+	r := c.newRenderer(cmd)
+	defer c.flushRenderer(cmd, r)
+
+	r.Title(fmt.Sprintf("Generating resource: %s", args[0]))
+
+	files, err := c.generator.Generate(ctx, cfg)
+	if len(files) > 0 {
+		r.Table(generatedFilesTable(files))
+	}
+	r.Result(GenerateResourceResult{Name: args[0], Files: generatedFiles(files)})
+	if err != nil {
+		return withCode(CodeGenerationFailed, fmt.Errorf("failed to generate resource: %w", err))
+	}
+
+	r.Section(interfaces.Section{
+		Title: "Next steps",
+		Body:  resourceNextSteps(files, skipGenerate),
+	})
+
+	return nil
+}
+
+func generatedFilesTable(files []interfaces.GeneratedFile) interfaces.Table {
+	rows := make([][]string, len(files))
+	for i, f := range files {
+		rows[i] = []string{f.Path, f.Action, f.Detail}
+	}
+	return interfaces.Table{
+		Headers: []string{"File", "Action", "Detail"},
+		Rows:    rows,
+	}
+}
+
+func resourceNextSteps(files []interfaces.GeneratedFile, skipGenerate bool) string {
+	var steps []string
+
+	if skipGenerate {
+		steps = append(steps, "make generate")
+	}
+
+	for _, f := range files {
+		if f.Action == interfaces.FileActionSkip && f.Detail != injector.DetailUnchanged {
+			steps = append(steps, "Register the resource manually in cmd/server/main.go, internal/http/server.go and internal/http/routes.go")
+			break
+		}
+	}
+
+	steps = append(steps, "tracks db migrate", "make test")
+
+	var body strings.Builder
+	for i, step := range steps {
+		if i > 0 {
+			body.WriteString("\n")
+		}
+		fmt.Fprintf(&body, "  %d. %s", i+1, step)
+	}
+	return body.String()
+}
@@ -0,0 +1,205 @@
+package commands
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	"github.com/anomalousventures/tracks/internal/generator"
+	"github.com/anomalousventures/tracks/tests/mocks"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/mock"
+)
+
+func setupGenerateResourceTestCommand(t *testing.T) (*cobra.Command, *mocks.MockProjectDetector, *mocks.MockResourceGenerator, *mocks.MockRenderer) {
+	mockDetector := mocks.NewMockProjectDetector(t)
+	mockGenerator := mocks.NewMockResourceGenerator(t)
+	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Result", mock.Anything).Return().Maybe()
+
+	factory := func(*cobra.Command) interfaces.Renderer {
+		return mockRenderer
+	}
+	flusher := func(*cobra.Command, interfaces.Renderer) {
+		mockRenderer.Flush()
+	}
+
+	cmd := NewGenerateResourceCommand(mockDetector, mockGenerator, factory, flusher)
+	cobraCmd := cmd.Command()
+	cobraCmd.SetOut(new(bytes.Buffer))
+	cobraCmd.SetErr(new(bytes.Buffer))
+
+	return cobraCmd, mockDetector, mockGenerator, mockRenderer
+}
+
+func TestGenerateResourceCommand_Command(t *testing.T) {
+	cobraCmd, _, _, _ := setupGenerateResourceTestCommand(t)
+
+	if !strings.HasPrefix(cobraCmd.Use, "resource") {
+		t.Errorf("expected Use to start with 'resource', got %q", cobraCmd.Use)
+	}
+
+	if cobraCmd.Short == "" || cobraCmd.Long == "" || cobraCmd.Example == "" {
+		t.Error("expected Short, Long and Example to be set")
+	}
+
+	forceFlag := cobraCmd.Flags().Lookup("force")
+	if forceFlag == nil {
+		t.Fatal("--force flag not found")
+	}
+	if forceFlag.Shorthand != "f" {
+		t.Errorf("expected --force shorthand 'f', got %q", forceFlag.Shorthand)
+	}
+
+	if cobraCmd.Flags().Lookup("skip-generate") == nil {
+		t.Error("--skip-generate flag not found")
+	}
+}
+
+func TestGenerateResourceCommand_RequiresFields(t *testing.T) {
+	cobraCmd, _, _, _ := setupGenerateResourceTestCommand(t)
+
+	cobraCmd.SetArgs([]string{"post"})
+	if err := cobraCmd.Execute(); err == nil {
+		t.Fatal("expected error when no fields are given")
+	}
+}
+
+func TestGenerateResourceCommand_InvalidField(t *testing.T) {
+	cobraCmd, _, _, _ := setupGenerateResourceTestCommand(t)
+
+	cobraCmd.SetArgs([]string{"post", "title:uuid"})
+	err := cobraCmd.Execute()
+	if err == nil {
+		t.Fatal("expected error for unknown field type")
+	}
+	if !strings.Contains(err.Error(), "invalid fields") {
+		t.Errorf("expected invalid fields error, got %v", err)
+	}
+}
+
+func TestGenerateResourceCommand_NotInProject(t *testing.T) {
+	cobraCmd, mockDetector, _, _ := setupGenerateResourceTestCommand(t)
+
+	mockDetector.On("Detect", mock.Anything, ".").
+		Return(nil, "", errors.New("no .tracks.yaml found")).Once()
+
+	cobraCmd.SetArgs([]string{"post", "title:string"})
+	err := cobraCmd.Execute()
+	if err == nil {
+		t.Fatal("expected error outside a project")
+	}
+	if !strings.Contains(err.Error(), "not in a Tracks project") {
+		t.Errorf("expected project error, got %v", err)
+	}
+}
+
+func TestGenerateResourceCommand_ValidationError(t *testing.T) {
+	cobraCmd, mockDetector, mockGenerator, _ := setupGenerateResourceTestCommand(t)
+
+	project := &interfaces.TracksProject{Name: "testapp", ModulePath: "github.com/test/app", DBDriver: "go-libsql"}
+	mockDetector.On("Detect", mock.Anything, ".").Return(project, "/tmp/testapp", nil).Once()
+	mockGenerator.On("Validate", mock.Anything).Return(errors.New("internal/db/queries/posts.sql already exists")).Once()
+
+	cobraCmd.SetArgs([]string{"post", "title:string"})
+	err := cobraCmd.Execute()
+	if err == nil {
+		t.Fatal("expected validation error")
+	}
+	if !strings.Contains(err.Error(), "already exists") {
+		t.Errorf("expected validation error to be surfaced, got %v", err)
+	}
+}
+
+func TestGenerateResourceCommand_Success(t *testing.T) {
+	cobraCmd, mockDetector, mockGenerator, mockRenderer := setupGenerateResourceTestCommand(t)
+
+	project := &interfaces.TracksProject{Name: "testapp", ModulePath: "github.com/test/app", DBDriver: "postgres"}
+	mockDetector.On("Detect", mock.Anything, ".").Return(project, "/tmp/testapp", nil).Once()
+
+	expectedCfg := generator.ResourceConfig{
+		Name:           "post",
+		Fields:         []generator.Field{{Name: "title", Type: generator.FieldString}, {Name: "published", Type: generator.FieldBool}},
+		ProjectDir:     "/tmp/testapp",
+		ModulePath:     "github.com/test/app",
+		DatabaseDriver: "postgres",
+		Force:          true,
+	}
+	files := []interfaces.GeneratedFile{
+		{Path: "internal/db/queries/posts.sql", Action: interfaces.FileActionCreate},
+		{Path: "cmd/server/main.go", Action: interfaces.FileActionUpdate},
+	}
+	mockGenerator.On("Validate", expectedCfg).Return(nil).Once()
+	mockGenerator.On("Generate", mock.Anything, expectedCfg).Return(files, nil).Once()
+
+	mockRenderer.On("Title", "Generating resource: post").Once()
+	mockRenderer.On("Table", mock.MatchedBy(func(tbl interfaces.Table) bool {
+		return len(tbl.Rows) == 2 && tbl.Rows[1][0] == "cmd/server/main.go" && tbl.Rows[1][1] == "update"
+	})).Once()
+	mockRenderer.On("Section", mock.MatchedBy(func(s interfaces.Section) bool {
+		return s.Title == "Next steps" &&
+			strings.Contains(s.Body, "tracks db migrate") &&
+			!strings.Contains(s.Body, "make generate") &&
+			!strings.Contains(s.Body, "manually")
+	})).Once()
+	mockRenderer.On("Flush").Return(nil).Once()
+
+	cobraCmd.SetArgs([]string{"post", "title:string", "published:bool", "--force"})
+	if err := cobraCmd.Execute(); err != nil {
+		t.Fatalf("execution failed: %v", err)
+	}
+}
+
+func TestGenerateResourceCommand_SkipGenerate_ManualWiring(t *testing.T) {
+	cobraCmd, mockDetector, mockGenerator, mockRenderer := setupGenerateResourceTestCommand(t)
+
+	project := &interfaces.TracksProject{Name: "testapp", ModulePath: "github.com/test/app", DBDriver: "go-libsql"}
+	mockDetector.On("Detect", mock.Anything, ".").Return(project, "/tmp/testapp", nil).Once()
+
+	files := []interfaces.GeneratedFile{
+		{Path: "cmd/server/main.go", Action: interfaces.FileActionSkip, Detail: "missing TRACKS:SERVER_SERVICES in cmd/server/main.go; wire the resource manually"},
+	}
+	mockGenerator.On("Validate", mock.MatchedBy(func(cfg generator.ResourceConfig) bool { return cfg.SkipGenerate })).Return(nil).Once()
+	mockGenerator.On("Generate", mock.Anything, mock.Anything).Return(files, nil).Once()
+
+	mockRenderer.On("Title", mock.Anything).Once()
+	mockRenderer.On("Table", mock.Anything).Once()
+	mockRenderer.On("Section", mock.MatchedBy(func(s interfaces.Section) bool {
+		return strings.Contains(s.Body, "1. make generate") &&
+			strings.Contains(s.Body, "manually") &&
+			strings.Contains(s.Body, "tracks db migrate")
+	})).Once()
+	mockRenderer.On("Flush").Return(nil).Once()
+
+	cobraCmd.SetArgs([]string{"post", "title:string", "--skip-generate"})
+	if err := cobraCmd.Execute(); err != nil {
+		t.Fatalf("execution failed: %v", err)
+	}
+}
+
+func TestGenerateResourceCommand_GenerateError(t *testing.T) {
+	cobraCmd, mockDetector, mockGenerator, mockRenderer := setupGenerateResourceTestCommand(t)
+
+	project := &interfaces.TracksProject{Name: "testapp", ModulePath: "github.com/test/app", DBDriver: "go-libsql"}
+	mockDetector.On("Detect", mock.Anything, ".").Return(project, "/tmp/testapp", nil).Once()
+
+	files := []interfaces.GeneratedFile{{Path: "internal/db/queries/posts.sql", Action: interfaces.FileActionCreate}}
+	mockGenerator.On("Validate", mock.Anything).Return(nil).Once()
+	mockGenerator.On("Generate", mock.Anything, mock.Anything).
+		Return(files, errors.New("failed to generate mocks and SQL code: exit status 2")).Once()
+
+	mockRenderer.On("Title", mock.Anything).Once()
+	mockRenderer.On("Table", mock.Anything).Once()
+	mockRenderer.On("Flush").Return(nil).Once()
+
+	cobraCmd.SetArgs([]string{"post", "title:string"})
+	err := cobraCmd.Execute()
+	if err == nil {
+		t.Fatal("expected generate error")
+	}
+	if !strings.Contains(err.Error(), "failed to generate resource") {
+		t.Errorf("expected wrapped generate error, got %v", err)
+	}
+}
@@ -0,0 +1,96 @@
+package commands
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	"github.com/anomalousventures/tracks/tests/mocks"
+	"github.com/spf13/cobra"
+)
+
+func TestNewGenerateCommand(t *testing.T) {
+	mockDetector := mocks.NewMockProjectDetector(t)
+	mockGenerator := mocks.NewMockResourceGenerator(t)
+	mockMigrationGenerator := mocks.NewMockMigrationGenerator(t)
+	mockCIGenerator := mocks.NewMockCIGenerator(t)
+	mockRenderer := mocks.NewMockRenderer(t)
+
+	factory := func(*cobra.Command) interfaces.Renderer {
+		return mockRenderer
+	}
+	flusher := func(*cobra.Command, interfaces.Renderer) {}
+
+	generateCmd := NewGenerateCommand(mockDetector, mockGenerator, mockMigrationGenerator, mockCIGenerator, factory, flusher)
+
+	if generateCmd == nil {
+		t.Fatal("NewGenerateCommand returned nil")
+	}
+
+	cobraCmd := generateCmd.Command()
+	if cobraCmd == nil {
+		t.Fatal("Command() returned nil - DI may have failed")
+	}
+}
+
+func TestGenerateCommand_Command(t *testing.T) {
+	mockDetector := mocks.NewMockProjectDetector(t)
+	mockGenerator := mocks.NewMockResourceGenerator(t)
+	mockMigrationGenerator := mocks.NewMockMigrationGenerator(t)
+	mockCIGenerator := mocks.NewMockCIGenerator(t)
+	mockRenderer := mocks.NewMockRenderer(t)
+
+	factory := func(*cobra.Command) interfaces.Renderer { return mockRenderer }
+	flusher := func(*cobra.Command, interfaces.Renderer) {}
+
+	cobraCmd := NewGenerateCommand(mockDetector, mockGenerator, mockMigrationGenerator, mockCIGenerator, factory, flusher).Command()
+
+	if cobraCmd.Use != "generate" {
+		t.Errorf("expected Use 'generate', got %q", cobraCmd.Use)
+	}
+
+	if len(cobraCmd.Aliases) != 1 || cobraCmd.Aliases[0] != "g" {
+		t.Errorf("expected alias 'g', got %v", cobraCmd.Aliases)
+	}
+
+	if cobraCmd.Short == "" || cobraCmd.Long == "" || cobraCmd.Example == "" {
+		t.Error("expected Short, Long and Example to be set")
+	}
+
+	for _, name := range []string{"resource", "migration", "ci"} {
+		found := false
+		for _, sub := range cobraCmd.Commands() {
+			if sub.Name() == name {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("expected %q subcommand", name)
+		}
+	}
+}
+
+func TestGenerateCommand_Run_ShowsHelp(t *testing.T) {
+	mockDetector := mocks.NewMockProjectDetector(t)
+	mockGenerator := mocks.NewMockResourceGenerator(t)
+	mockMigrationGenerator := mocks.NewMockMigrationGenerator(t)
+	mockCIGenerator := mocks.NewMockCIGenerator(t)
+	mockRenderer := mocks.NewMockRenderer(t)
+
+	factory := func(*cobra.Command) interfaces.Renderer { return mockRenderer }
+	flusher := func(*cobra.Command, interfaces.Renderer) {}
+
+	cobraCmd := NewGenerateCommand(mockDetector, mockGenerator, mockMigrationGenerator, mockCIGenerator, factory, flusher).Command()
+	out := new(bytes.Buffer)
+	cobraCmd.SetOut(out)
+	cobraCmd.SetErr(new(bytes.Buffer))
+	cobraCmd.SetArgs([]string{})
+
+	if err := cobraCmd.Execute(); err != nil {
+		t.Fatalf("execution failed: %v", err)
+	}
+
+	if !bytes.Contains(out.Bytes(), []byte("resource")) {
+		t.Errorf("expected help output to list the resource subcommand, got %q", out.String())
+	}
+}
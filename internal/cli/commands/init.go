@@ -0,0 +1,228 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	"github.com/anomalousventures/tracks/internal/generator"
+	"github.com/spf13/cobra"
+)
+
+// InitCommand represents the 'init' command.
+type InitCommand struct {
+	validator     interfaces.Validator
+	initializer   interfaces.ProjectInitializer
+	newRenderer   RendererFactory
+	flushRenderer RendererFlusher
+	newPrompter   PrompterFactory
+}
+
+// NewInitCommand creates a new instance of the 'init' command with injected dependencies.
+func NewInitCommand(
+	validator interfaces.Validator,
+	initializer interfaces.ProjectInitializer,
+	newRenderer RendererFactory,
+	flushRenderer RendererFlusher,
+	newPrompter PrompterFactory,
+) *InitCommand {
+	return &InitCommand{
+		validator:     validator,
+		initializer:   initializer,
+		newRenderer:   newRenderer,
+		flushRenderer: flushRenderer,
+		newPrompter:   newPrompter,
+	}
+}
+
+// Command returns the cobra.Command for the 'init' command.
+func (c *InitCommand) Command() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "init",
+		Short: "Adopt an existing Go module as a Tracks project",
+		Long: `Write .tracks.yaml into an existing Go module so the Tracks commands
+(generate, db, ui, status) work in it.
+
+The module path is read from go.mod and the database driver from the
+imports of the module's Go files. Features are recorded from the files the
+module already has: templUI for .templui.json, Node.js for package.json,
+Docker for a Dockerfile, and the CI provider of .github/workflows or
+.gitlab-ci.yml.
+
+With --makefile, the Tracks Makefile targets the module lacks are appended
+to its Makefile, or a Makefile is written if it has none. With
+--directories, the missing directories of the Tracks layout are created.
+
+An empty .tracks/manifest.json marks the project as adopted: upgrades only
+touch the files Tracks writes into it later, never the module's own files.
+
+Existing files are never changed without confirmation. Without a terminal,
+in CI or with JSON output, pass --yes to confirm.`,
+		Example: `  # Adopt the module in the current directory
+  tracks init
+
+  # Also add the missing Makefile targets and directories
+  tracks init --makefile --directories
+
+  # Set what cannot be detected
+  tracks init --name api --db postgres --env-prefix API`,
+		Args: cobra.NoArgs,
+		RunE: c.runE,
+	}
+
+	cmd.Flags().String("name", "", "Project name (default: last element of the module path)")
+	cmd.Flags().String("db", "", "Database driver: go-libsql, sqlite3 or postgres (default: detected from imports)")
+	cmd.Flags().String("env-prefix", "APP", "Prefix of the project's environment variables (e.g., APP_DATABASE_URL)")
+	cmd.Flags().Bool("makefile", false, "Add the missing Tracks Makefile targets")
+	cmd.Flags().Bool("directories", false, "Create the missing Tracks directories")
+
+	return cmd
+}
+
+func (c *InitCommand) runE(cmd *cobra.Command, _ []string) error {
+	ctx := cmd.Context()
+
+	name, _ := cmd.Flags().GetString("name")
+	driver, _ := cmd.Flags().GetString("db")
+	envPrefix, _ := cmd.Flags().GetString("env-prefix")
+	makefile, _ := cmd.Flags().GetBool("makefile")
+	directories, _ := cmd.Flags().GetBool("directories")
+
+	info, err := c.initializer.Inspect(ctx, ".")
+	if errors.Is(err, generator.ErrNoGoModule) {
+		return withCode(CodeNotInModule, errors.New("not in a Go module (no go.mod found); use 'tracks new' to create a project"))
+	}
+	if err != nil {
+		return withCode(CodeConfig, fmt.Errorf("failed to inspect module: %w", err))
+	}
+
+	if name == "" {
+		name = projectNameFromModule(info.ModulePath)
+	}
+	if err := c.validator.ValidateProjectName(ctx, name); err != nil {
+		return withCode(CodeInvalidArgument, fmt.Errorf("%w; set one with --name", err))
+	}
+	if err := c.validator.ValidateEnvPrefix(ctx, envPrefix); err != nil {
+		return withCode(CodeInvalidArgument, err)
+	}
+
+	prompter := c.newPrompter(cmd)
+	if driver == "" {
+		driver = info.DBDriver
+	}
+	if driver == "" {
+		description := "No database driver was found in the module's imports."
+		if len(info.Drivers) > 1 {
+			description = fmt.Sprintf("The module imports several database drivers: %s.", strings.Join(info.Drivers, ", "))
+		}
+		driver, err = prompter.Select(ctx, interfaces.SelectPrompt{
+			Title:       "Database driver?",
+			Description: description,
+			Options:     []string{"go-libsql", "sqlite3", "postgres"},
+			Default:     "go-libsql",
+		})
+		if err := promptError(err); err != nil {
+			return err
+		}
+	}
+	if err := c.validator.ValidateDatabaseDriver(ctx, driver); err != nil {
+		return withCode(CodeInvalidArgument, err)
+	}
+
+	cfg := generator.InitConfig{
+		ProjectDir:     info.Dir,
+		ProjectName:    name,
+		ModulePath:     info.ModulePath,
+		DatabaseDriver: driver,
+		EnvPrefix:      envPrefix,
+		Features:       info.Features,
+		Makefile:       makefile,
+		Directories:    directories,
+	}
+
+	plan, err := c.initializer.Plan(ctx, cfg)
+	if err != nil {
+		return withCode(CodeGenerationFailed, fmt.Errorf("failed to plan init: %w", err))
+	}
+	cfg.Keep, err = confirmChanges(ctx, prompter, plan)
+	if err != nil {
+		return err
+	}
+
+	files, err := c.initializer.Init(ctx, cfg)
+	if err != nil {
+		return withCode(CodeGenerationFailed, fmt.Errorf("failed to initialize project: %w", err))
+	}
+
+	r := c.newRenderer(cmd)
+	defer c.flushRenderer(cmd, r)
+
+	r.Title(fmt.Sprintf("Initialized Tracks project: %s", name))
+	r.Table(generatedFilesTable(files))
+	r.Result(InitResult{
+		Name:           name,
+		ModulePath:     info.ModulePath,
+		DatabaseDriver: driver,
+		Files:          generatedFiles(files),
+	})
+	r.Section(interfaces.Section{
+		Title: "Next steps",
+		Body:  "  1. Review .tracks.yaml and commit it\n  2. tracks generate resource <name> <field:type>...",
+	})
+
+	return nil
+}
+
+// confirmChanges asks before each change to an existing file in plan and
+// returns the files whose change was declined. Canceling a prompt aborts
+// the command.
+func confirmChanges(ctx context.Context, prompter interfaces.Prompter, plan []interfaces.GeneratedFile) ([]string, error) {
+	var keep []string
+	for _, file := range plan {
+		if file.Action != interfaces.FileActionUpdate {
+			continue
+		}
+		confirmed, err := prompter.Confirm(ctx, interfaces.ConfirmPrompt{
+			Title:       fmt.Sprintf("Change the existing %s?", file.Path),
+			Description: file.Detail,
+		})
+		if err := promptError(err); err != nil {
+			return nil, err
+		}
+		if !confirmed {
+			keep = append(keep, file.Path)
+		}
+	}
+	return keep, nil
+}
+
+// promptError turns a prompt's error into the command's error.
+func promptError(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, interfaces.ErrPromptUnavailable):
+		return withCode(CodeConfirmationRequired, err)
+	case errors.Is(err, interfaces.ErrPromptCanceled):
+		return err
+	default:
+		return fmt.Errorf("failed to read answer: %w", err)
+	}
+}
+
+// majorVersionSuffix matches the major version element of a module path.
+var majorVersionSuffix = regexp.MustCompile(`^v[0-9]+$`)
+
+// projectNameFromModule returns the last element of modulePath, skipping a
+// major version suffix, in lower case.
+func projectNameFromModule(modulePath string) string {
+	name := path.Base(modulePath)
+	if majorVersionSuffix.MatchString(name) {
+		name = path.Base(path.Dir(modulePath))
+	}
+	return strings.ToLower(name)
+}
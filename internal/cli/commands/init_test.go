@@ -0,0 +1,199 @@
+package commands
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	"github.com/anomalousventures/tracks/internal/cli/renderer/prompttest"
+	"github.com/anomalousventures/tracks/internal/generator"
+	"github.com/anomalousventures/tracks/tests/mocks"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/mock"
+)
+
+func setupInitTestCommand(t *testing.T, prompter interfaces.Prompter) (*cobra.Command, *mocks.MockProjectInitializer) {
+	mockValidator := mocks.NewMockValidator(t)
+	mockValidator.On("ValidateProjectName", mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockValidator.On("ValidateEnvPrefix", mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockValidator.On("ValidateDatabaseDriver", mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockInitializer := mocks.NewMockProjectInitializer(t)
+
+	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Title", mock.Anything).Return().Maybe()
+	mockRenderer.On("Table", mock.Anything).Return().Maybe()
+	mockRenderer.On("Result", mock.Anything).Return().Maybe()
+	mockRenderer.On("Section", mock.Anything).Return().Maybe()
+
+	factory := func(*cobra.Command) interfaces.Renderer { return mockRenderer }
+	flusher := func(*cobra.Command, interfaces.Renderer) {}
+	newPrompter := func(*cobra.Command) interfaces.Prompter { return prompter }
+
+	cobraCmd := NewInitCommand(mockValidator, mockInitializer, factory, flusher, newPrompter).Command()
+	cobraCmd.SetOut(new(bytes.Buffer))
+	cobraCmd.SetErr(new(bytes.Buffer))
+
+	return cobraCmd, mockInitializer
+}
+
+func TestInitCommand_Command(t *testing.T) {
+	cobraCmd, _ := setupInitTestCommand(t, prompttest.NewPrompter())
+
+	if cobraCmd.Use != "init" {
+		t.Errorf("expected Use 'init', got %q", cobraCmd.Use)
+	}
+	if cobraCmd.Short == "" || cobraCmd.Long == "" || cobraCmd.Example == "" {
+		t.Error("expected Short, Long and Example to be set")
+	}
+	for _, flag := range []string{"name", "db", "env-prefix", "makefile", "directories"} {
+		if cobraCmd.Flags().Lookup(flag) == nil {
+			t.Errorf("expected --%s flag", flag)
+		}
+	}
+}
+
+func TestInitCommand_NotInModule(t *testing.T) {
+	cobraCmd, mockInitializer := setupInitTestCommand(t, prompttest.NewPrompter())
+	mockInitializer.On("Inspect", mock.Anything, ".").Return(nil, generator.ErrNoGoModule).Once()
+
+	cobraCmd.SetArgs([]string{})
+	err := cobraCmd.Execute()
+	if ErrorCode(err) != CodeNotInModule {
+		t.Fatalf("expected %s, got %v", CodeNotInModule, err)
+	}
+}
+
+func TestInitCommand_DetectedModule(t *testing.T) {
+	cobraCmd, mockInitializer := setupInitTestCommand(t, prompttest.NewPrompter())
+
+	info := &interfaces.ModuleInfo{
+		Dir:        "/src/legacy",
+		ModulePath: "github.com/example/legacy/v2",
+		DBDriver:   "postgres",
+		Features:   interfaces.ProjectFeatures{Docker: true, CI: "gitlab"},
+	}
+	mockInitializer.On("Inspect", mock.Anything, ".").Return(info, nil).Once()
+
+	expectedCfg := generator.InitConfig{
+		ProjectDir:     "/src/legacy",
+		ProjectName:    "legacy",
+		ModulePath:     "github.com/example/legacy/v2",
+		DatabaseDriver: "postgres",
+		EnvPrefix:      "APP",
+		Features:       info.Features,
+		Makefile:       true,
+	}
+	files := []interfaces.GeneratedFile{{Path: ".tracks.yaml", Action: interfaces.FileActionCreate}}
+	mockInitializer.On("Plan", mock.Anything, expectedCfg).Return(files, nil).Once()
+	mockInitializer.On("Init", mock.Anything, expectedCfg).Return(files, nil).Once()
+
+	cobraCmd.SetArgs([]string{"--makefile"})
+	if err := cobraCmd.Execute(); err != nil {
+		t.Fatalf("execution failed: %v", err)
+	}
+}
+
+func TestInitCommand_ConfirmsChanges(t *testing.T) {
+	prompter := prompttest.NewPrompter()
+	prompter.Answer("Database driver?", "sqlite3")
+	prompter.Answer("Change the existing .tracks.yaml?", false)
+	prompter.Answer("Change the existing Makefile?", true)
+	cobraCmd, mockInitializer := setupInitTestCommand(t, prompter)
+
+	info := &interfaces.ModuleInfo{Dir: "/src/legacy", ModulePath: "github.com/example/legacy"}
+	mockInitializer.On("Inspect", mock.Anything, ".").Return(info, nil).Once()
+
+	plan := []interfaces.GeneratedFile{
+		{Path: ".tracks.yaml", Action: interfaces.FileActionUpdate, Detail: "replaces the existing file"},
+		{Path: "Makefile", Action: interfaces.FileActionUpdate, Detail: "adds targets: ci"},
+		{Path: "internal/db/queries/", Action: interfaces.FileActionCreate},
+	}
+	mockInitializer.On("Plan", mock.Anything, mock.Anything).Return(plan, nil).Once()
+	mockInitializer.On("Init", mock.Anything, mock.MatchedBy(func(cfg generator.InitConfig) bool {
+		return cfg.DatabaseDriver == "sqlite3" && len(cfg.Keep) == 1 && cfg.Keep[0] == ".tracks.yaml"
+	})).Return(plan, nil).Once()
+
+	cobraCmd.SetArgs([]string{"--makefile", "--directories"})
+	if err := cobraCmd.Execute(); err != nil {
+		t.Fatalf("execution failed: %v", err)
+	}
+
+	want := []string{"Database driver?", "Change the existing .tracks.yaml?", "Change the existing Makefile?"}
+	if got := prompter.Asked(); strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("asked %v, want %v", got, want)
+	}
+}
+
+func TestInitCommand_SeveralDrivers(t *testing.T) {
+	mockPrompter := mocks.NewMockPrompter(t)
+	mockPrompter.On("Select", mock.Anything, mock.MatchedBy(func(p interfaces.SelectPrompt) bool {
+		return p.Description == "The module imports several database drivers: postgres, sqlite3."
+	})).Return("postgres", nil).Once()
+	cobraCmd, mockInitializer := setupInitTestCommand(t, mockPrompter)
+
+	info := &interfaces.ModuleInfo{Dir: "/src/legacy", ModulePath: "github.com/example/legacy", Drivers: []string{"postgres", "sqlite3"}}
+	mockInitializer.On("Inspect", mock.Anything, ".").Return(info, nil).Once()
+	files := []interfaces.GeneratedFile{{Path: ".tracks.yaml", Action: interfaces.FileActionCreate}}
+	mockInitializer.On("Plan", mock.Anything, mock.Anything).Return(files, nil).Once()
+	mockInitializer.On("Init", mock.Anything, mock.MatchedBy(func(cfg generator.InitConfig) bool {
+		return cfg.DatabaseDriver == "postgres"
+	})).Return(files, nil).Once()
+
+	cobraCmd.SetArgs([]string{})
+	if err := cobraCmd.Execute(); err != nil {
+		t.Fatalf("execution failed: %v", err)
+	}
+}
+
+func TestInitCommand_CancelAborts(t *testing.T) {
+	prompter := prompttest.NewPrompter()
+	prompter.Fail("Change the existing .tracks.yaml?", interfaces.ErrPromptCanceled)
+	cobraCmd, mockInitializer := setupInitTestCommand(t, prompter)
+
+	info := &interfaces.ModuleInfo{Dir: "/src/legacy", ModulePath: "github.com/example/legacy", DBDriver: "postgres"}
+	mockInitializer.On("Inspect", mock.Anything, ".").Return(info, nil).Once()
+	plan := []interfaces.GeneratedFile{
+		{Path: ".tracks.yaml", Action: interfaces.FileActionUpdate},
+		{Path: "Makefile", Action: interfaces.FileActionUpdate},
+	}
+	mockInitializer.On("Plan", mock.Anything, mock.Anything).Return(plan, nil).Once()
+
+	cobraCmd.SetArgs([]string{"--makefile"})
+	err := cobraCmd.Execute()
+	if !errors.Is(err, interfaces.ErrPromptCanceled) {
+		t.Fatalf("expected the cancel to abort, got %v", err)
+	}
+	if got := prompter.Asked(); len(got) != 1 {
+		t.Errorf("expected no prompts after the cancel, asked %v", got)
+	}
+}
+
+func TestInitCommand_ConfirmationRequired(t *testing.T) {
+	cobraCmd, mockInitializer := setupInitTestCommand(t, prompttest.NewPrompter())
+
+	info := &interfaces.ModuleInfo{Dir: "/src/legacy", ModulePath: "github.com/example/legacy", DBDriver: "go-libsql"}
+	mockInitializer.On("Inspect", mock.Anything, ".").Return(info, nil).Once()
+	plan := []interfaces.GeneratedFile{{Path: ".tracks.yaml", Action: interfaces.FileActionUpdate}}
+	mockInitializer.On("Plan", mock.Anything, mock.Anything).Return(plan, nil).Once()
+
+	cobraCmd.SetArgs([]string{})
+	err := cobraCmd.Execute()
+	if ErrorCode(err) != CodeConfirmationRequired {
+		t.Fatalf("expected %s, got %v", CodeConfirmationRequired, err)
+	}
+}
+
+func TestProjectNameFromModule(t *testing.T) {
+	tests := map[string]string{
+		"github.com/example/Legacy":    "legacy",
+		"github.com/example/legacy/v3": "legacy",
+		"legacy":                       "legacy",
+	}
+	for modulePath, want := range tests {
+		if got := projectNameFromModule(modulePath); got != want {
+			t.Errorf("projectNameFromModule(%q) = %q, want %q", modulePath, got, want)
+		}
+	}
+}
@@ -1,11 +1,21 @@
 package commands
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"os"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
 
 	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	trackscontext "github.com/anomalousventures/tracks/internal/context"
 	"github.com/anomalousventures/tracks/internal/generator"
+	"github.com/anomalousventures/tracks/internal/generator/steps"
 	"github.com/spf13/cobra"
 )
 
@@ -15,26 +25,56 @@ type RendererFactory func(*cobra.Command) interfaces.Renderer
 // RendererFlusher flushes a renderer and handles errors.
 type RendererFlusher func(*cobra.Command, interfaces.Renderer)
 
+// PrompterFactory creates a prompter from a cobra command.
+type PrompterFactory func(*cobra.Command) interfaces.Prompter
+
+// ProjectWizardFactory creates the 'tracks new' wizard from a cobra
+// command. It returns an error wrapping interfaces.ErrPromptUnavailable when
+// the wizard cannot run, e.g. without a terminal.
+type ProjectWizardFactory func(*cobra.Command) (interfaces.ProjectWizard, error)
+
 // Follows ADR-001 dependency injection pattern: command struct with injected dependencies.
 type NewCommand struct {
 	validator     interfaces.Validator
 	generator     interfaces.ProjectGenerator
 	newRenderer   RendererFactory
 	flushRenderer RendererFlusher
+	newPrompter   PrompterFactory
+	newWizard     ProjectWizardFactory
 
 	// Flags
-	dbDriver   string
-	modulePath string
-	noGit      bool
+	dbDriver      string
+	modulePath    string
+	envPrefix     string
+	outputPath    string
+	goVersion     string
+	profile       string
+	noUI          bool
+	noNode        bool
+	noDocker      bool
+	noExamples    bool
+	ci            string
+	noGit         bool
+	keepOnFailure bool
+	noCache       bool
+	dryRun        bool
+	starter       string
+	vars          []string
+	configFile    string
+
+	// answerVars are the starter kit variables of the --config file.
+	answerVars map[string]string
 }
 
 // Follows ADR-001: constructor accepts all dependencies as parameters.
-func NewNewCommand(validator interfaces.Validator, generator interfaces.ProjectGenerator, newRenderer RendererFactory, flushRenderer RendererFlusher) *NewCommand {
+func NewNewCommand(validator interfaces.Validator, generator interfaces.ProjectGenerator, newRenderer RendererFactory, flushRenderer RendererFlusher, newPrompter PrompterFactory, newWizard ProjectWizardFactory) *NewCommand {
 	return &NewCommand{
 		validator:     validator,
 		generator:     generator,
 		newRenderer:   newRenderer,
 		flushRenderer: flushRenderer,
+		newPrompter:   newPrompter,
+		newWizard:     newWizard,
 	}
 }
 
@@ -45,6 +85,10 @@ func (c *NewCommand) Command() *cobra.Command {
 		Short: "Create a new Tracks application",
 		Long: `Create a new Tracks application with the specified project name.
 
+Without a project name, an interactive wizard asks for each option,
+validating answers as you type, and shows the generation's progress. The
+wizard needs a terminal; --interactive forces it without one.
+
 This command generates a complete Go web application with:
   - Proper project structure following Go best practices
   - Type-safe templates using templ
@@ -57,6 +101,9 @@ The generated application is production-ready and follows idiomatic Go patterns.
 		Example: `  # Create a new application with default settings
   tracks new myapp
 
+  # Choose every option in the interactive wizard
+  tracks new
+
   # Specify database driver
   tracks new myapp --db postgres
 
@@ -66,69 +113,347 @@ The generated application is production-ready and follows idiomatic Go patterns.
   # Skip git initialization
   tracks new myapp --no-git
 
+  # Keep the partial project if generation fails
+  tracks new myapp --keep-on-failure
+
+  # Install templUI components again instead of using the cache
+  tracks new myapp --no-cache
+
+  # Preview the files and steps without writing anything
+  tracks new myapp --db postgres --dry-run
+
+  # Start from a starter kit, setting one of its variables
+  tracks new myapp --starter ./saas-kit.tar.gz --var Plan=pro
+
+  # Generate from an answers file, e.g. in CI
+  tracks new --config answers.yaml
+
+  # Pin the Go version and create the project in another directory
+  tracks new myapp --go-version 1.24 --output-path ./services
+
+  # A JSON API without templUI, Node.js or the examples, on GitLab CI
+  tracks new myapi --profile api --ci gitlab
+
+  # The full project without Docker
+  tracks new myapp --no-docker
+
   # Combine flags
   tracks new myapp --db postgres --module github.com/myorg/myapp --no-git`,
-		Args: cobra.ExactArgs(1),
+		Args: cobra.MaximumNArgs(1),
 		RunE: c.run,
 	}
 
 	// Add flags
 	cmd.Flags().StringVar(&c.dbDriver, "db", "go-libsql", "Database driver (go-libsql|sqlite3|postgres)")
 	cmd.Flags().StringVar(&c.modulePath, "module", "", "Go module path (e.g., github.com/user/project)")
+	cmd.Flags().StringVar(&c.envPrefix, "env-prefix", "APP", "Prefix of the project's environment variables (e.g., APP_DATABASE_URL)")
+	cmd.Flags().StringVar(&c.outputPath, "output-path", ".", "Directory to create the project directory in")
+	cmd.Flags().StringVar(&c.goVersion, "go-version", generator.DefaultGoVersion, "Go version of the generated go.mod")
+	cmd.Flags().StringVar(&c.profile, "profile", generator.ProfileFull, "Feature profile (full|api|minimal)")
+	cmd.Flags().BoolVar(&c.noUI, "no-ui", false, "Skip templUI components and use plain HTML")
+	cmd.Flags().BoolVar(&c.noNode, "no-node", false, "Skip the Node.js asset pipeline (Tailwind, esbuild)")
+	cmd.Flags().BoolVar(&c.noDocker, "no-docker", false, "Skip the Dockerfile and docker-compose.yml")
+	cmd.Flags().BoolVar(&c.noExamples, "no-examples", false, "Skip the HTMX counter example")
+	cmd.Flags().StringVar(&c.ci, "ci", "", "CI provider (github|gitlab|none); defaults to the profile's")
 	cmd.Flags().BoolVar(&c.noGit, "no-git", false, "Skip git repository initialization")
+	cmd.Flags().BoolVar(&c.keepOnFailure, "keep-on-failure", false, "Keep the partially generated project if generation fails")
+	cmd.Flags().BoolVar(&c.noCache, "no-cache", false, "Don't reuse cached step outputs from earlier generations")
+	cmd.Flags().BoolVar(&c.dryRun, "dry-run", false, "List the files and steps that would be generated without writing anything")
+	cmd.Flags().StringVar(&c.starter, "starter", "", "Starter kit directory or archive (.zip, .tar.gz) to generate from")
+	cmd.Flags().StringArrayVar(&c.vars, "var", nil, "Starter kit variable as NAME=value (repeatable)")
+	cmd.Flags().StringVar(&c.configFile, "config", "", "Answers file (YAML) with the project options; flags override it")
 
 	return cmd
 }
 
 func (c *NewCommand) run(cmd *cobra.Command, args []string) error {
-	ctx := cmd.Context()
-	projectName := args[0]
+	// Cancel generation on Ctrl-C so the generator can remove the partial
+	// project instead of the process dying mid-way.
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	var name string
+	if len(args) > 0 {
+		name = args[0]
+	}
+	if c.configFile != "" {
+		answeredName, err := c.loadAnswers(cmd)
+		if err != nil {
+			return err
+		}
+		if name == "" {
+			name = answeredName
+		}
+	}
+
+	opts := interfaces.ProjectOptions{
+		Name:           name,
+		ModulePath:     c.modulePath,
+		DatabaseDriver: c.dbDriver,
+		EnvPrefix:      c.envPrefix,
+		InitGit:        !c.noGit,
+		OutputPath:     c.outputPath,
+		GoVersion:      c.goVersion,
+		Profile:        c.profile,
+		NoUI:           c.noUI,
+		NoNode:         c.noNode,
+		NoDocker:       c.noDocker,
+		NoExamples:     c.noExamples,
+		CI:             c.ci,
+		Starter:        c.starter,
+	}
+
+	if _, err := featureConfig(opts).Features(); err != nil {
+		return withCode(CodeInvalidArgument, err)
+	}
+
+	if c.isSet(cmd, "go-version") {
+		if err := c.validator.ValidateGoVersion(ctx, opts.GoVersion); err != nil {
+			return withCode(CodeInvalidArgument, fmt.Errorf("invalid Go version: %w", err))
+		}
+	}
+
+	if opts.Name == "" {
+		starterVars, err := c.starterVars()
+		if err != nil {
+			return err
+		}
+		opts.StarterVars = starterVars
+		return c.runWizard(ctx, cmd, opts)
+	}
 
 	// Validate project name
-	if err := c.validator.ValidateProjectName(ctx, projectName); err != nil {
-		return fmt.Errorf("invalid project name: %w", err)
+	if err := c.validator.ValidateProjectName(ctx, opts.Name); err != nil {
+		return withCode(CodeInvalidArgument, fmt.Errorf("invalid project name: %w", err))
 	}
 
 	// Validate database driver
-	if err := c.validator.ValidateDatabaseDriver(ctx, c.dbDriver); err != nil {
-		return fmt.Errorf("invalid database driver: %w", err)
+	if err := c.validator.ValidateDatabaseDriver(ctx, opts.DatabaseDriver); err != nil {
+		return withCode(CodeInvalidArgument, fmt.Errorf("invalid database driver: %w", err))
+	}
+
+	// Validate provided module path; an empty one is generated from the
+	// project name
+	if opts.ModulePath != "" {
+		if err := c.validator.ValidateModulePath(ctx, opts.ModulePath); err != nil {
+			return withCode(CodeInvalidArgument, fmt.Errorf("invalid module path: %w", err))
+		}
 	}
 
-	// Validate or generate module path
-	if c.modulePath == "" {
-		// Auto-generate from project name
-		c.modulePath = fmt.Sprintf("example.com/%s", projectName)
-	} else {
-		// Validate provided module path
-		if err := c.validator.ValidateModulePath(ctx, c.modulePath); err != nil {
-			return fmt.Errorf("invalid module path: %w", err)
+	if c.isSet(cmd, "env-prefix") {
+		if err := c.validator.ValidateEnvPrefix(ctx, opts.EnvPrefix); err != nil {
+			return withCode(CodeInvalidArgument, fmt.Errorf("invalid env prefix: %w", err))
+		}
+	}
+
+	if c.isSet(cmd, "output-path") {
+		if err := c.validator.ValidateDirectory(ctx, filepath.Join(opts.OutputPath, opts.Name)); err != nil {
+			return withCode(CodeInvalidArgument, fmt.Errorf("invalid output path: %w", err))
+		}
+	}
+
+	starterVars, err := c.starterVars()
+	if err != nil {
+		return err
+	}
+	opts.StarterVars = starterVars
+
+	if opts.Starter != "" {
+		kit, err := c.generator.LoadStarter(ctx, opts.Starter)
+		if err != nil {
+			return withCode(CodeInvalidArgument, fmt.Errorf("failed to load starter kit: %w", err))
+		}
+		if err := c.promptStarterVars(ctx, cmd, kit, opts.StarterVars); err != nil {
+			return err
 		}
 	}
 
 	r := c.newRenderer(cmd)
+	if err := c.generate(ctx, opts, r); err != nil {
+		return err
+	}
+	c.flushRenderer(cmd, r)
+	return nil
+}
 
-	r.Title(fmt.Sprintf("Creating new Tracks application: %s", projectName))
+// loadAnswers reads the --config answers file. Its values replace the
+// defaults of the flags not given on the command line, so flags still win.
+// It returns the project name of the file, if any.
+func (c *NewCommand) loadAnswers(cmd *cobra.Command) (string, error) {
+	cfg := generator.ProjectConfig{
+		ModulePath:     c.modulePath,
+		DatabaseDriver: c.dbDriver,
+		EnvPrefix:      c.envPrefix,
+		InitGit:        !c.noGit,
+		OutputPath:     c.outputPath,
+		GoVersion:      c.goVersion,
+		Profile:        c.profile,
+		NoUI:           c.noUI,
+		NoNode:         c.noNode,
+		NoDocker:       c.noDocker,
+		NoExamples:     c.noExamples,
+		CI:             c.ci,
+		KeepOnFailure:  c.keepOnFailure,
+		NoCache:        c.noCache,
+		Starter:        c.starter,
+	}
+	if err := generator.LoadProjectConfig(c.configFile, &cfg); err != nil {
+		return "", withCode(CodeInvalidArgument, err)
+	}
+
+	flags := cmd.Flags()
+	answer := func(flag string, apply func()) {
+		if !flags.Changed(flag) {
+			apply()
+		}
+	}
+	answer("module", func() { c.modulePath = cfg.ModulePath })
+	answer("db", func() { c.dbDriver = cfg.DatabaseDriver })
+	answer("env-prefix", func() { c.envPrefix = cfg.EnvPrefix })
+	answer("no-git", func() { c.noGit = !cfg.InitGit })
+	answer("output-path", func() { c.outputPath = cfg.OutputPath })
+	answer("go-version", func() { c.goVersion = cfg.GoVersion })
+	answer("profile", func() { c.profile = cfg.Profile })
+	answer("no-ui", func() { c.noUI = cfg.NoUI })
+	answer("no-node", func() { c.noNode = cfg.NoNode })
+	answer("no-docker", func() { c.noDocker = cfg.NoDocker })
+	answer("no-examples", func() { c.noExamples = cfg.NoExamples })
+	answer("ci", func() { c.ci = cfg.CI })
+	answer("keep-on-failure", func() { c.keepOnFailure = cfg.KeepOnFailure })
+	answer("no-cache", func() { c.noCache = cfg.NoCache })
+	answer("starter", func() { c.starter = cfg.Starter })
+	c.answerVars = cfg.StarterVars
+	return cfg.ProjectName, nil
+}
+
+// featureConfig returns the feature choices of opts as a ProjectConfig, whose
+// Features method resolves and validates them.
+func featureConfig(opts interfaces.ProjectOptions) generator.ProjectConfig {
+	return generator.ProjectConfig{
+		Profile:    opts.Profile,
+		NoUI:       opts.NoUI,
+		NoNode:     opts.NoNode,
+		NoDocker:   opts.NoDocker,
+		NoExamples: opts.NoExamples,
+		CI:         opts.CI,
+	}
+}
+
+// isSet reports whether flag was given on the command line or may have been
+// set by the answers file, and so needs validating. Flag defaults are valid.
+func (c *NewCommand) isSet(cmd *cobra.Command, flag string) bool {
+	return c.configFile != "" || cmd.Flags().Changed(flag)
+}
+
+// starterVars returns the starter kit variables of the answers file and the
+// --var flags, which win. Both need --starter.
+func (c *NewCommand) starterVars() (map[string]string, error) {
+	vars, err := parseStarterVars(c.vars)
+	if err != nil {
+		return nil, withCode(CodeInvalidArgument, err)
+	}
+	for name, value := range c.answerVars {
+		if _, ok := vars[name]; !ok {
+			vars[name] = value
+		}
+	}
+	if len(vars) > 0 && c.starter == "" {
+		return nil, withCode(CodeInvalidArgument, fmt.Errorf("--var requires --starter"))
+	}
+	return vars, nil
+}
+
+// runWizard asks for the project options in the interactive wizard, which
+// then runs the generation. Quitting the wizard is not an error.
+func (c *NewCommand) runWizard(ctx context.Context, cmd *cobra.Command, opts interfaces.ProjectOptions) error {
+	wizard, err := c.newWizard(cmd)
+	if err != nil {
+		return withCode(CodeInvalidArgument, fmt.Errorf("requires a project name: %w", err))
+	}
+
+	_, err = wizard.Run(ctx, opts, c.generate)
+	if errors.Is(err, interfaces.ErrPromptCanceled) {
+		return nil
+	}
+	return err
+}
+
+// generate generates the project described by opts, or plans it with
+// --dry-run, rendering to r. opts must already be validated.
+func (c *NewCommand) generate(ctx context.Context, opts interfaces.ProjectOptions, r interfaces.Renderer) error {
+	ctx = trackscontext.WithOutput(ctx, r.Stream)
+
+	projectName := opts.Name
+	if opts.ModulePath == "" {
+		opts.ModulePath = fmt.Sprintf("example.com/%s", projectName)
+	}
+	if opts.OutputPath == "" {
+		opts.OutputPath = "."
+	}
+
+	title := fmt.Sprintf("Creating new Tracks application: %s", projectName)
+	if c.dryRun {
+		title += " (dry run)"
+	}
+	r.Title(title)
 	r.Section(interfaces.Section{
 		Body: fmt.Sprintf("Database: %s\nModule: %s\nGit: %t",
-			c.dbDriver, c.modulePath, !c.noGit),
+			opts.DatabaseDriver, opts.ModulePath, opts.InitGit),
 	})
 
+	// Steps finish concurrently; OnStep calls are serialized by the
+	// generator, so appending needs no lock.
+	var timings []steps.Result
+
 	// Generate the project
 	cfg := generator.ProjectConfig{
 		ProjectName:    projectName,
-		ModulePath:     c.modulePath,
-		DatabaseDriver: c.dbDriver,
-		EnvPrefix:      "APP",
-		InitGit:        !c.noGit,
-		OutputPath:     ".",
+		ModulePath:     opts.ModulePath,
+		DatabaseDriver: opts.DatabaseDriver,
+		EnvPrefix:      opts.EnvPrefix,
+		InitGit:        opts.InitGit,
+		OutputPath:     opts.OutputPath,
+		GoVersion:      opts.GoVersion,
+		Profile:        opts.Profile,
+		NoUI:           opts.NoUI,
+		NoNode:         opts.NoNode,
+		NoDocker:       opts.NoDocker,
+		NoExamples:     opts.NoExamples,
+		CI:             opts.CI,
+		KeepOnFailure:  c.keepOnFailure,
+		NoCache:        c.noCache,
+		Starter:        opts.Starter,
+		StarterVars:    opts.StarterVars,
+		OnStep: func(result steps.Result) {
+			timings = append(timings, result)
+		},
+		NewProgress: r.Progress,
 	}
 
 	if err := c.generator.Validate(cfg); err != nil {
-		return fmt.Errorf("validation failed: %w", err)
+		return withCode(CodeInvalidArgument, fmt.Errorf("validation failed: %w", err))
+	}
+
+	if c.dryRun {
+		plan, err := c.generator.Plan(ctx, cfg)
+		if err != nil {
+			return withCode(CodeGenerationFailed, fmt.Errorf("failed to plan project: %w", err))
+		}
+		renderPlan(r, plan)
+		r.Result(NewResult{
+			Name:           projectName,
+			Path:           filepath.Join(opts.OutputPath, projectName),
+			ModulePath:     opts.ModulePath,
+			DatabaseDriver: opts.DatabaseDriver,
+			DryRun:         true,
+			Steps:          []StepTiming{},
+			Plan:           plan,
+		})
+		return nil
 	}
 
 	if err := c.generator.Generate(ctx, cfg); err != nil {
-		return fmt.Errorf("failed to generate project: %w", err)
+		return withCode(CodeGenerationFailed, fmt.Errorf("failed to generate project: %w", err))
 	}
 
 	projectPath, err := generator.GetAbsolutePath(cfg.OutputPath, projectName)
@@ -147,16 +472,151 @@ func (c *NewCommand) run(cmd *cobra.Command, args []string) error {
 	successOutput := generator.RenderSuccessOutput(generator.SuccessOutput{
 		ProjectName:    projectName,
 		ProjectPath:    projectPath,
-		ModulePath:     c.modulePath,
-		DatabaseDriver: c.dbDriver,
+		ModulePath:     opts.ModulePath,
+		DatabaseDriver: opts.DatabaseDriver,
 		GitInitialized: gitInitialized,
 		NoColor:        false,
 	})
 
+	if len(timings) > 0 {
+		r.Table(stepTimingsTable(timings))
+	}
+
 	r.Section(interfaces.Section{
 		Body: successOutput,
 	})
+	r.Result(NewResult{
+		Name:           projectName,
+		Path:           projectPath,
+		ModulePath:     opts.ModulePath,
+		DatabaseDriver: opts.DatabaseDriver,
+		GitInitialized: gitInitialized,
+		Steps:          stepTimings(timings),
+	})
+	return nil
+}
 
-	c.flushRenderer(cmd, r)
+// parseStarterVars parses --var NAME=value flags.
+func parseStarterVars(flags []string) (map[string]string, error) {
+	vars := make(map[string]string, len(flags))
+	for _, flag := range flags {
+		name, value, ok := strings.Cut(flag, "=")
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid --var %q: expected NAME=value", flag)
+		}
+		vars[name] = value
+	}
+	return vars, nil
+}
+
+// promptStarterVars asks for each starter kit variable not set with --var
+// and stores the answers in vars. An empty answer keeps the kit's default.
+// Without a terminal, or in JSON or CI mode, the prompter fails unless --yes
+// accepts the defaults.
+func (c *NewCommand) promptStarterVars(ctx context.Context, cmd *cobra.Command, kit *interfaces.StarterKit, vars map[string]string) error {
+	prompter := c.newPrompter(cmd)
+	for _, v := range kit.Variables {
+		if _, ok := vars[v.Name]; ok {
+			continue
+		}
+
+		title := v.Prompt
+		if title == "" {
+			title = v.Name
+		}
+		prompt := interfaces.InputPrompt{
+			Title:       title,
+			Description: fmt.Sprintf("Starter kit variable %s; set it with --var %s=value.", v.Name, v.Name),
+			Default:     v.Default,
+		}
+		if v.Required {
+			prompt.Validate = func(answer string) error {
+				if strings.TrimSpace(answer) == "" {
+					return fmt.Errorf("%s is required", v.Name)
+				}
+				return nil
+			}
+		}
+
+		answer, err := prompter.Input(ctx, prompt)
+		if err := promptError(err); err != nil {
+			return err
+		}
+		if answer = strings.TrimSpace(answer); answer != "" {
+			vars[v.Name] = answer
+		}
+	}
 	return nil
 }
+
+// stepTimingsTable lists each generation step with how long it took, in the
+// order the steps finished.
+func stepTimingsTable(results []steps.Result) interfaces.Table {
+	rows := make([][]string, 0, len(results))
+	for _, result := range results {
+		status := "ok"
+		switch {
+		case result.Skipped:
+			status = "skipped"
+		case result.Err != nil:
+			status = "failed (continued)"
+		}
+		rows = append(rows, []string{result.Name, result.Duration.Round(time.Millisecond).String(), status})
+	}
+	return interfaces.Table{
+		Headers: []string{"Step", "Time", "Status"},
+		Rows:    rows,
+	}
+}
+
+// stepTimings converts step results for NewResult, with the same statuses
+// as stepTimingsTable.
+func stepTimings(results []steps.Result) []StepTiming {
+	timings := make([]StepTiming, 0, len(results))
+	for _, result := range results {
+		status := "ok"
+		switch {
+		case result.Skipped:
+			status = "skipped"
+		case result.Err != nil:
+			status = "failed"
+		}
+		timings = append(timings, StepTiming{
+			Name:       result.Name,
+			DurationMS: result.Duration.Milliseconds(),
+			Status:     status,
+		})
+	}
+	return timings
+}
+
+// renderPlan shows the files and steps of a dry run.
+func renderPlan(r interfaces.Renderer, plan *interfaces.ProjectPlan) {
+	fileRows := make([][]string, 0, len(plan.Files))
+	total := 0
+	for _, f := range plan.Files {
+		fileRows = append(fileRows, []string{f.Path, strconv.Itoa(f.Size), f.Template})
+		total += f.Size
+	}
+	r.Table(interfaces.Table{
+		Headers: []string{"File", "Bytes", "Template"},
+		Rows:    fileRows,
+	})
+
+	stepRows := make([][]string, 0, len(plan.Steps))
+	for _, step := range plan.Steps {
+		required := "yes"
+		if step.Optional {
+			required = "no"
+		}
+		stepRows = append(stepRows, []string{step.Name, strings.Join(step.DependsOn, ", "), required})
+	}
+	r.Table(interfaces.Table{
+		Headers: []string{"Step", "After", "Required"},
+		Rows:    stepRows,
+	})
+
+	r.Section(interfaces.Section{
+		Body: fmt.Sprintf("%d file(s), %d bytes. Dry run: nothing was written.", len(plan.Files), total),
+	})
+}
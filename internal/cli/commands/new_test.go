@@ -2,10 +2,20 @@ package commands
 
 import (
 	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	"github.com/anomalousventures/tracks/internal/cli/renderer/prompttest"
+	"github.com/anomalousventures/tracks/internal/generator"
+	"github.com/anomalousventures/tracks/internal/generator/steps"
 	"github.com/anomalousventures/tracks/internal/validation"
 	"github.com/anomalousventures/tracks/tests/mocks"
 	"github.com/spf13/cobra"
@@ -16,6 +26,7 @@ func setupTestCommand(t *testing.T) *cobra.Command {
 	mockValidator := mocks.NewMockValidator(t)
 	mockGenerator := mocks.NewMockProjectGenerator(t)
 	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Result", mock.Anything).Return().Maybe()
 	mockRenderer.On("Title", mock.Anything).Return().Maybe()
 	mockRenderer.On("Section", mock.Anything).Return().Maybe()
 	mockRenderer.On("Flush").Return(nil).Maybe()
@@ -28,7 +39,7 @@ func setupTestCommand(t *testing.T) *cobra.Command {
 	flusher := func(*cobra.Command, interfaces.Renderer) {
 		mockRenderer.Flush()
 	}
-	cmd := NewNewCommand(mockValidator, mockGenerator, factory, flusher)
+	cmd := NewNewCommand(mockValidator, mockGenerator, factory, flusher, noPrompts, noWizard)
 	cobraCmd := cmd.Command()
 	cobraCmd.SetOut(new(bytes.Buffer))
 	cobraCmd.SetErr(new(bytes.Buffer))
@@ -37,6 +48,7 @@ func setupTestCommand(t *testing.T) *cobra.Command {
 
 func TestNewNewCommand(t *testing.T) {
 	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Result", mock.Anything).Return().Maybe()
 	rendererFactory := func(*cobra.Command) interfaces.Renderer {
 		return mockRenderer
 	}
@@ -44,7 +56,7 @@ func TestNewNewCommand(t *testing.T) {
 
 	mockValidator := mocks.NewMockValidator(t)
 	mockGenerator := mocks.NewMockProjectGenerator(t)
-	cmd := NewNewCommand(mockValidator, mockGenerator, rendererFactory, flusher)
+	cmd := NewNewCommand(mockValidator, mockGenerator, rendererFactory, flusher, noPrompts, noWizard)
 
 	if cmd == nil {
 		t.Fatal("NewNewCommand returned nil")
@@ -64,12 +76,13 @@ func TestNewCommand_Command(t *testing.T) {
 	mockValidator := mocks.NewMockValidator(t)
 	mockGenerator := mocks.NewMockProjectGenerator(t)
 	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Result", mock.Anything).Return().Maybe()
 	rendererFactory := func(*cobra.Command) interfaces.Renderer {
 		return mockRenderer
 	}
 	flusher := func(*cobra.Command, interfaces.Renderer) {}
 
-	newCmd := NewNewCommand(mockValidator, mockGenerator, rendererFactory, flusher)
+	newCmd := NewNewCommand(mockValidator, mockGenerator, rendererFactory, flusher, noPrompts, noWizard)
 	cobraCmd := newCmd.Command()
 
 	if cobraCmd == nil {
@@ -115,6 +128,7 @@ func TestNewCommand_Run(t *testing.T) {
 	mockValidator := mocks.NewMockValidator(t)
 	mockGenerator := mocks.NewMockProjectGenerator(t)
 	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Result", mock.Anything).Return().Maybe()
 
 	mockValidator.On("ValidateProjectName", mock.Anything, "myapp").Return(nil).Once()
 	mockValidator.On("ValidateDatabaseDriver", mock.Anything, "go-libsql").Return(nil).Once()
@@ -135,7 +149,7 @@ func TestNewCommand_Run(t *testing.T) {
 		}
 	}
 
-	newCmd := NewNewCommand(mockValidator, mockGenerator, rendererFactory, flusher)
+	newCmd := NewNewCommand(mockValidator, mockGenerator, rendererFactory, flusher, noPrompts, noWizard)
 	cobraCmd := newCmd.Command()
 	cobraCmd.SetOut(new(bytes.Buffer))
 	cobraCmd.SetErr(new(bytes.Buffer))
@@ -181,6 +195,7 @@ func TestNewCommand_RunWithDifferentProjectNames(t *testing.T) {
 			mockValidator := mocks.NewMockValidator(t)
 			mockGenerator := mocks.NewMockProjectGenerator(t)
 			mockRenderer := mocks.NewMockRenderer(t)
+			mockRenderer.On("Result", mock.Anything).Return().Maybe()
 
 			mockValidator.On("ValidateProjectName", mock.Anything, tt.projectName).Return(nil).Once()
 			mockValidator.On("ValidateDatabaseDriver", mock.Anything, "go-libsql").Return(nil).Once()
@@ -194,7 +209,7 @@ func TestNewCommand_RunWithDifferentProjectNames(t *testing.T) {
 			}
 			flusher := func(*cobra.Command, interfaces.Renderer) {}
 
-			cmd := NewNewCommand(mockValidator, mockGenerator, factory, flusher)
+			cmd := NewNewCommand(mockValidator, mockGenerator, factory, flusher, noPrompts, noWizard)
 			cobraCmd := cmd.Command()
 			cobraCmd.SetOut(new(bytes.Buffer))
 			cobraCmd.SetErr(new(bytes.Buffer))
@@ -214,6 +229,7 @@ func TestNewCommand_RendererFactoryCalledWithCommand(t *testing.T) {
 	mockValidator := mocks.NewMockValidator(t)
 	mockGenerator := mocks.NewMockProjectGenerator(t)
 	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Result", mock.Anything).Return().Maybe()
 
 	mockValidator.On("ValidateProjectName", mock.Anything, "testapp").Return(nil).Once()
 	mockValidator.On("ValidateDatabaseDriver", mock.Anything, "go-libsql").Return(nil).Once()
@@ -229,7 +245,7 @@ func TestNewCommand_RendererFactoryCalledWithCommand(t *testing.T) {
 	}
 	flusher := func(*cobra.Command, interfaces.Renderer) {}
 
-	newCmd := NewNewCommand(mockValidator, mockGenerator, rendererFactory, flusher)
+	newCmd := NewNewCommand(mockValidator, mockGenerator, rendererFactory, flusher, noPrompts, noWizard)
 	cobraCmd := newCmd.Command()
 	cobraCmd.SetOut(new(bytes.Buffer))
 	cobraCmd.SetErr(new(bytes.Buffer))
@@ -251,6 +267,7 @@ func TestNewCommand_FlusherCalledWithCommandAndRenderer(t *testing.T) {
 	mockValidator := mocks.NewMockValidator(t)
 	mockGenerator := mocks.NewMockProjectGenerator(t)
 	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Result", mock.Anything).Return().Maybe()
 
 	mockValidator.On("ValidateProjectName", mock.Anything, "testapp").Return(nil).Once()
 	mockValidator.On("ValidateDatabaseDriver", mock.Anything, "go-libsql").Return(nil).Once()
@@ -270,7 +287,7 @@ func TestNewCommand_FlusherCalledWithCommandAndRenderer(t *testing.T) {
 		capturedRenderer = r
 	}
 
-	newCmd := NewNewCommand(mockValidator, mockGenerator, rendererFactory, flusher)
+	newCmd := NewNewCommand(mockValidator, mockGenerator, rendererFactory, flusher, noPrompts, noWizard)
 	cobraCmd := newCmd.Command()
 	cobraCmd.SetOut(new(bytes.Buffer))
 	cobraCmd.SetErr(new(bytes.Buffer))
@@ -311,13 +328,14 @@ func TestNewCommand_FlagDefaults(t *testing.T) {
 	mockValidator := mocks.NewMockValidator(t)
 	mockGenerator := mocks.NewMockProjectGenerator(t)
 	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Result", mock.Anything).Return().Maybe()
 
 	factory := func(*cobra.Command) interfaces.Renderer {
 		return mockRenderer
 	}
 	flusher := func(*cobra.Command, interfaces.Renderer) {}
 
-	cmd := NewNewCommand(mockValidator, mockGenerator, factory, flusher)
+	cmd := NewNewCommand(mockValidator, mockGenerator, factory, flusher, noPrompts, noWizard)
 	cobraCmd := cmd.Command()
 
 	dbFlag := cobraCmd.Flags().Lookup("db")
@@ -429,6 +447,7 @@ func TestNewCommand_FlagParsing(t *testing.T) {
 			mockValidator := mocks.NewMockValidator(t)
 			mockGenerator := mocks.NewMockProjectGenerator(t)
 			mockRenderer := mocks.NewMockRenderer(t)
+			mockRenderer.On("Result", mock.Anything).Return().Maybe()
 
 			tt.setupValidator(mockValidator)
 			mockGenerator.On("Validate", mock.Anything).Return(nil).Once()
@@ -445,7 +464,7 @@ func TestNewCommand_FlagParsing(t *testing.T) {
 			}
 			flusher := func(*cobra.Command, interfaces.Renderer) {}
 
-			cmd := NewNewCommand(mockValidator, mockGenerator, factory, flusher)
+			cmd := NewNewCommand(mockValidator, mockGenerator, factory, flusher, noPrompts, noWizard)
 			cobraCmd := cmd.Command()
 			cobraCmd.SetOut(new(bytes.Buffer))
 			cobraCmd.SetErr(new(bytes.Buffer))
@@ -467,6 +486,7 @@ func TestNewCommand_ValidationIntegration(t *testing.T) {
 		mockValidator := mocks.NewMockValidator(t)
 		mockGenerator := mocks.NewMockProjectGenerator(t)
 		mockRenderer := mocks.NewMockRenderer(t)
+		mockRenderer.On("Result", mock.Anything).Return().Maybe()
 
 		mockValidator.On("ValidateProjectName", mock.Anything, "MyApp").
 			Return(&validation.ValidationError{Field: "project_name", Message: "must be lowercase"}).Once()
@@ -476,7 +496,7 @@ func TestNewCommand_ValidationIntegration(t *testing.T) {
 		}
 		flusher := func(*cobra.Command, interfaces.Renderer) {}
 
-		cmd := NewNewCommand(mockValidator, mockGenerator, factory, flusher)
+		cmd := NewNewCommand(mockValidator, mockGenerator, factory, flusher, noPrompts, noWizard)
 		cobraCmd := cmd.Command()
 		cobraCmd.SetOut(new(bytes.Buffer))
 		cobraCmd.SetErr(new(bytes.Buffer))
@@ -498,6 +518,7 @@ func TestNewCommand_ValidationIntegration(t *testing.T) {
 		mockValidator := mocks.NewMockValidator(t)
 		mockGenerator := mocks.NewMockProjectGenerator(t)
 		mockRenderer := mocks.NewMockRenderer(t)
+		mockRenderer.On("Result", mock.Anything).Return().Maybe()
 
 		mockValidator.On("ValidateProjectName", mock.Anything, "myapp").Return(nil).Once()
 		mockValidator.On("ValidateDatabaseDriver", mock.Anything, "mysql").
@@ -508,7 +529,7 @@ func TestNewCommand_ValidationIntegration(t *testing.T) {
 		}
 		flusher := func(*cobra.Command, interfaces.Renderer) {}
 
-		cmd := NewNewCommand(mockValidator, mockGenerator, factory, flusher)
+		cmd := NewNewCommand(mockValidator, mockGenerator, factory, flusher, noPrompts, noWizard)
 		cobraCmd := cmd.Command()
 		cobraCmd.SetOut(new(bytes.Buffer))
 		cobraCmd.SetErr(new(bytes.Buffer))
@@ -530,6 +551,7 @@ func TestNewCommand_ValidationIntegration(t *testing.T) {
 		mockValidator := mocks.NewMockValidator(t)
 		mockGenerator := mocks.NewMockProjectGenerator(t)
 		mockRenderer := mocks.NewMockRenderer(t)
+		mockRenderer.On("Result", mock.Anything).Return().Maybe()
 
 		mockValidator.On("ValidateProjectName", mock.Anything, "myapp").Return(nil).Once()
 		mockValidator.On("ValidateDatabaseDriver", mock.Anything, "go-libsql").Return(nil).Once()
@@ -541,7 +563,7 @@ func TestNewCommand_ValidationIntegration(t *testing.T) {
 		}
 		flusher := func(*cobra.Command, interfaces.Renderer) {}
 
-		cmd := NewNewCommand(mockValidator, mockGenerator, factory, flusher)
+		cmd := NewNewCommand(mockValidator, mockGenerator, factory, flusher, noPrompts, noWizard)
 		cobraCmd := cmd.Command()
 		cobraCmd.SetOut(new(bytes.Buffer))
 		cobraCmd.SetErr(new(bytes.Buffer))
@@ -563,6 +585,7 @@ func TestNewCommand_ValidationIntegration(t *testing.T) {
 		mockValidator := mocks.NewMockValidator(t)
 		mockGenerator := mocks.NewMockProjectGenerator(t)
 		mockRenderer := mocks.NewMockRenderer(t)
+		mockRenderer.On("Result", mock.Anything).Return().Maybe()
 		mockRenderer.On("Title", mock.Anything).Return().Maybe()
 		mockRenderer.On("Section", mock.Anything).Return().Maybe()
 		mockGenerator.On("Validate", mock.Anything).Return(nil).Maybe()
@@ -581,7 +604,7 @@ func TestNewCommand_ValidationIntegration(t *testing.T) {
 		}
 		flusher := func(*cobra.Command, interfaces.Renderer) {}
 
-		cmd := NewNewCommand(mockValidator, mockGenerator, factory, flusher)
+		cmd := NewNewCommand(mockValidator, mockGenerator, factory, flusher, noPrompts, noWizard)
 		cobraCmd := cmd.Command()
 		cobraCmd.SetOut(new(bytes.Buffer))
 		cobraCmd.SetErr(new(bytes.Buffer))
@@ -598,6 +621,7 @@ func TestNewCommand_ValidationIntegration(t *testing.T) {
 		mockValidator := mocks.NewMockValidator(t)
 		mockGenerator := mocks.NewMockProjectGenerator(t)
 		mockRenderer := mocks.NewMockRenderer(t)
+		mockRenderer.On("Result", mock.Anything).Return().Maybe()
 		mockRenderer.On("Title", mock.Anything).Return().Maybe()
 		mockRenderer.On("Section", mock.MatchedBy(func(s interfaces.Section) bool {
 			return strings.Contains(s.Body, "Module: example.com/myapp")
@@ -614,7 +638,7 @@ func TestNewCommand_ValidationIntegration(t *testing.T) {
 		}
 		flusher := func(*cobra.Command, interfaces.Renderer) {}
 
-		cmd := NewNewCommand(mockValidator, mockGenerator, factory, flusher)
+		cmd := NewNewCommand(mockValidator, mockGenerator, factory, flusher, noPrompts, noWizard)
 		cobraCmd := cmd.Command()
 		cobraCmd.SetOut(new(bytes.Buffer))
 		cobraCmd.SetErr(new(bytes.Buffer))
@@ -632,6 +656,7 @@ func TestNewCommand_ValidationIntegration(t *testing.T) {
 		mockValidator := mocks.NewMockValidator(t)
 		mockGenerator := mocks.NewMockProjectGenerator(t)
 		mockRenderer := mocks.NewMockRenderer(t)
+		mockRenderer.On("Result", mock.Anything).Return().Maybe()
 		mockRenderer.On("Title", mock.Anything).Return().Maybe()
 		mockRenderer.On("Section", mock.Anything).Return().Maybe()
 		mockGenerator.On("Validate", mock.Anything).Return(nil).Maybe()
@@ -646,7 +671,7 @@ func TestNewCommand_ValidationIntegration(t *testing.T) {
 		}
 		flusher := func(*cobra.Command, interfaces.Renderer) {}
 
-		cmd := NewNewCommand(mockValidator, mockGenerator, factory, flusher)
+		cmd := NewNewCommand(mockValidator, mockGenerator, factory, flusher, noPrompts, noWizard)
 		cobraCmd := cmd.Command()
 		cobraCmd.SetOut(new(bytes.Buffer))
 		cobraCmd.SetErr(new(bytes.Buffer))
@@ -663,6 +688,7 @@ func TestNewCommand_ValidationIntegration(t *testing.T) {
 		mockValidator := mocks.NewMockValidator(t)
 		mockGenerator := mocks.NewMockProjectGenerator(t)
 		mockRenderer := mocks.NewMockRenderer(t)
+		mockRenderer.On("Result", mock.Anything).Return().Maybe()
 		mockRenderer.On("Title", mock.Anything).Return().Maybe()
 		mockRenderer.On("Section", mock.Anything).Return().Maybe()
 		mockGenerator.On("Validate", mock.Anything).Return(nil).Maybe()
@@ -676,7 +702,7 @@ func TestNewCommand_ValidationIntegration(t *testing.T) {
 		}
 		flusher := func(*cobra.Command, interfaces.Renderer) {}
 
-		cmd := NewNewCommand(mockValidator, mockGenerator, factory, flusher)
+		cmd := NewNewCommand(mockValidator, mockGenerator, factory, flusher, noPrompts, noWizard)
 		cobraCmd := cmd.Command()
 		cobraCmd.SetOut(new(bytes.Buffer))
 		cobraCmd.SetErr(new(bytes.Buffer))
@@ -689,3 +715,665 @@ func TestNewCommand_ValidationIntegration(t *testing.T) {
 		mockValidator.AssertExpectations(t)
 	})
 }
+
+func TestNewCommand_KeepOnFailureFlag(t *testing.T) {
+	mockValidator := mocks.NewMockValidator(t)
+	mockGenerator := mocks.NewMockProjectGenerator(t)
+	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Result", mock.Anything).Return().Maybe()
+
+	mockValidator.On("ValidateProjectName", mock.Anything, "myapp").Return(nil).Once()
+	mockValidator.On("ValidateDatabaseDriver", mock.Anything, "go-libsql").Return(nil).Once()
+	mockGenerator.On("Validate", mock.Anything).Return(nil).Once()
+	mockGenerator.On("Generate", mock.Anything, mock.MatchedBy(func(cfg generator.ProjectConfig) bool {
+		return cfg.KeepOnFailure
+	})).Return(errors.New("make generate failed (partial project kept at .tracks-new-myapp-1/myapp)")).Once()
+	mockRenderer.On("Title", mock.Anything).Once()
+	mockRenderer.On("Section", mock.Anything).Once()
+
+	factory := func(*cobra.Command) interfaces.Renderer {
+		return mockRenderer
+	}
+	flusher := func(*cobra.Command, interfaces.Renderer) {}
+
+	cobraCmd := NewNewCommand(mockValidator, mockGenerator, factory, flusher, noPrompts, noWizard).Command()
+	cobraCmd.SetOut(new(bytes.Buffer))
+	cobraCmd.SetErr(new(bytes.Buffer))
+	cobraCmd.SetArgs([]string{"myapp", "--keep-on-failure"})
+
+	err := cobraCmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "partial project kept at") {
+		t.Fatalf("expected generation error with kept path, got %v", err)
+	}
+}
+
+func TestNewCommand_StepTimings(t *testing.T) {
+	mockValidator := mocks.NewMockValidator(t)
+	mockGenerator := mocks.NewMockProjectGenerator(t)
+	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Result", mock.Anything).Return().Maybe()
+
+	mockValidator.On("ValidateProjectName", mock.Anything, "myapp").Return(nil).Once()
+	mockValidator.On("ValidateDatabaseDriver", mock.Anything, "go-libsql").Return(nil).Once()
+	mockGenerator.On("Validate", mock.Anything).Return(nil).Once()
+	mockGenerator.On("Generate", mock.Anything, mock.MatchedBy(func(cfg generator.ProjectConfig) bool {
+		return cfg.NoCache && cfg.OnStep != nil
+	})).Run(func(args mock.Arguments) {
+		cfg := args.Get(1).(generator.ProjectConfig)
+		cfg.OnStep(steps.Result{Name: "npm install", Duration: 1500 * time.Millisecond, Optional: true})
+		cfg.OnStep(steps.Result{Name: "make assets", Optional: true, Skipped: true})
+		cfg.OnStep(steps.Result{Name: "templui", Duration: 2 * time.Second, Optional: true, Err: errors.New("offline")})
+	}).Return(nil).Once()
+	mockRenderer.On("Title", mock.Anything).Once()
+	mockRenderer.On("Section", mock.Anything).Twice()
+	mockRenderer.On("Table", interfaces.Table{
+		Headers: []string{"Step", "Time", "Status"},
+		Rows: [][]string{
+			{"npm install", "1.5s", "ok"},
+			{"make assets", "0s", "skipped"},
+			{"templui", "2s", "failed (continued)"},
+		},
+	}).Once()
+
+	factory := func(*cobra.Command) interfaces.Renderer {
+		return mockRenderer
+	}
+	flusher := func(*cobra.Command, interfaces.Renderer) {}
+
+	cobraCmd := NewNewCommand(mockValidator, mockGenerator, factory, flusher, noPrompts, noWizard).Command()
+	cobraCmd.SetOut(new(bytes.Buffer))
+	cobraCmd.SetErr(new(bytes.Buffer))
+	cobraCmd.SetArgs([]string{"myapp", "--no-cache"})
+
+	if err := cobraCmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestNewCommand_Progress(t *testing.T) {
+	mockValidator := mocks.NewMockValidator(t)
+	mockGenerator := mocks.NewMockProjectGenerator(t)
+	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Result", mock.Anything).Return().Maybe()
+	mockProgress := mocks.NewMockProgress(t)
+
+	spec := interfaces.ProgressSpec{Label: "Generating project", Total: 6}
+
+	mockValidator.On("ValidateProjectName", mock.Anything, "myapp").Return(nil).Once()
+	mockValidator.On("ValidateDatabaseDriver", mock.Anything, "go-libsql").Return(nil).Once()
+	mockGenerator.On("Validate", mock.Anything).Return(nil).Once()
+	mockGenerator.On("Generate", mock.Anything, mock.MatchedBy(func(cfg generator.ProjectConfig) bool {
+		return cfg.NewProgress != nil
+	})).Run(func(args mock.Arguments) {
+		cfg := args.Get(1).(generator.ProjectConfig)
+		progress := cfg.NewProgress(spec)
+		progress.Status("templates")
+		progress.Increment(1)
+		progress.Done()
+	}).Return(nil).Once()
+	mockRenderer.On("Title", mock.Anything).Once()
+	mockRenderer.On("Section", mock.Anything).Twice()
+	mockRenderer.On("Progress", spec).Return(mockProgress).Once()
+	mockProgress.On("Status", "templates").Once()
+	mockProgress.On("Increment", int64(1)).Once()
+	mockProgress.On("Done").Once()
+
+	factory := func(*cobra.Command) interfaces.Renderer {
+		return mockRenderer
+	}
+	flusher := func(*cobra.Command, interfaces.Renderer) {}
+
+	cobraCmd := NewNewCommand(mockValidator, mockGenerator, factory, flusher, noPrompts, noWizard).Command()
+	cobraCmd.SetOut(new(bytes.Buffer))
+	cobraCmd.SetErr(new(bytes.Buffer))
+	cobraCmd.SetArgs([]string{"myapp"})
+
+	if err := cobraCmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestNewCommand_DryRun(t *testing.T) {
+	mockValidator := mocks.NewMockValidator(t)
+	mockGenerator := mocks.NewMockProjectGenerator(t)
+	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Result", mock.Anything).Return().Maybe()
+
+	plan := &interfaces.ProjectPlan{
+		Files: []interfaces.PlannedFile{
+			{Path: "Makefile", Template: "Makefile.tmpl", Size: 1200},
+			{Path: "go.mod", Template: "go.mod.tmpl", Size: 300},
+		},
+		Steps: []interfaces.PlannedStep{
+			{Name: "go mod tidy", Optional: true},
+			{Name: "make generate", DependsOn: []string{"go mod tidy"}},
+		},
+	}
+
+	mockValidator.On("ValidateProjectName", mock.Anything, "myapp").Return(nil).Once()
+	mockValidator.On("ValidateDatabaseDriver", mock.Anything, "postgres").Return(nil).Once()
+	mockGenerator.On("Validate", mock.Anything).Return(nil).Once()
+	mockGenerator.On("Plan", mock.Anything, mock.MatchedBy(func(cfg generator.ProjectConfig) bool {
+		return cfg.DatabaseDriver == "postgres"
+	})).Return(plan, nil).Once()
+	mockRenderer.On("Title", "Creating new Tracks application: myapp (dry run)").Once()
+	mockRenderer.On("Section", mock.MatchedBy(func(s interfaces.Section) bool {
+		return strings.Contains(s.Body, "Database: postgres")
+	})).Once()
+	mockRenderer.On("Table", interfaces.Table{
+		Headers: []string{"File", "Bytes", "Template"},
+		Rows: [][]string{
+			{"Makefile", "1200", "Makefile.tmpl"},
+			{"go.mod", "300", "go.mod.tmpl"},
+		},
+	}).Once()
+	mockRenderer.On("Table", interfaces.Table{
+		Headers: []string{"Step", "After", "Required"},
+		Rows: [][]string{
+			{"go mod tidy", "", "no"},
+			{"make generate", "go mod tidy", "yes"},
+		},
+	}).Once()
+	mockRenderer.On("Section", interfaces.Section{
+		Body: "2 file(s), 1500 bytes. Dry run: nothing was written.",
+	}).Once()
+
+	factory := func(*cobra.Command) interfaces.Renderer {
+		return mockRenderer
+	}
+	flusher := func(*cobra.Command, interfaces.Renderer) {}
+
+	cobraCmd := NewNewCommand(mockValidator, mockGenerator, factory, flusher, noPrompts, noWizard).Command()
+	cobraCmd.SetOut(new(bytes.Buffer))
+	cobraCmd.SetErr(new(bytes.Buffer))
+	cobraCmd.SetArgs([]string{"myapp", "--db", "postgres", "--dry-run"})
+
+	if err := cobraCmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	mockGenerator.AssertNotCalled(t, "Generate", mock.Anything, mock.Anything)
+}
+
+func TestNewCommand_Starter(t *testing.T) {
+	mockValidator := mocks.NewMockValidator(t)
+	mockGenerator := mocks.NewMockProjectGenerator(t)
+	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Result", mock.Anything).Return().Maybe()
+
+	kit := &interfaces.StarterKit{
+		Name: "saas",
+		Variables: []interfaces.StarterVariable{
+			{Name: "Plan", Prompt: "Billing plan", Default: "free"},
+			{Name: "Company", Prompt: "Company name", Required: true},
+			{Name: "Region", Default: "eu"},
+		},
+	}
+
+	mockValidator.On("ValidateProjectName", mock.Anything, "myapp").Return(nil).Once()
+	mockValidator.On("ValidateDatabaseDriver", mock.Anything, "go-libsql").Return(nil).Once()
+	mockGenerator.On("LoadStarter", mock.Anything, "./kit.tar.gz").Return(kit, nil).Once()
+	mockGenerator.On("Validate", mock.Anything).Return(nil).Once()
+	mockGenerator.On("Plan", mock.Anything, mock.MatchedBy(func(cfg generator.ProjectConfig) bool {
+		return cfg.Starter == "./kit.tar.gz" &&
+			len(cfg.StarterVars) == 3 &&
+			cfg.StarterVars["Plan"] == "pro" &&
+			cfg.StarterVars["Company"] == "Acme" &&
+			cfg.StarterVars["Region"] == "eu"
+	})).Return(&interfaces.ProjectPlan{}, nil).Once()
+	mockRenderer.On("Title", mock.Anything).Once()
+	mockRenderer.On("Section", mock.Anything)
+	mockRenderer.On("Table", mock.Anything)
+
+	factory := func(*cobra.Command) interfaces.Renderer {
+		return mockRenderer
+	}
+	flusher := func(*cobra.Command, interfaces.Renderer) {}
+
+	prompter := prompttest.NewPrompter()
+	prompter.Answer("Company name", "Acme")
+	prompter.Answer("Region", "")
+
+	out := new(bytes.Buffer)
+	cobraCmd := NewNewCommand(mockValidator, mockGenerator, factory, flusher, newTestPrompter(prompter), noWizard).Command()
+	cobraCmd.SetOut(out)
+	cobraCmd.SetErr(new(bytes.Buffer))
+	cobraCmd.SetArgs([]string{"myapp", "--starter", "./kit.tar.gz", "--var", "Plan=pro", "--dry-run"})
+
+	if err := cobraCmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := prompter.Asked(), []string{"Company name", "Region"}; !slices.Equal(got, want) {
+		t.Errorf("expected prompts %v, got %v", want, got)
+	}
+	if out.Len() != 0 {
+		t.Errorf("prompts should not write to stdout, got %q", out.String())
+	}
+}
+
+func TestNewCommand_StarterPromptUnavailable(t *testing.T) {
+	mockValidator := mocks.NewMockValidator(t)
+	mockGenerator := mocks.NewMockProjectGenerator(t)
+
+	kit := &interfaces.StarterKit{
+		Name:      "saas",
+		Variables: []interfaces.StarterVariable{{Name: "Plan", Prompt: "Plan name", Default: "pro"}},
+	}
+	mockValidator.On("ValidateProjectName", mock.Anything, "myapp").Return(nil).Once()
+	mockValidator.On("ValidateDatabaseDriver", mock.Anything, "go-libsql").Return(nil).Once()
+	mockGenerator.On("LoadStarter", mock.Anything, "./kit.tar.gz").Return(kit, nil).Once()
+
+	factory := func(*cobra.Command) interfaces.Renderer { return mocks.NewMockRenderer(t) }
+	flusher := func(*cobra.Command, interfaces.Renderer) {}
+
+	out := new(bytes.Buffer)
+	cobraCmd := NewNewCommand(mockValidator, mockGenerator, factory, flusher, noPrompts, noWizard).Command()
+	cobraCmd.SetOut(out)
+	cobraCmd.SetErr(new(bytes.Buffer))
+	cobraCmd.SetArgs([]string{"myapp", "--starter", "./kit.tar.gz", "--dry-run"})
+
+	err := cobraCmd.Execute()
+	if !errors.Is(err, interfaces.ErrPromptUnavailable) {
+		t.Fatalf("expected ErrPromptUnavailable, got %v", err)
+	}
+	if code := ErrorCode(err); code != CodeConfirmationRequired {
+		t.Errorf("ErrorCode() = %q, want %q", code, CodeConfirmationRequired)
+	}
+	if strings.Contains(out.String(), "Plan name") {
+		t.Errorf("prompts should not write to stdout, got %q", out.String())
+	}
+	mockGenerator.AssertNotCalled(t, "Generate", mock.Anything, mock.Anything)
+}
+
+func TestNewCommand_StarterVarErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "malformed var",
+			args:    []string{"myapp", "--starter", "./kit", "--var", "Plan"},
+			wantErr: "expected NAME=value",
+		},
+		{
+			name:    "var without starter",
+			args:    []string{"myapp", "--var", "Plan=pro"},
+			wantErr: "--var requires --starter",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockValidator := mocks.NewMockValidator(t)
+			mockGenerator := mocks.NewMockProjectGenerator(t)
+
+			mockValidator.On("ValidateProjectName", mock.Anything, "myapp").Return(nil).Once()
+			mockValidator.On("ValidateDatabaseDriver", mock.Anything, "go-libsql").Return(nil).Once()
+
+			factory := func(*cobra.Command) interfaces.Renderer { return mocks.NewMockRenderer(t) }
+			flusher := func(*cobra.Command, interfaces.Renderer) {}
+
+			cobraCmd := NewNewCommand(mockValidator, mockGenerator, factory, flusher, noPrompts, noWizard).Command()
+			cobraCmd.SetOut(new(bytes.Buffer))
+			cobraCmd.SetErr(new(bytes.Buffer))
+			cobraCmd.SetArgs(tt.args)
+
+			err := cobraCmd.Execute()
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+// noPrompts is a PrompterFactory for tests that answer no prompts.
+func noPrompts(*cobra.Command) interfaces.Prompter {
+	return prompttest.NewPrompter()
+}
+
+// noWizard is a ProjectWizardFactory for tests that never start the wizard.
+func noWizard(*cobra.Command) (interfaces.ProjectWizard, error) {
+	return nil, fmt.Errorf("%w: cannot start the project wizard because stdin is not a terminal", interfaces.ErrPromptUnavailable)
+}
+
+func TestNewCommand_Wizard(t *testing.T) {
+	mockValidator := mocks.NewMockValidator(t)
+	mockGenerator := mocks.NewMockProjectGenerator(t)
+	mockWizard := mocks.NewMockProjectWizard(t)
+	wizardRenderer := mocks.NewMockRenderer(t)
+	wizardRenderer.On("Title", "Creating new Tracks application: webapp").Once()
+	wizardRenderer.On("Section", mock.Anything)
+	wizardRenderer.On("Result", mock.MatchedBy(func(res NewResult) bool {
+		return res.Name == "webapp" && res.ModulePath == "example.com/webapp"
+	})).Once()
+
+	mockWizard.EXPECT().Run(mock.Anything, interfaces.ProjectOptions{
+		DatabaseDriver: "postgres",
+		EnvPrefix:      "APP",
+		InitGit:        true,
+		OutputPath:     ".",
+		GoVersion:      "1.25",
+		Profile:        "full",
+		StarterVars:    map[string]string{},
+	}, mock.Anything).RunAndReturn(func(ctx context.Context, opts interfaces.ProjectOptions, generate interfaces.GenerateFunc) (interfaces.ProjectOptions, error) {
+		opts.Name = "webapp"
+		opts.EnvPrefix = "WEB"
+		return opts, generate(ctx, opts, wizardRenderer)
+	}).Once()
+	mockGenerator.On("Validate", mock.Anything).Return(nil).Once()
+	mockGenerator.On("Generate", mock.Anything, mock.MatchedBy(func(cfg generator.ProjectConfig) bool {
+		return cfg.ProjectName == "webapp" &&
+			cfg.DatabaseDriver == "postgres" &&
+			cfg.EnvPrefix == "WEB" &&
+			cfg.ModulePath == "example.com/webapp"
+	})).Return(nil).Once()
+
+	factory := func(*cobra.Command) interfaces.Renderer {
+		t.Error("the command renderer should not be used with the wizard")
+		return nil
+	}
+	flusher := func(*cobra.Command, interfaces.Renderer) {}
+	newWizard := func(*cobra.Command) (interfaces.ProjectWizard, error) { return mockWizard, nil }
+
+	cobraCmd := NewNewCommand(mockValidator, mockGenerator, factory, flusher, noPrompts, newWizard).Command()
+	cobraCmd.SetOut(new(bytes.Buffer))
+	cobraCmd.SetErr(new(bytes.Buffer))
+	cobraCmd.SetArgs([]string{"--db", "postgres"})
+
+	if err := cobraCmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestNewCommand_WizardCanceled(t *testing.T) {
+	mockWizard := mocks.NewMockProjectWizard(t)
+	mockWizard.On("Run", mock.Anything, mock.Anything, mock.Anything).
+		Return(interfaces.ProjectOptions{}, interfaces.ErrPromptCanceled).Once()
+
+	factory := func(*cobra.Command) interfaces.Renderer { return mocks.NewMockRenderer(t) }
+	flusher := func(*cobra.Command, interfaces.Renderer) {}
+	newWizard := func(*cobra.Command) (interfaces.ProjectWizard, error) { return mockWizard, nil }
+
+	cobraCmd := NewNewCommand(mocks.NewMockValidator(t), mocks.NewMockProjectGenerator(t), factory, flusher, noPrompts, newWizard).Command()
+	cobraCmd.SetOut(new(bytes.Buffer))
+	cobraCmd.SetErr(new(bytes.Buffer))
+	cobraCmd.SetArgs([]string{})
+
+	if err := cobraCmd.Execute(); err != nil {
+		t.Fatalf("quitting the wizard should not be an error, got: %v", err)
+	}
+}
+
+func TestNewCommand_WizardUnavailable(t *testing.T) {
+	cobraCmd := setupTestCommand(t)
+	cobraCmd.SetArgs([]string{})
+
+	err := cobraCmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "requires a project name") {
+		t.Fatalf("expected a project name error, got: %v", err)
+	}
+	if !errors.Is(err, interfaces.ErrPromptUnavailable) {
+		t.Errorf("expected ErrPromptUnavailable in the chain, got: %v", err)
+	}
+	if code := ErrorCode(err); code != CodeInvalidArgument {
+		t.Errorf("ErrorCode() = %q, want %q", code, CodeInvalidArgument)
+	}
+}
+
+func TestNewCommand_EnvPrefix(t *testing.T) {
+	mockValidator := mocks.NewMockValidator(t)
+	mockGenerator := mocks.NewMockProjectGenerator(t)
+
+	mockValidator.On("ValidateProjectName", mock.Anything, "myapp").Return(nil).Once()
+	mockValidator.On("ValidateDatabaseDriver", mock.Anything, "go-libsql").Return(nil).Once()
+	mockValidator.On("ValidateEnvPrefix", mock.Anything, "my-app").
+		Return(errors.New("must be uppercase")).Once()
+
+	factory := func(*cobra.Command) interfaces.Renderer { return mocks.NewMockRenderer(t) }
+	flusher := func(*cobra.Command, interfaces.Renderer) {}
+
+	cobraCmd := NewNewCommand(mockValidator, mockGenerator, factory, flusher, noPrompts, noWizard).Command()
+	cobraCmd.SetOut(new(bytes.Buffer))
+	cobraCmd.SetErr(new(bytes.Buffer))
+	cobraCmd.SetArgs([]string{"myapp", "--env-prefix", "my-app"})
+
+	err := cobraCmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "invalid env prefix") {
+		t.Fatalf("expected env prefix error, got: %v", err)
+	}
+}
+
+func TestNewCommand_AnswersFile(t *testing.T) {
+	answers := filepath.Join(t.TempDir(), "answers.yaml")
+	if err := os.WriteFile(answers, []byte(`
+project_name: webapp
+module_path: github.com/acme/webapp
+database_driver: postgres
+env_prefix: WEB
+init_git: false
+output_path: ./services
+go_version: "1.24"
+profile: api
+ci: gitlab
+`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantCfg generator.ProjectConfig
+	}{
+		{
+			name: "answers only",
+			args: []string{"--config", answers},
+			wantCfg: generator.ProjectConfig{
+				ProjectName:    "webapp",
+				ModulePath:     "github.com/acme/webapp",
+				DatabaseDriver: "postgres",
+				EnvPrefix:      "WEB",
+				OutputPath:     "./services",
+				GoVersion:      "1.24",
+				Profile:        "api",
+				CI:             "gitlab",
+			},
+		},
+		{
+			name: "flags and name override answers",
+			args: []string{"api", "--config", answers, "--db", "sqlite3", "--go-version", "1.25.1", "--no-git=false", "--profile", "full", "--no-docker"},
+			wantCfg: generator.ProjectConfig{
+				ProjectName:    "api",
+				ModulePath:     "github.com/acme/webapp",
+				DatabaseDriver: "sqlite3",
+				EnvPrefix:      "WEB",
+				InitGit:        true,
+				OutputPath:     "./services",
+				GoVersion:      "1.25.1",
+				Profile:        "full",
+				NoDocker:       true,
+				CI:             "gitlab",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := tt.wantCfg
+			mockValidator := mocks.NewMockValidator(t)
+			mockGenerator := mocks.NewMockProjectGenerator(t)
+			mockRenderer := mocks.NewMockRenderer(t)
+			mockRenderer.On("Title", mock.Anything).Maybe()
+			mockRenderer.On("Section", mock.Anything).Maybe()
+			mockRenderer.On("Result", mock.Anything).Maybe()
+
+			mockValidator.On("ValidateGoVersion", mock.Anything, want.GoVersion).Return(nil).Once()
+			mockValidator.On("ValidateProjectName", mock.Anything, want.ProjectName).Return(nil).Once()
+			mockValidator.On("ValidateDatabaseDriver", mock.Anything, want.DatabaseDriver).Return(nil).Once()
+			mockValidator.On("ValidateModulePath", mock.Anything, want.ModulePath).Return(nil).Once()
+			mockValidator.On("ValidateEnvPrefix", mock.Anything, want.EnvPrefix).Return(nil).Once()
+			mockValidator.On("ValidateDirectory", mock.Anything, filepath.Join(want.OutputPath, want.ProjectName)).Return(nil).Once()
+			mockGenerator.On("Validate", mock.Anything).Return(nil).Once()
+			mockGenerator.On("Generate", mock.Anything, mock.MatchedBy(func(cfg generator.ProjectConfig) bool {
+				return cfg.ProjectName == want.ProjectName &&
+					cfg.ModulePath == want.ModulePath &&
+					cfg.DatabaseDriver == want.DatabaseDriver &&
+					cfg.EnvPrefix == want.EnvPrefix &&
+					cfg.InitGit == want.InitGit &&
+					cfg.OutputPath == want.OutputPath &&
+					cfg.GoVersion == want.GoVersion &&
+					cfg.Profile == want.Profile &&
+					cfg.NoDocker == want.NoDocker &&
+					cfg.CI == want.CI
+			})).Return(nil).Once()
+
+			factory := func(*cobra.Command) interfaces.Renderer { return mockRenderer }
+			flusher := func(*cobra.Command, interfaces.Renderer) {}
+
+			cobraCmd := NewNewCommand(mockValidator, mockGenerator, factory, flusher, noPrompts, noWizard).Command()
+			cobraCmd.SetOut(new(bytes.Buffer))
+			cobraCmd.SetErr(new(bytes.Buffer))
+			cobraCmd.SetArgs(tt.args)
+
+			if err := cobraCmd.Execute(); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestNewCommand_AnswersFileErrors(t *testing.T) {
+	dir := t.TempDir()
+	unknown := filepath.Join(dir, "unknown.yaml")
+	if err := os.WriteFile(unknown, []byte("project: webapp\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	badVersion := filepath.Join(dir, "version.yaml")
+	if err := os.WriteFile(badVersion, []byte("project_name: webapp\ngo_version: latest\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		file    string
+		wantErr string
+	}{
+		{name: "missing file", file: filepath.Join(dir, "missing.yaml"), wantErr: "failed to read answers file"},
+		{name: "unknown field", file: unknown, wantErr: `unknown field "project"`},
+		{name: "invalid go version", file: badVersion, wantErr: "invalid Go version"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockValidator := mocks.NewMockValidator(t)
+			mockValidator.On("ValidateGoVersion", mock.Anything, "latest").
+				Return(errors.New("must be a Go release")).Maybe()
+
+			factory := func(*cobra.Command) interfaces.Renderer { return mocks.NewMockRenderer(t) }
+			flusher := func(*cobra.Command, interfaces.Renderer) {}
+
+			cobraCmd := NewNewCommand(mockValidator, mocks.NewMockProjectGenerator(t), factory, flusher, noPrompts, noWizard).Command()
+			cobraCmd.SetOut(new(bytes.Buffer))
+			cobraCmd.SetErr(new(bytes.Buffer))
+			cobraCmd.SetArgs([]string{"--config", tt.file})
+
+			err := cobraCmd.Execute()
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expected error containing %q, got: %v", tt.wantErr, err)
+			}
+			if code := ErrorCode(err); code != CodeInvalidArgument {
+				t.Errorf("ErrorCode() = %q, want %q", code, CodeInvalidArgument)
+			}
+		})
+	}
+}
+
+func TestNewCommand_Features(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantCfg generator.ProjectConfig
+	}{
+		{
+			name:    "full by default",
+			args:    []string{"myapp"},
+			wantCfg: generator.ProjectConfig{Profile: "full"},
+		},
+		{
+			name:    "profile and ci",
+			args:    []string{"myapp", "--profile", "minimal", "--ci", "gitlab"},
+			wantCfg: generator.ProjectConfig{Profile: "minimal", CI: "gitlab"},
+		},
+		{
+			name:    "feature flags",
+			args:    []string{"myapp", "--no-ui", "--no-node", "--no-docker", "--no-examples", "--ci", "none"},
+			wantCfg: generator.ProjectConfig{Profile: "full", NoUI: true, NoNode: true, NoDocker: true, NoExamples: true, CI: "none"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := tt.wantCfg
+			mockValidator := mocks.NewMockValidator(t)
+			mockGenerator := mocks.NewMockProjectGenerator(t)
+			mockRenderer := mocks.NewMockRenderer(t)
+			mockRenderer.On("Title", mock.Anything).Maybe()
+			mockRenderer.On("Section", mock.Anything).Maybe()
+			mockRenderer.On("Result", mock.Anything).Maybe()
+
+			mockValidator.On("ValidateProjectName", mock.Anything, "myapp").Return(nil).Once()
+			mockValidator.On("ValidateDatabaseDriver", mock.Anything, "go-libsql").Return(nil).Once()
+			mockGenerator.On("Validate", mock.Anything).Return(nil).Once()
+			mockGenerator.On("Generate", mock.Anything, mock.MatchedBy(func(cfg generator.ProjectConfig) bool {
+				return cfg.Profile == want.Profile &&
+					cfg.NoUI == want.NoUI &&
+					cfg.NoNode == want.NoNode &&
+					cfg.NoDocker == want.NoDocker &&
+					cfg.NoExamples == want.NoExamples &&
+					cfg.CI == want.CI
+			})).Return(nil).Once()
+
+			factory := func(*cobra.Command) interfaces.Renderer { return mockRenderer }
+			flusher := func(*cobra.Command, interfaces.Renderer) {}
+
+			cobraCmd := NewNewCommand(mockValidator, mockGenerator, factory, flusher, noPrompts, noWizard).Command()
+			cobraCmd.SetOut(new(bytes.Buffer))
+			cobraCmd.SetErr(new(bytes.Buffer))
+			cobraCmd.SetArgs(tt.args)
+
+			if err := cobraCmd.Execute(); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestNewCommand_FeaturesInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{name: "unknown profile", args: []string{"myapp", "--profile", "huge"}, wantErr: `unknown profile "huge"`},
+		{name: "unknown ci", args: []string{"myapp", "--ci", "jenkins"}, wantErr: `unknown CI provider "jenkins"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			factory := func(*cobra.Command) interfaces.Renderer { return mocks.NewMockRenderer(t) }
+			flusher := func(*cobra.Command, interfaces.Renderer) {}
+
+			cobraCmd := NewNewCommand(mocks.NewMockValidator(t), mocks.NewMockProjectGenerator(t), factory, flusher, noPrompts, noWizard).Command()
+			cobraCmd.SetOut(new(bytes.Buffer))
+			cobraCmd.SetErr(new(bytes.Buffer))
+			cobraCmd.SetArgs(tt.args)
+
+			err := cobraCmd.Execute()
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expected error containing %q, got: %v", tt.wantErr, err)
+			}
+			if code := ErrorCode(err); code != CodeInvalidArgument {
+				t.Errorf("ErrorCode() = %q, want %q", code, CodeInvalidArgument)
+			}
+		})
+	}
+}
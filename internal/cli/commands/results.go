@@ -0,0 +1,262 @@
+package commands
+
+import (
+	"time"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	"github.com/anomalousventures/tracks/internal/database"
+)
+
+// Each command passes one of these results to Renderer.Result. They are
+// serialized as-is in JSON and NDJSON output, and documented by the JSON
+// Schema of the same kind in website/static/schemas/v1. Renaming or
+// removing a field is a breaking change for scripts; adding one is not.
+
+// VersionResult is the result of 'tracks version'.
+type VersionResult struct {
+	Version string `json:"version"`
+	Commit  string `json:"commit"`
+	Date    string `json:"date"`
+}
+
+func (VersionResult) ResultKind() string { return "version" }
+
+// NewResult is the result of 'tracks new'. With --dry-run, Plan lists what
+// would be generated and the other fields describe the project as
+// configured.
+type NewResult struct {
+	Name           string                  `json:"name"`
+	Path           string                  `json:"path"`
+	ModulePath     string                  `json:"module_path"`
+	DatabaseDriver string                  `json:"database_driver"`
+	GitInitialized bool                    `json:"git_initialized"`
+	DryRun         bool                    `json:"dry_run"`
+	Steps          []StepTiming            `json:"steps"`
+	Plan           *interfaces.ProjectPlan `json:"plan,omitempty"`
+}
+
+func (NewResult) ResultKind() string { return "new" }
+
+// StepTiming is how long a generation step took. Status is "ok",
+// "skipped", or "failed" for an optional step that failed without stopping
+// generation.
+type StepTiming struct {
+	Name       string `json:"name"`
+	DurationMS int64  `json:"duration_ms"`
+	Status     string `json:"status"`
+}
+
+// Migration is a migration applied, rolled back or pending.
+type Migration struct {
+	Version int64  `json:"version"`
+	Name    string `json:"name"`
+}
+
+// DBMigrateResult is the result of 'tracks db migrate'. With --dry-run,
+// Migrations are the ones that would be applied.
+type DBMigrateResult struct {
+	DryRun     bool        `json:"dry_run"`
+	Migrations []Migration `json:"migrations"`
+}
+
+func (DBMigrateResult) ResultKind() string { return "db-migrate" }
+
+// DBRollbackResult is the result of 'tracks db rollback'.
+type DBRollbackResult struct {
+	Migrations []Migration `json:"migrations"`
+}
+
+func (DBRollbackResult) ResultKind() string { return "db-rollback" }
+
+// DBResetResult is the result of 'tracks db reset'. Migrations are the
+// ones applied after the reset.
+type DBResetResult struct {
+	Migrations []Migration `json:"migrations"`
+}
+
+func (DBResetResult) ResultKind() string { return "db-reset" }
+
+// DBStatusResult is the result of 'tracks db status'.
+type DBStatusResult struct {
+	// Database is the database URL with its password removed.
+	Database   string            `json:"database"`
+	Source     string            `json:"source"`
+	Driver     string            `json:"driver"`
+	Migrations []MigrationStatus `json:"migrations"`
+	Applied    int               `json:"applied"`
+	Pending    int               `json:"pending"`
+}
+
+func (DBStatusResult) ResultKind() string { return "db-status" }
+
+// MigrationStatus is one migration in a DBStatusResult.
+type MigrationStatus struct {
+	Version   int64      `json:"version"`
+	Name      string     `json:"name"`
+	Applied   bool       `json:"applied"`
+	AppliedAt *time.Time `json:"applied_at,omitempty"`
+}
+
+// UIListResult is the result of 'tracks ui list'.
+type UIListResult struct {
+	Ref        string        `json:"ref,omitempty"`
+	Components []UIComponent `json:"components"`
+}
+
+func (UIListResult) ResultKind() string { return "ui-list" }
+
+// UIComponent is one templUI component in a UIListResult.
+type UIComponent struct {
+	Name      string `json:"name"`
+	Category  string `json:"category,omitempty"`
+	Installed bool   `json:"installed"`
+}
+
+// UIAddResult is the result of 'tracks ui add'.
+type UIAddResult struct {
+	Ref             string   `json:"ref,omitempty"`
+	Components      []string `json:"components"`
+	InjectedScripts []string `json:"injected_scripts"`
+}
+
+func (UIAddResult) ResultKind() string { return "ui-add" }
+
+// UIUpgradeResult is the result of 'tracks ui upgrade'. Version is empty
+// when the installed version could not be read after upgrading.
+type UIUpgradeResult struct {
+	Ref     string `json:"ref,omitempty"`
+	Version string `json:"version,omitempty"`
+}
+
+func (UIUpgradeResult) ResultKind() string { return "ui-upgrade" }
+
+// InitResult is the result of 'tracks init'.
+type InitResult struct {
+	Name           string          `json:"name"`
+	ModulePath     string          `json:"module_path"`
+	DatabaseDriver string          `json:"database_driver"`
+	Files          []GeneratedFile `json:"files"`
+}
+
+func (InitResult) ResultKind() string { return "init" }
+
+// GeneratedFile is a file touched by a generator or upgrade.
+type GeneratedFile struct {
+	Path   string `json:"path"`
+	Action string `json:"action"`
+	Detail string `json:"detail,omitempty"`
+}
+
+// GenerateCIResult is the result of 'tracks generate ci'.
+type GenerateCIResult struct {
+	Provider string          `json:"provider"`
+	Files    []GeneratedFile `json:"files"`
+}
+
+func (GenerateCIResult) ResultKind() string { return "generate-ci" }
+
+// GenerateMigrationResult is the result of 'tracks generate migration'.
+type GenerateMigrationResult struct {
+	Name  string          `json:"name"`
+	Files []GeneratedFile `json:"files"`
+}
+
+func (GenerateMigrationResult) ResultKind() string { return "generate-migration" }
+
+// GenerateResourceResult is the result of 'tracks generate resource'.
+type GenerateResourceResult struct {
+	Name  string          `json:"name"`
+	Files []GeneratedFile `json:"files"`
+}
+
+func (GenerateResourceResult) ResultKind() string { return "generate-resource" }
+
+// StatusResult is the result of 'tracks status'. Files lists every
+// generated file, including untouched ones, whether or not --all is set.
+type StatusResult struct {
+	Project   string       `json:"project"`
+	Files     []FileStatus `json:"files"`
+	Modified  int          `json:"modified"`
+	Deleted   int          `json:"deleted"`
+	Untouched int          `json:"untouched"`
+}
+
+func (StatusResult) ResultKind() string { return "status" }
+
+// FileStatus is one generated file in a StatusResult.
+type FileStatus struct {
+	Path  string `json:"path"`
+	State string `json:"state"`
+}
+
+// UpgradeResult is the result of 'tracks upgrade'. Files omits files that
+// were already up to date; UpToDate counts them.
+type UpgradeResult struct {
+	Version   string          `json:"version"`
+	DryRun    bool            `json:"dry_run"`
+	Files     []GeneratedFile `json:"files"`
+	UpToDate  int             `json:"up_to_date"`
+	Conflicts int             `json:"conflicts"`
+}
+
+func (UpgradeResult) ResultKind() string { return "upgrade" }
+
+// TemplatesListResult is the result of 'tracks templates list'.
+type TemplatesListResult struct {
+	Overrides []TemplateOverride `json:"overrides"`
+}
+
+func (TemplatesListResult) ResultKind() string { return "templates-list" }
+
+// TemplateOverride is one override in a TemplatesListResult.
+type TemplateOverride struct {
+	Template string `json:"template"`
+	Scope    string `json:"scope"`
+	Active   bool   `json:"active"`
+	Path     string `json:"path"`
+}
+
+// TemplatesCheckResult is the result of 'tracks templates check'.
+type TemplatesCheckResult struct {
+	Templates int             `json:"templates"`
+	Checks    int             `json:"checks"`
+	Failures  []TemplateCheck `json:"failures"`
+}
+
+func (TemplatesCheckResult) ResultKind() string { return "templates-check" }
+
+// TemplateCheck is a template that failed to render for a driver.
+type TemplateCheck struct {
+	Template string `json:"template"`
+	Driver   string `json:"driver"`
+	Error    string `json:"error"`
+}
+
+// TemplatesEjectResult is the result of 'tracks templates eject'.
+type TemplatesEjectResult struct {
+	Template string `json:"template"`
+	Scope    string `json:"scope"`
+	Path     string `json:"path"`
+}
+
+func (TemplatesEjectResult) ResultKind() string { return "templates-eject" }
+
+// migrations converts the database package's statuses to result
+// migrations. It never returns nil, so the JSON is an empty array.
+func migrations(statuses []database.MigrationStatus) []Migration {
+	result := make([]Migration, 0, len(statuses))
+	for _, s := range statuses {
+		result = append(result, Migration{Version: s.Version, Name: s.Name})
+	}
+	return result
+}
+
+// generatedFiles converts generator results to result files. It never
+// returns nil, so the JSON is an empty array.
+func generatedFiles(files []interfaces.GeneratedFile) []GeneratedFile {
+	result := make([]GeneratedFile, 0, len(files))
+	for _, f := range files {
+		result = append(result, GeneratedFile{Path: f.Path, Action: f.Action, Detail: f.Detail})
+	}
+	return result
+}
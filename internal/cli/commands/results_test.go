@@ -0,0 +1,160 @@
+package commands
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+)
+
+var schemaDir = filepath.Join("..", "..", "..", "website", "static", "schemas", "v1")
+
+var allResults = []interfaces.Result{
+	VersionResult{},
+	NewResult{},
+	DBMigrateResult{},
+	DBRollbackResult{},
+	DBResetResult{},
+	DBStatusResult{},
+	UIListResult{},
+	UIAddResult{},
+	UIUpgradeResult{},
+	InitResult{},
+	GenerateCIResult{},
+	GenerateMigrationResult{},
+	GenerateResourceResult{},
+	StatusResult{},
+	UpgradeResult{},
+	TemplatesListResult{},
+	TemplatesCheckResult{},
+	TemplatesEjectResult{},
+}
+
+type jsonSchema struct {
+	Type       string                `json:"type"`
+	Format     string                `json:"format"`
+	Properties map[string]jsonSchema `json:"properties"`
+	Required   []string              `json:"required"`
+	Items      *jsonSchema           `json:"items"`
+}
+
+// TestResultSchemas checks that every result matches its published schema:
+// each JSON field is a property of the right type, and exactly the fields
+// without omitempty are required.
+func TestResultSchemas(t *testing.T) {
+	kinds := make(map[string]bool)
+	for _, res := range allResults {
+		kind := res.ResultKind()
+		t.Run(kind, func(t *testing.T) {
+			if kinds[kind] {
+				t.Fatalf("duplicate result kind %q", kind)
+			}
+			kinds[kind] = true
+
+			data, err := os.ReadFile(filepath.Join(schemaDir, kind+".json"))
+			if err != nil {
+				t.Fatalf("missing schema: %v", err)
+			}
+			var schema jsonSchema
+			if err := json.Unmarshal(data, &schema); err != nil {
+				t.Fatalf("invalid schema: %v", err)
+			}
+			checkSchema(t, kind, reflect.TypeOf(res), schema)
+		})
+	}
+}
+
+func checkSchema(t *testing.T, path string, typ reflect.Type, schema jsonSchema) {
+	t.Helper()
+
+	if typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	switch {
+	case typ == reflect.TypeOf(time.Time{}):
+		if schema.Type != "string" || schema.Format != "date-time" {
+			t.Errorf("%s: want a date-time string, schema has %q/%q", path, schema.Type, schema.Format)
+		}
+	case typ.Kind() == reflect.Struct:
+		if schema.Type != "object" {
+			t.Errorf("%s: want object, schema has %q", path, schema.Type)
+			return
+		}
+		var fields, required []string
+		for i := 0; i < typ.NumField(); i++ {
+			name, opts, _ := strings.Cut(typ.Field(i).Tag.Get("json"), ",")
+			fields = append(fields, name)
+			if opts != "omitempty" {
+				required = append(required, name)
+			}
+			prop, ok := schema.Properties[name]
+			if !ok {
+				t.Errorf("%s: field %q missing from schema", path, name)
+				continue
+			}
+			checkSchema(t, path+"."+name, typ.Field(i).Type, prop)
+		}
+		if len(schema.Properties) != len(fields) {
+			t.Errorf("%s: schema has properties %v, struct has %v", path, keys(schema.Properties), fields)
+		}
+		sort.Strings(required)
+		sort.Strings(schema.Required)
+		if !reflect.DeepEqual(required, schema.Required) && len(required)+len(schema.Required) > 0 {
+			t.Errorf("%s: required = %v, want %v", path, schema.Required, required)
+		}
+	case typ.Kind() == reflect.Slice:
+		if schema.Type != "array" || schema.Items == nil {
+			t.Errorf("%s: want array, schema has %q", path, schema.Type)
+			return
+		}
+		checkSchema(t, path+"[]", typ.Elem(), *schema.Items)
+	case typ.Kind() == reflect.String:
+		if schema.Type != "string" {
+			t.Errorf("%s: want string, schema has %q", path, schema.Type)
+		}
+	case typ.Kind() == reflect.Bool:
+		if schema.Type != "boolean" {
+			t.Errorf("%s: want boolean, schema has %q", path, schema.Type)
+		}
+	case typ.Kind() >= reflect.Int && typ.Kind() <= reflect.Int64:
+		if schema.Type != "integer" {
+			t.Errorf("%s: want integer, schema has %q", path, schema.Type)
+		}
+	default:
+		t.Errorf("%s: unsupported type %s", path, typ)
+	}
+}
+
+func keys(m map[string]jsonSchema) []string {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestResultsMarshalEmptyArrays(t *testing.T) {
+	data, err := json.Marshal(DBMigrateResult{Migrations: migrations(nil)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := string(data); got != `{"dry_run":false,"migrations":[]}` {
+		t.Errorf("got %s", got)
+	}
+
+	data, err = json.Marshal(UpgradeResult{Files: generatedFiles(nil)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `"files":[]`) {
+		t.Errorf("expected empty files array, got %s", data)
+	}
+}
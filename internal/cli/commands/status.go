@@ -0,0 +1,119 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	"github.com/anomalousventures/tracks/internal/generator"
+	"github.com/spf13/cobra"
+)
+
+// StatusCommand represents the 'status' command.
+type StatusCommand struct {
+	detector      interfaces.ProjectDetector
+	checker       interfaces.StatusChecker
+	newRenderer   RendererFactory
+	flushRenderer RendererFlusher
+}
+
+// NewStatusCommand creates a new instance of the 'status' command with injected dependencies.
+func NewStatusCommand(
+	detector interfaces.ProjectDetector,
+	checker interfaces.StatusChecker,
+	newRenderer RendererFactory,
+	flushRenderer RendererFlusher,
+) *StatusCommand {
+	return &StatusCommand{
+		detector:      detector,
+		checker:       checker,
+		newRenderer:   newRenderer,
+		flushRenderer: flushRenderer,
+	}
+}
+
+// Command returns the cobra.Command for the 'status' command.
+func (c *StatusCommand) Command() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Show which generated files were changed",
+		Long: `Compare the project with the manifest Tracks recorded when it generated
+the project (.tracks/manifest.json).
+
+Each generated file is reported as modified, deleted, or untouched since
+Tracks last wrote it. Only modified and deleted files are listed unless
+--all is given.`,
+		Example: `  # Show changed generated files
+  tracks status
+
+  # Include untouched files
+  tracks status --all`,
+		Args: cobra.NoArgs,
+		RunE: c.runE,
+	}
+
+	cmd.Flags().Bool("all", false, "List untouched files too")
+
+	return cmd
+}
+
+func (c *StatusCommand) runE(cmd *cobra.Command, _ []string) error {
+	ctx := cmd.Context()
+
+	all, _ := cmd.Flags().GetBool("all")
+
+	project, projectDir, err := c.detector.Detect(ctx, ".")
+	if err != nil {
+		return errNotInProject(err)
+	}
+	if project == nil {
+		return errNotInProject(nil)
+	}
+
+	statuses, err := c.checker.Status(ctx, projectDir)
+	if errors.Is(err, generator.ErrNoManifest) {
+		return withCode(CodeNoManifest, fmt.Errorf("%w: run 'tracks upgrade' to record one for this project", err))
+	}
+	if err != nil {
+		return fmt.Errorf("failed to check project status: %w", err)
+	}
+
+	r := c.newRenderer(cmd)
+	defer c.flushRenderer(cmd, r)
+
+	r.Title(fmt.Sprintf("Generated files in %s", project.Name))
+
+	var rows [][]string
+	counts := make(map[string]int)
+	files := make([]FileStatus, 0, len(statuses))
+	for _, s := range statuses {
+		counts[s.State]++
+		files = append(files, FileStatus{Path: s.Path, State: s.State})
+		if all || s.State != interfaces.FileStateUntouched {
+			rows = append(rows, []string{s.Path, s.State})
+		}
+	}
+
+	if len(rows) > 0 {
+		r.Table(interfaces.Table{
+			Headers: []string{"File", "State"},
+			Rows:    rows,
+		})
+	}
+
+	r.Section(interfaces.Section{
+		Body: fmt.Sprintf("%d modified, %d deleted, %d untouched.",
+			counts[interfaces.FileStateModified],
+			counts[interfaces.FileStateDeleted],
+			counts[interfaces.FileStateUntouched]),
+	})
+	r.Result(StatusResult{
+		Project:   project.Name,
+		Files:     files,
+		Modified:  counts[interfaces.FileStateModified],
+		Deleted:   counts[interfaces.FileStateDeleted],
+		Untouched: counts[interfaces.FileStateUntouched],
+	})
+
+	return nil
+}
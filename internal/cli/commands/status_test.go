@@ -0,0 +1,197 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	"github.com/anomalousventures/tracks/internal/cli/renderer"
+	"github.com/anomalousventures/tracks/internal/generator"
+	"github.com/anomalousventures/tracks/tests/mocks"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/mock"
+)
+
+func setupStatusTestCommand(t *testing.T) (*cobra.Command, *mocks.MockProjectDetector, *mocks.MockStatusChecker, *mocks.MockRenderer) {
+	mockDetector := mocks.NewMockProjectDetector(t)
+	mockChecker := mocks.NewMockStatusChecker(t)
+	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Result", mock.Anything).Return().Maybe()
+
+	factory := func(*cobra.Command) interfaces.Renderer {
+		return mockRenderer
+	}
+	flusher := func(*cobra.Command, interfaces.Renderer) {
+		mockRenderer.Flush()
+	}
+
+	cmd := NewStatusCommand(mockDetector, mockChecker, factory, flusher)
+	cobraCmd := cmd.Command()
+	cobraCmd.SetOut(new(bytes.Buffer))
+	cobraCmd.SetErr(new(bytes.Buffer))
+
+	return cobraCmd, mockDetector, mockChecker, mockRenderer
+}
+
+var statusTestFiles = []interfaces.FileStatus{
+	{Path: "Makefile", State: interfaces.FileStateModified},
+	{Path: "README.md", State: interfaces.FileStateDeleted},
+	{Path: "go.mod", State: interfaces.FileStateUntouched},
+}
+
+func TestStatusCommand_Command(t *testing.T) {
+	cobraCmd, _, _, _ := setupStatusTestCommand(t)
+
+	if cobraCmd.Use != "status" {
+		t.Errorf("expected Use to be 'status', got %q", cobraCmd.Use)
+	}
+	if cobraCmd.Short == "" || cobraCmd.Long == "" || cobraCmd.Example == "" {
+		t.Error("expected Short, Long and Example to be set")
+	}
+	if cobraCmd.Flags().Lookup("all") == nil {
+		t.Error("expected --all flag")
+	}
+}
+
+func TestStatusCommand_ChangedFiles(t *testing.T) {
+	cobraCmd, mockDetector, mockChecker, mockRenderer := setupStatusTestCommand(t)
+
+	project := &interfaces.TracksProject{Name: "testapp"}
+	mockDetector.On("Detect", mock.Anything, ".").Return(project, "/tmp/testapp", nil).Once()
+	mockChecker.On("Status", mock.Anything, "/tmp/testapp").Return(statusTestFiles, nil).Once()
+
+	mockRenderer.On("Title", "Generated files in testapp").Once()
+	mockRenderer.On("Table", interfaces.Table{
+		Headers: []string{"File", "State"},
+		Rows: [][]string{
+			{"Makefile", "modified"},
+			{"README.md", "deleted"},
+		},
+	}).Once()
+	mockRenderer.On("Section", interfaces.Section{Body: "1 modified, 1 deleted, 1 untouched."}).Once()
+	mockRenderer.On("Flush").Return(nil).Once()
+
+	cobraCmd.SetArgs([]string{})
+	if err := cobraCmd.Execute(); err != nil {
+		t.Fatalf("execution failed: %v", err)
+	}
+}
+
+func TestStatusCommand_All(t *testing.T) {
+	cobraCmd, mockDetector, mockChecker, mockRenderer := setupStatusTestCommand(t)
+
+	project := &interfaces.TracksProject{Name: "testapp"}
+	mockDetector.On("Detect", mock.Anything, ".").Return(project, "/tmp/testapp", nil).Once()
+	mockChecker.On("Status", mock.Anything, "/tmp/testapp").Return(statusTestFiles, nil).Once()
+
+	mockRenderer.On("Title", mock.Anything).Once()
+	mockRenderer.On("Table", mock.MatchedBy(func(tbl interfaces.Table) bool {
+		return len(tbl.Rows) == 3
+	})).Once()
+	mockRenderer.On("Section", mock.Anything).Once()
+	mockRenderer.On("Flush").Return(nil).Once()
+
+	cobraCmd.SetArgs([]string{"--all"})
+	if err := cobraCmd.Execute(); err != nil {
+		t.Fatalf("execution failed: %v", err)
+	}
+}
+
+func TestStatusCommand_AllUntouched(t *testing.T) {
+	cobraCmd, mockDetector, mockChecker, mockRenderer := setupStatusTestCommand(t)
+
+	project := &interfaces.TracksProject{Name: "testapp"}
+	mockDetector.On("Detect", mock.Anything, ".").Return(project, "/tmp/testapp", nil).Once()
+	mockChecker.On("Status", mock.Anything, "/tmp/testapp").
+		Return([]interfaces.FileStatus{{Path: "go.mod", State: interfaces.FileStateUntouched}}, nil).Once()
+
+	mockRenderer.On("Title", mock.Anything).Once()
+	mockRenderer.On("Section", interfaces.Section{Body: "0 modified, 0 deleted, 1 untouched."}).Once()
+	mockRenderer.On("Flush").Return(nil).Once()
+
+	cobraCmd.SetArgs([]string{})
+	if err := cobraCmd.Execute(); err != nil {
+		t.Fatalf("execution failed: %v", err)
+	}
+}
+
+func TestStatusCommand_NoManifest(t *testing.T) {
+	cobraCmd, mockDetector, mockChecker, _ := setupStatusTestCommand(t)
+
+	project := &interfaces.TracksProject{Name: "testapp"}
+	mockDetector.On("Detect", mock.Anything, ".").Return(project, "/tmp/testapp", nil).Once()
+	mockChecker.On("Status", mock.Anything, "/tmp/testapp").Return(nil, generator.ErrNoManifest).Once()
+
+	cobraCmd.SetArgs([]string{})
+	err := cobraCmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "tracks upgrade") {
+		t.Fatalf("expected no manifest error suggesting tracks upgrade, got %v", err)
+	}
+}
+
+func TestStatusCommand_NotInProject(t *testing.T) {
+	cobraCmd, mockDetector, _, _ := setupStatusTestCommand(t)
+
+	mockDetector.On("Detect", mock.Anything, ".").
+		Return(nil, "", errors.New("no .tracks.yaml found")).Once()
+
+	cobraCmd.SetArgs([]string{})
+	err := cobraCmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "not in a Tracks project") {
+		t.Fatalf("expected project error, got %v", err)
+	}
+}
+
+func TestStatusCommand_JSONResult(t *testing.T) {
+	mockDetector := mocks.NewMockProjectDetector(t)
+	mockChecker := mocks.NewMockStatusChecker(t)
+
+	var out bytes.Buffer
+	factory := func(*cobra.Command) interfaces.Renderer {
+		return renderer.NewJSONRenderer(&out)
+	}
+	flusher := func(_ *cobra.Command, r interfaces.Renderer) {
+		_ = r.Flush()
+	}
+
+	project := &interfaces.TracksProject{Name: "testapp"}
+	mockDetector.On("Detect", mock.Anything, ".").Return(project, "/tmp/testapp", nil).Once()
+	mockChecker.On("Status", mock.Anything, "/tmp/testapp").Return(statusTestFiles, nil).Once()
+
+	cobraCmd := NewStatusCommand(mockDetector, mockChecker, factory, flusher).Command()
+	cobraCmd.SetArgs([]string{})
+	if err := cobraCmd.Execute(); err != nil {
+		t.Fatalf("execution failed: %v", err)
+	}
+
+	var doc struct {
+		SchemaVersion int          `json:"schema_version"`
+		Kind          string       `json:"kind"`
+		Result        StatusResult `json:"result"`
+	}
+	if err := json.Unmarshal(out.Bytes(), &doc); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, out.String())
+	}
+
+	if doc.SchemaVersion != renderer.ResultSchemaVersion || doc.Kind != "status" {
+		t.Errorf("schema_version = %d, kind = %q", doc.SchemaVersion, doc.Kind)
+	}
+	want := StatusResult{
+		Project: "testapp",
+		Files: []FileStatus{
+			{Path: "Makefile", State: "modified"},
+			{Path: "README.md", State: "deleted"},
+			{Path: "go.mod", State: "untouched"},
+		},
+		Modified:  1,
+		Deleted:   1,
+		Untouched: 1,
+	}
+	if !reflect.DeepEqual(doc.Result, want) {
+		t.Errorf("result = %+v, want %+v", doc.Result, want)
+	}
+}
@@ -0,0 +1,76 @@
+package commands
+
+import (
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	"github.com/spf13/cobra"
+)
+
+// TemplatesCommand represents the 'templates' parent command for managing
+// template overrides.
+type TemplatesCommand struct {
+	detector      interfaces.ProjectDetector
+	manager       interfaces.TemplateManager
+	newRenderer   RendererFactory
+	flushRenderer RendererFlusher
+}
+
+// NewTemplatesCommand creates a new instance of the 'templates' command with injected dependencies.
+func NewTemplatesCommand(
+	detector interfaces.ProjectDetector,
+	manager interfaces.TemplateManager,
+	newRenderer RendererFactory,
+	flushRenderer RendererFlusher,
+) *TemplatesCommand {
+	return &TemplatesCommand{
+		detector:      detector,
+		manager:       manager,
+		newRenderer:   newRenderer,
+		flushRenderer: flushRenderer,
+	}
+}
+
+// Command returns the cobra.Command for the 'templates' subcommand.
+func (c *TemplatesCommand) Command() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "templates",
+		Short: "Customize the templates Tracks generates from",
+		Long: `Customize the templates Tracks generates from.
+
+Templates are embedded in the CLI. A file in an override directory shadows
+the embedded template with the same path:
+
+  .tracks/templates/            per project, committed with the project
+  ~/.config/tracks/templates/   per user, for every project
+
+Project overrides take precedence over user overrides. tracks new only sees
+user overrides, because the project does not exist yet; tracks upgrade and
+tracks generate see both.`,
+		Example: `  # Copy the default Dockerfile template into the project for editing
+  tracks templates eject Dockerfile.tmpl
+
+  # Override the CI workflow for every project you generate
+  tracks templates eject .github/workflows/ci.yml.tmpl --user
+
+  # Show which overrides are active
+  tracks templates list
+
+  # Verify every template still renders and parses
+  tracks templates check`,
+		Run: c.run,
+	}
+
+	listCmd := NewTemplatesListCommand(c.detector, c.manager, c.newRenderer, c.flushRenderer)
+	cmd.AddCommand(listCmd.Command())
+
+	ejectCmd := NewTemplatesEjectCommand(c.detector, c.manager, c.newRenderer, c.flushRenderer)
+	cmd.AddCommand(ejectCmd.Command())
+
+	checkCmd := NewTemplatesCheckCommand(c.detector, c.manager, c.newRenderer, c.flushRenderer)
+	cmd.AddCommand(checkCmd.Command())
+
+	return cmd
+}
+
+func (c *TemplatesCommand) run(cmd *cobra.Command, _ []string) {
+	_ = cmd.Help()
+}
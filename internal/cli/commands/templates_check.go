@@ -0,0 +1,106 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	"github.com/spf13/cobra"
+)
+
+// TemplatesCheckCommand represents the 'templates check' subcommand.
+type TemplatesCheckCommand struct {
+	detector      interfaces.ProjectDetector
+	manager       interfaces.TemplateManager
+	newRenderer   RendererFactory
+	flushRenderer RendererFlusher
+}
+
+// NewTemplatesCheckCommand creates a new instance of the 'templates check' command with injected dependencies.
+func NewTemplatesCheckCommand(
+	detector interfaces.ProjectDetector,
+	manager interfaces.TemplateManager,
+	newRenderer RendererFactory,
+	flushRenderer RendererFlusher,
+) *TemplatesCheckCommand {
+	return &TemplatesCheckCommand{
+		detector:      detector,
+		manager:       manager,
+		newRenderer:   newRenderer,
+		flushRenderer: flushRenderer,
+	}
+}
+
+// Command returns the cobra.Command for the 'templates check' subcommand.
+func (c *TemplatesCheckCommand) Command() *cobra.Command {
+	return &cobra.Command{
+		Use:   "check",
+		Short: "Render every template for every database driver",
+		Long: `Render every project, resource and migration template for each database
+driver (go-libsql, sqlite3, postgres) with sample data, and verify that the
+rendered Go and templ files parse.
+
+Overrides are applied, so run it after editing an override to catch mistakes
+before tracks new, generate or upgrade does. Inside a project the project's
+overrides are checked too. The command fails if any template does not render.`,
+		Example: `  # Check the templates, including your overrides
+  tracks templates check`,
+		Args: cobra.NoArgs,
+		RunE: c.runE,
+	}
+}
+
+func (c *TemplatesCheckCommand) runE(cmd *cobra.Command, _ []string) error {
+	ctx := cmd.Context()
+
+	projectDir := ""
+	if project, dir, err := c.detector.Detect(ctx, "."); err == nil && project != nil {
+		projectDir = dir
+	}
+
+	results, err := c.manager.Check(ctx, projectDir)
+	if err != nil {
+		return err
+	}
+
+	r := c.newRenderer(cmd)
+	defer c.flushRenderer(cmd, r)
+
+	r.Title("Template check")
+
+	templates := make(map[string]bool)
+	var rows [][]string
+	failures := make([]TemplateCheck, 0)
+	for _, result := range results {
+		templates[result.Template] = true
+		if result.Err != nil {
+			rows = append(rows, []string{result.Template, result.Driver, result.Err.Error()})
+			failures = append(failures, TemplateCheck{
+				Template: result.Template,
+				Driver:   result.Driver,
+				Error:    result.Err.Error(),
+			})
+		}
+	}
+	r.Result(TemplatesCheckResult{
+		Templates: len(templates),
+		Checks:    len(results),
+		Failures:  failures,
+	})
+
+	if len(rows) == 0 {
+		r.Section(interfaces.Section{
+			Body: fmt.Sprintf("All %d template(s) render for every driver.", len(templates)),
+		})
+		return nil
+	}
+
+	r.Table(interfaces.Table{
+		Headers: []string{"Template", "Driver", "Error"},
+		Rows:    rows,
+	})
+	r.Section(interfaces.Section{
+		Body: fmt.Sprintf("%d of %d check(s) failed.", len(rows), len(results)),
+	})
+
+	return withCode(CodeTemplateCheckFailed, fmt.Errorf("%d template check(s) failed", len(rows)))
+}
@@ -0,0 +1,97 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	"github.com/spf13/cobra"
+)
+
+// TemplatesEjectCommand represents the 'templates eject' subcommand.
+type TemplatesEjectCommand struct {
+	detector      interfaces.ProjectDetector
+	manager       interfaces.TemplateManager
+	newRenderer   RendererFactory
+	flushRenderer RendererFlusher
+}
+
+// NewTemplatesEjectCommand creates a new instance of the 'templates eject' command with injected dependencies.
+func NewTemplatesEjectCommand(
+	detector interfaces.ProjectDetector,
+	manager interfaces.TemplateManager,
+	newRenderer RendererFactory,
+	flushRenderer RendererFlusher,
+) *TemplatesEjectCommand {
+	return &TemplatesEjectCommand{
+		detector:      detector,
+		manager:       manager,
+		newRenderer:   newRenderer,
+		flushRenderer: flushRenderer,
+	}
+}
+
+// Command returns the cobra.Command for the 'templates eject' subcommand.
+func (c *TemplatesEjectCommand) Command() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "eject <template>",
+		Short: "Copy a default template into an override directory",
+		Long: `Copy an embedded template into an override directory so it can be edited.
+
+Template paths are relative to the embedded templates. Project templates may
+omit the "project/" prefix; resource and migration templates keep theirs
+(e.g. resource/handler.go.tmpl).
+
+By default the template is copied into the project's .tracks/templates
+directory. Use --user to copy it into ~/.config/tracks/templates instead,
+which applies to every project, including new ones.`,
+		Example: `  # Customize the Dockerfile for this project
+  tracks templates eject Dockerfile.tmpl
+
+  # Customize the base layout for every new project
+  tracks templates eject internal/http/views/layouts/base.templ.tmpl --user`,
+		Args: cobra.ExactArgs(1),
+		RunE: c.runE,
+	}
+
+	cmd.Flags().Bool("user", false, "Copy into the user template directory instead of the project's")
+	cmd.Flags().Bool("force", false, "Overwrite an existing override")
+
+	return cmd
+}
+
+func (c *TemplatesEjectCommand) runE(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	user, _ := cmd.Flags().GetBool("user")
+	force, _ := cmd.Flags().GetBool("force")
+
+	scope := interfaces.TemplateScopeUser
+	projectDir := ""
+	if !user {
+		scope = interfaces.TemplateScopeProject
+		project, dir, err := c.detector.Detect(ctx, ".")
+		if err != nil {
+			return errNotInProject(err)
+		}
+		if project == nil {
+			return withCode(CodeNotInProject, fmt.Errorf("not in a Tracks project (no .tracks.yaml found); use --user to eject for every project"))
+		}
+		projectDir = dir
+	}
+
+	path, err := c.manager.Eject(ctx, projectDir, args[0], scope, force)
+	if err != nil {
+		return err
+	}
+
+	r := c.newRenderer(cmd)
+	defer c.flushRenderer(cmd, r)
+
+	r.Title(fmt.Sprintf("Ejected %s", args[0]))
+	r.Result(TemplatesEjectResult{Template: args[0], Scope: scope, Path: path})
+	r.Section(interfaces.Section{
+		Body: fmt.Sprintf("Edit %s to customize it. Delete the file to go back to the default template.", path),
+	})
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	"github.com/spf13/cobra"
+)
+
+// TemplatesListCommand represents the 'templates list' subcommand.
+type TemplatesListCommand struct {
+	detector      interfaces.ProjectDetector
+	manager       interfaces.TemplateManager
+	newRenderer   RendererFactory
+	flushRenderer RendererFlusher
+}
+
+// NewTemplatesListCommand creates a new instance of the 'templates list' command with injected dependencies.
+func NewTemplatesListCommand(
+	detector interfaces.ProjectDetector,
+	manager interfaces.TemplateManager,
+	newRenderer RendererFactory,
+	flushRenderer RendererFlusher,
+) *TemplatesListCommand {
+	return &TemplatesListCommand{
+		detector:      detector,
+		manager:       manager,
+		newRenderer:   newRenderer,
+		flushRenderer: flushRenderer,
+	}
+}
+
+// Command returns the cobra.Command for the 'templates list' subcommand.
+func (c *TemplatesListCommand) Command() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List template overrides",
+		Long: `List the files in the user and project template override directories.
+
+An override is inactive when a project override shadows it, or when its path
+matches no embedded template, usually because of a typo or a template that
+was renamed. Outside a project only user overrides are listed.`,
+		Example: `  # Show template overrides
+  tracks templates list`,
+		Args: cobra.NoArgs,
+		RunE: c.runE,
+	}
+}
+
+func (c *TemplatesListCommand) runE(cmd *cobra.Command, _ []string) error {
+	ctx := cmd.Context()
+
+	projectDir := ""
+	if project, dir, err := c.detector.Detect(ctx, "."); err == nil && project != nil {
+		projectDir = dir
+	}
+
+	overrides, err := c.manager.Overrides(ctx, projectDir)
+	if err != nil {
+		return err
+	}
+
+	r := c.newRenderer(cmd)
+	defer c.flushRenderer(cmd, r)
+
+	r.Title("Template overrides")
+
+	result := TemplatesListResult{Overrides: make([]TemplateOverride, 0, len(overrides))}
+	for _, o := range overrides {
+		result.Overrides = append(result.Overrides, TemplateOverride{
+			Template: o.Template,
+			Scope:    o.Scope,
+			Active:   o.Active,
+			Path:     o.Path,
+		})
+	}
+	r.Result(result)
+
+	if len(overrides) == 0 {
+		r.Section(interfaces.Section{
+			Body: "No template overrides. Use 'tracks templates eject <template>' to customize one.",
+		})
+		return nil
+	}
+
+	rows := make([][]string, 0, len(overrides))
+	active := 0
+	for _, o := range overrides {
+		state := "inactive"
+		if o.Active {
+			state = "active"
+			active++
+		}
+		rows = append(rows, []string{o.Template, o.Scope, state, o.Path})
+	}
+	r.Table(interfaces.Table{
+		Headers: []string{"Template", "Scope", "State", "Path"},
+		Rows:    rows,
+	})
+	r.Section(interfaces.Section{
+		Body: fmt.Sprintf("%d of %d override(s) active.", active, len(overrides)),
+	})
+
+	return nil
+}
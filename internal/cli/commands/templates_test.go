@@ -0,0 +1,186 @@
+package commands
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	"github.com/anomalousventures/tracks/tests/mocks"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/mock"
+)
+
+func setupTemplatesTestCommand(t *testing.T, args ...string) (*cobra.Command, *mocks.MockProjectDetector, *mocks.MockTemplateManager, *mocks.MockRenderer) {
+	mockDetector := mocks.NewMockProjectDetector(t)
+	mockManager := mocks.NewMockTemplateManager(t)
+	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Result", mock.Anything).Return().Maybe()
+
+	factory := func(*cobra.Command) interfaces.Renderer {
+		return mockRenderer
+	}
+	flusher := func(*cobra.Command, interfaces.Renderer) {
+		mockRenderer.Flush()
+	}
+
+	cobraCmd := NewTemplatesCommand(mockDetector, mockManager, factory, flusher).Command()
+	cobraCmd.SetOut(new(bytes.Buffer))
+	cobraCmd.SetErr(new(bytes.Buffer))
+	cobraCmd.SetArgs(args)
+
+	return cobraCmd, mockDetector, mockManager, mockRenderer
+}
+
+func TestTemplatesCommand_Subcommands(t *testing.T) {
+	cobraCmd, _, _, _ := setupTemplatesTestCommand(t)
+
+	for _, name := range []string{"list", "eject", "check"} {
+		sub, _, err := cobraCmd.Find([]string{name})
+		if err != nil || sub.Name() != name {
+			t.Errorf("expected %q subcommand, got %v (%v)", name, sub, err)
+		}
+	}
+}
+
+func TestTemplatesListCommand(t *testing.T) {
+	cobraCmd, mockDetector, mockManager, mockRenderer := setupTemplatesTestCommand(t, "list")
+
+	project := &interfaces.TracksProject{Name: "testapp"}
+	mockDetector.On("Detect", mock.Anything, ".").Return(project, "/tmp/testapp", nil).Once()
+	mockManager.On("Overrides", mock.Anything, "/tmp/testapp").Return([]interfaces.TemplateOverride{
+		{Template: "project/Dockerfile.tmpl", Scope: interfaces.TemplateScopeProject, Path: "/tmp/testapp/.tracks/templates/project/Dockerfile.tmpl", Active: true},
+		{Template: "project/Dockerfile.tmpl", Scope: interfaces.TemplateScopeUser, Path: "/home/me/.config/tracks/templates/project/Dockerfile.tmpl"},
+	}, nil).Once()
+	mockRenderer.On("Title", "Template overrides").Once()
+	mockRenderer.On("Table", interfaces.Table{
+		Headers: []string{"Template", "Scope", "State", "Path"},
+		Rows: [][]string{
+			{"project/Dockerfile.tmpl", "project", "active", "/tmp/testapp/.tracks/templates/project/Dockerfile.tmpl"},
+			{"project/Dockerfile.tmpl", "user", "inactive", "/home/me/.config/tracks/templates/project/Dockerfile.tmpl"},
+		},
+	}).Once()
+	mockRenderer.On("Section", interfaces.Section{Body: "1 of 2 override(s) active."}).Once()
+	mockRenderer.On("Flush").Return(nil).Once()
+
+	if err := cobraCmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestTemplatesListCommand_OutsideProject(t *testing.T) {
+	cobraCmd, mockDetector, mockManager, mockRenderer := setupTemplatesTestCommand(t, "list")
+
+	mockDetector.On("Detect", mock.Anything, ".").Return(nil, "", errors.New("no .tracks.yaml")).Once()
+	mockManager.On("Overrides", mock.Anything, "").Return(nil, nil).Once()
+	mockRenderer.On("Title", "Template overrides").Once()
+	mockRenderer.On("Section", mock.MatchedBy(func(s interfaces.Section) bool {
+		return strings.Contains(s.Body, "No template overrides")
+	})).Once()
+	mockRenderer.On("Flush").Return(nil).Once()
+
+	if err := cobraCmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestTemplatesEjectCommand(t *testing.T) {
+	cobraCmd, mockDetector, mockManager, mockRenderer := setupTemplatesTestCommand(t, "eject", "Dockerfile.tmpl", "--force")
+
+	project := &interfaces.TracksProject{Name: "testapp"}
+	mockDetector.On("Detect", mock.Anything, ".").Return(project, "/tmp/testapp", nil).Once()
+	mockManager.On("Eject", mock.Anything, "/tmp/testapp", "Dockerfile.tmpl", interfaces.TemplateScopeProject, true).
+		Return("/tmp/testapp/.tracks/templates/project/Dockerfile.tmpl", nil).Once()
+	mockRenderer.On("Title", "Ejected Dockerfile.tmpl").Once()
+	mockRenderer.On("Section", mock.MatchedBy(func(s interfaces.Section) bool {
+		return strings.Contains(s.Body, "/tmp/testapp/.tracks/templates/project/Dockerfile.tmpl")
+	})).Once()
+	mockRenderer.On("Flush").Return(nil).Once()
+
+	if err := cobraCmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestTemplatesEjectCommand_User(t *testing.T) {
+	cobraCmd, _, mockManager, mockRenderer := setupTemplatesTestCommand(t, "eject", "resource/handler.go.tmpl", "--user")
+
+	mockManager.On("Eject", mock.Anything, "", "resource/handler.go.tmpl", interfaces.TemplateScopeUser, false).
+		Return("/home/me/.config/tracks/templates/resource/handler.go.tmpl", nil).Once()
+	mockRenderer.On("Title", mock.Anything).Once()
+	mockRenderer.On("Section", mock.Anything).Once()
+	mockRenderer.On("Flush").Return(nil).Once()
+
+	if err := cobraCmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestTemplatesEjectCommand_NotInProject(t *testing.T) {
+	cobraCmd, mockDetector, _, _ := setupTemplatesTestCommand(t, "eject", "Dockerfile.tmpl")
+
+	mockDetector.On("Detect", mock.Anything, ".").Return(nil, "", nil).Once()
+
+	err := cobraCmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "--user") {
+		t.Fatalf("expected error suggesting --user, got %v", err)
+	}
+}
+
+func TestTemplatesEjectCommand_Error(t *testing.T) {
+	cobraCmd, mockDetector, mockManager, _ := setupTemplatesTestCommand(t, "eject", "Nope.tmpl")
+
+	project := &interfaces.TracksProject{Name: "testapp"}
+	mockDetector.On("Detect", mock.Anything, ".").Return(project, "/tmp/testapp", nil).Once()
+	mockManager.On("Eject", mock.Anything, "/tmp/testapp", "Nope.tmpl", interfaces.TemplateScopeProject, false).
+		Return("", errors.New(`unknown template "project/Nope.tmpl"`)).Once()
+
+	err := cobraCmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "unknown template") {
+		t.Fatalf("expected unknown template error, got %v", err)
+	}
+}
+
+func TestTemplatesCheckCommand(t *testing.T) {
+	cobraCmd, mockDetector, mockManager, mockRenderer := setupTemplatesTestCommand(t, "check")
+
+	project := &interfaces.TracksProject{Name: "testapp"}
+	mockDetector.On("Detect", mock.Anything, ".").Return(project, "/tmp/testapp", nil).Once()
+	mockManager.On("Check", mock.Anything, "/tmp/testapp").Return([]interfaces.TemplateCheck{
+		{Template: "project/go.mod.tmpl", Driver: "go-libsql"},
+		{Template: "project/go.mod.tmpl", Driver: "postgres"},
+		{Template: "resource/handler.go.tmpl", Driver: "postgres"},
+	}, nil).Once()
+	mockRenderer.On("Title", "Template check").Once()
+	mockRenderer.On("Section", interfaces.Section{Body: "All 2 template(s) render for every driver."}).Once()
+	mockRenderer.On("Flush").Return(nil).Once()
+
+	if err := cobraCmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestTemplatesCheckCommand_Failures(t *testing.T) {
+	cobraCmd, mockDetector, mockManager, mockRenderer := setupTemplatesTestCommand(t, "check")
+
+	mockDetector.On("Detect", mock.Anything, ".").Return(nil, "", errors.New("no .tracks.yaml")).Once()
+	mockManager.On("Check", mock.Anything, "").Return([]interfaces.TemplateCheck{
+		{Template: "resource/handler.go.tmpl", Driver: "go-libsql"},
+		{Template: "resource/handler.go.tmpl", Driver: "postgres", Err: errors.New("rendered output line 3, column 1: expected declaration")},
+	}, nil).Once()
+	mockRenderer.On("Title", "Template check").Once()
+	mockRenderer.On("Table", interfaces.Table{
+		Headers: []string{"Template", "Driver", "Error"},
+		Rows: [][]string{
+			{"resource/handler.go.tmpl", "postgres", "rendered output line 3, column 1: expected declaration"},
+		},
+	}).Once()
+	mockRenderer.On("Section", interfaces.Section{Body: "1 of 2 check(s) failed."}).Once()
+	mockRenderer.On("Flush").Return(nil).Once()
+
+	err := cobraCmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "1 template check(s) failed") {
+		t.Fatalf("expected check failure, got %v", err)
+	}
+}
@@ -8,12 +8,14 @@ import (
 	"strings"
 
 	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	trackscontext "github.com/anomalousventures/tracks/internal/context"
+	"github.com/anomalousventures/tracks/internal/generator/injector"
 	"github.com/spf13/cobra"
 )
 
 const (
-	scriptMarkerBegin = "<!-- TRACKS:UI_SCRIPTS:BEGIN -->"
-	scriptMarkerEnd   = "<!-- TRACKS:UI_SCRIPTS:END -->"
+	uiScriptsMarker  = "UI_SCRIPTS"
+	baseTemplRelPath = "internal/http/views/layouts/base.templ"
 )
 
 // UIAddCommand represents the 'ui add' subcommand for adding templUI components.
@@ -75,7 +77,7 @@ The optional @ref syntax allows you to specify a templUI version:
 
 func (c *UIAddCommand) runE(cmd *cobra.Command, args []string) error {
 	r := c.newRenderer(cmd)
-	ctx := cmd.Context()
+	ctx := trackscontext.WithOutput(cmd.Context(), r.Stream)
 	defer c.flushRenderer(cmd, r)
 
 	project, projectDir, err := c.detector.Detect(ctx, ".")
@@ -104,7 +106,7 @@ func (c *UIAddCommand) runE(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
-	injectedScripts := c.injectScripts(projectDir, args)
+	injectedScripts := c.injectScripts(r, projectDir, args)
 
 	var body strings.Builder
 	body.WriteString(fmt.Sprintf("Added %d component(s): %s", len(args), strings.Join(args, ", ")))
@@ -115,12 +117,17 @@ func (c *UIAddCommand) runE(cmd *cobra.Command, args []string) error {
 	r.Section(interfaces.Section{
 		Body: body.String(),
 	})
+	r.Result(UIAddResult{
+		Ref:             ref,
+		Components:      args,
+		InjectedScripts: append([]string{}, injectedScripts...),
+	})
 
 	return nil
 }
 
-func (c *UIAddCommand) injectScripts(projectDir string, components []string) []string {
-	baseTemplPath := filepath.Join(projectDir, "internal", "http", "views", "layouts", "base.templ")
+func (c *UIAddCommand) injectScripts(r interfaces.Renderer, projectDir string, components []string) []string {
+	baseTemplPath := filepath.Join(projectDir, filepath.FromSlash(baseTemplRelPath))
 	uiDir := filepath.Join(projectDir, "internal", "http", "views", "components", "ui")
 
 	baseContent, err := os.ReadFile(baseTemplPath)
@@ -128,9 +135,7 @@ func (c *UIAddCommand) injectScripts(projectDir string, components []string) []s
 		return nil
 	}
 
-	var injectedScripts []string
-	contentStr := string(baseContent)
-
+	var injectedScripts, scriptCalls []string
 	for _, component := range components {
 		componentFile := filepath.Join(uiDir, component+".templ")
 		scriptFuncName := capitalizeFirst(component) + "Script"
@@ -140,18 +145,26 @@ func (c *UIAddCommand) injectScripts(projectDir string, components []string) []s
 		}
 
 		scriptCall := fmt.Sprintf("@ui.%s()", scriptFuncName)
-		if strings.Contains(contentStr, scriptCall) {
+		if strings.Contains(string(baseContent), scriptCall) {
 			continue
 		}
 
-		contentStr = injectScriptCall(contentStr, scriptCall)
+		scriptCalls = append(scriptCalls, scriptCall)
 		injectedScripts = append(injectedScripts, scriptFuncName)
 	}
 
-	if len(injectedScripts) > 0 {
-		_ = os.WriteFile(baseTemplPath, []byte(contentStr), 0644)
+	if len(scriptCalls) == 0 {
+		return nil
 	}
 
+	contentStr, conflicts := injectScriptCalls(string(baseContent), scriptCalls...)
+	if len(conflicts) > 0 {
+		injector.ReportConflicts(r, conflicts)
+		return nil
+	}
+
+	_ = os.WriteFile(baseTemplPath, []byte(contentStr), 0644)
+
 	return injectedScripts
 }
 
@@ -166,24 +179,20 @@ func hasScriptFunc(componentFile, funcName string) bool {
 	return re.Match(content)
 }
 
-func injectScriptCall(content, scriptCall string) string {
-	beginIdx := strings.Index(content, scriptMarkerBegin)
-	endIdx := strings.Index(content, scriptMarkerEnd)
-
-	if beginIdx == -1 || endIdx == -1 || endIdx <= beginIdx {
-		return content
+// injectScriptCalls adds script calls to the UI_SCRIPTS marker region. If the
+// region is missing or malformed, content is returned unchanged along with the
+// conflicts.
+func injectScriptCalls(content string, scriptCalls ...string) (string, []injector.Conflict) {
+	edits := make([]injector.Edit, len(scriptCalls))
+	for i, call := range scriptCalls {
+		edits[i] = injector.Edit{Marker: uiScriptsMarker, Lines: []string{call}}
 	}
 
-	insertPos := beginIdx + len(scriptMarkerBegin)
-
-	indentation := "\t\t\t"
-	if lineStart := strings.LastIndex(content[:beginIdx], "\n"); lineStart != -1 {
-		lineContent := content[lineStart+1 : beginIdx]
-		indentation = strings.TrimSuffix(lineContent, strings.TrimLeft(lineContent, " \t"))
+	updated, _, conflicts := injector.ApplyEdits(content, injector.TemplSyntax, edits)
+	for i := range conflicts {
+		conflicts[i].Path = baseTemplRelPath
 	}
-
-	newLine := "\n" + indentation + scriptCall
-	return content[:insertPos] + newLine + content[insertPos:]
+	return updated, conflicts
 }
 
 func capitalizeFirst(s string) string {
@@ -18,6 +18,7 @@ func setupUIAddTestCommand(t *testing.T) (*cobra.Command, *mocks.MockUIExecutor,
 	mockExecutor := mocks.NewMockUIExecutor(t)
 	mockDetector := mocks.NewMockProjectDetector(t)
 	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Result", mock.Anything).Return().Maybe()
 
 	factory := func(*cobra.Command) interfaces.Renderer {
 		return mockRenderer
@@ -70,6 +71,7 @@ func TestUIAddCommand_Run_NotInProject(t *testing.T) {
 	mockExecutor := mocks.NewMockUIExecutor(t)
 	mockDetector := mocks.NewMockProjectDetector(t)
 	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Result", mock.Anything).Return().Maybe()
 
 	mockDetector.On("Detect", mock.Anything, ".").
 		Return(nil, "", nil).Once()
@@ -101,6 +103,7 @@ func TestUIAddCommand_Run_Success(t *testing.T) {
 	mockExecutor := mocks.NewMockUIExecutor(t)
 	mockDetector := mocks.NewMockProjectDetector(t)
 	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Result", mock.Anything).Return().Maybe()
 
 	project := &interfaces.TracksProject{Name: "testapp"}
 	mockDetector.On("Detect", mock.Anything, ".").
@@ -139,6 +142,7 @@ func TestUIAddCommand_Run_WithForceFlag(t *testing.T) {
 	mockExecutor := mocks.NewMockUIExecutor(t)
 	mockDetector := mocks.NewMockProjectDetector(t)
 	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Result", mock.Anything).Return().Maybe()
 
 	project := &interfaces.TracksProject{Name: "testapp"}
 	mockDetector.On("Detect", mock.Anything, ".").
@@ -172,6 +176,7 @@ func TestUIAddCommand_Run_MultipleComponents(t *testing.T) {
 	mockExecutor := mocks.NewMockUIExecutor(t)
 	mockDetector := mocks.NewMockProjectDetector(t)
 	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Result", mock.Anything).Return().Maybe()
 
 	project := &interfaces.TracksProject{Name: "testapp"}
 	mockDetector.On("Detect", mock.Anything, ".").
@@ -207,6 +212,7 @@ func TestUIAddCommand_Run_ExecutorError(t *testing.T) {
 	mockExecutor := mocks.NewMockUIExecutor(t)
 	mockDetector := mocks.NewMockProjectDetector(t)
 	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Result", mock.Anything).Return().Maybe()
 
 	project := &interfaces.TracksProject{Name: "testapp"}
 	mockDetector.On("Detect", mock.Anything, ".").
@@ -298,12 +304,13 @@ func TestHasScriptFunc(t *testing.T) {
 	}
 }
 
-func TestInjectScriptCall(t *testing.T) {
+func TestInjectScriptCalls(t *testing.T) {
 	tests := []struct {
-		name       string
-		content    string
-		scriptCall string
-		expected   string
+		name          string
+		content       string
+		scriptCalls   []string
+		expected      string
+		wantConflicts int
 	}{
 		{
 			name: "inject between markers",
@@ -311,7 +318,7 @@ func TestInjectScriptCall(t *testing.T) {
 			<!-- TRACKS:UI_SCRIPTS:BEGIN -->
 			<!-- TRACKS:UI_SCRIPTS:END -->
 		</body>`,
-			scriptCall: "@ui.ToastScript()",
+			scriptCalls: []string{"@ui.ToastScript()"},
 			expected: `<body>
 			<!-- TRACKS:UI_SCRIPTS:BEGIN -->
 			@ui.ToastScript()
@@ -319,18 +326,53 @@ func TestInjectScriptCall(t *testing.T) {
 		</body>`,
 		},
 		{
-			name:       "no markers",
-			content:    "<body></body>",
-			scriptCall: "@ui.ToastScript()",
-			expected:   "<body></body>",
+			name: "appends after existing scripts",
+			content: `<body>
+			<!-- TRACKS:UI_SCRIPTS:BEGIN -->
+			@ui.ToastScript()
+			<!-- TRACKS:UI_SCRIPTS:END -->
+		</body>`,
+			scriptCalls: []string{"@ui.DialogScript()", "@ui.ToastScript()"},
+			expected: `<body>
+			<!-- TRACKS:UI_SCRIPTS:BEGIN -->
+			@ui.ToastScript()
+			@ui.DialogScript()
+			<!-- TRACKS:UI_SCRIPTS:END -->
+		</body>`,
+		},
+		{
+			name:          "no markers",
+			content:       "<body></body>",
+			scriptCalls:   []string{"@ui.ToastScript()"},
+			expected:      "<body></body>",
+			wantConflicts: 1,
+		},
+		{
+			name: "missing end marker",
+			content: `<body>
+			<!-- TRACKS:UI_SCRIPTS:BEGIN -->
+		</body>`,
+			scriptCalls: []string{"@ui.ToastScript()"},
+			expected: `<body>
+			<!-- TRACKS:UI_SCRIPTS:BEGIN -->
+		</body>`,
+			wantConflicts: 1,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := injectScriptCall(tt.content, tt.scriptCall)
+			result, conflicts := injectScriptCalls(tt.content, tt.scriptCalls...)
 			if result != tt.expected {
-				t.Errorf("injectScriptCall() =\n%s\n\nexpected:\n%s", result, tt.expected)
+				t.Errorf("injectScriptCalls() =\n%s\n\nexpected:\n%s", result, tt.expected)
+			}
+			if len(conflicts) != tt.wantConflicts {
+				t.Errorf("expected %d conflict(s), got %v", tt.wantConflicts, conflicts)
+			}
+			for _, c := range conflicts {
+				if c.Path != baseTemplRelPath {
+					t.Errorf("expected conflict path %q, got %q", baseTemplRelPath, c.Path)
+				}
 			}
 		})
 	}
@@ -94,7 +94,14 @@ func (c *UIListCommand) runE(cmd *cobra.Command, args []string) error {
 
 	headers := []string{"NAME", "CATEGORY", "INSTALLED"}
 	rows := make([][]string, len(components))
+	result := UIListResult{Ref: ref, Components: make([]UIComponent, 0, len(components))}
 	for i, comp := range components {
+		result.Components = append(result.Components, UIComponent{
+			Name:      comp.Name,
+			Category:  comp.Category,
+			Installed: comp.Installed,
+		})
+
 		installed := "-"
 		if comp.Installed {
 			installed = "✓"
@@ -110,6 +117,7 @@ func (c *UIListCommand) runE(cmd *cobra.Command, args []string) error {
 		Headers: headers,
 		Rows:    rows,
 	})
+	r.Result(result)
 
 	return nil
 }
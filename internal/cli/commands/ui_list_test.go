@@ -18,6 +18,7 @@ func setupUIListTestCommand(t *testing.T) (*cobra.Command, *mocks.MockUIExecutor
 	mockExecutor := mocks.NewMockUIExecutor(t)
 	mockDetector := mocks.NewMockProjectDetector(t)
 	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Result", mock.Anything).Return().Maybe()
 
 	factory := func(*cobra.Command) interfaces.Renderer {
 		return mockRenderer
@@ -62,6 +63,7 @@ func TestUIListCommand_Run_NotInProject(t *testing.T) {
 	mockExecutor := mocks.NewMockUIExecutor(t)
 	mockDetector := mocks.NewMockProjectDetector(t)
 	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Result", mock.Anything).Return().Maybe()
 
 	mockDetector.On("Detect", mock.Anything, ".").
 		Return(nil, "", nil).Once()
@@ -92,6 +94,7 @@ func TestUIListCommand_Run_Success(t *testing.T) {
 	mockExecutor := mocks.NewMockUIExecutor(t)
 	mockDetector := mocks.NewMockProjectDetector(t)
 	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Result", mock.Anything).Return().Maybe()
 
 	project := &interfaces.TracksProject{Name: "testapp"}
 	mockDetector.On("Detect", mock.Anything, ".").
@@ -132,6 +135,7 @@ func TestUIListCommand_Run_ExecutorError(t *testing.T) {
 	mockExecutor := mocks.NewMockUIExecutor(t)
 	mockDetector := mocks.NewMockProjectDetector(t)
 	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Result", mock.Anything).Return().Maybe()
 
 	project := &interfaces.TracksProject{Name: "testapp"}
 	mockDetector.On("Detect", mock.Anything, ".").
@@ -166,6 +170,7 @@ func TestUIListCommand_TableContent(t *testing.T) {
 	mockExecutor := mocks.NewMockUIExecutor(t)
 	mockDetector := mocks.NewMockProjectDetector(t)
 	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Result", mock.Anything).Return().Maybe()
 
 	project := &interfaces.TracksProject{Name: "testapp"}
 	mockDetector.On("Detect", mock.Anything, ".").
@@ -16,6 +16,7 @@ func setupUITestCommand(t *testing.T) (*cobra.Command, *mocks.MockUIExecutor, *m
 	mockExecutor := mocks.NewMockUIExecutor(t)
 	mockDetector := mocks.NewMockProjectDetector(t)
 	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Result", mock.Anything).Return().Maybe()
 	mockRenderer.On("Title", mock.Anything).Return().Maybe()
 	mockRenderer.On("Section", mock.Anything).Return().Maybe()
 	mockRenderer.On("Flush").Return(nil).Maybe()
@@ -39,6 +40,7 @@ func TestUICommand_Command(t *testing.T) {
 	mockExecutor := mocks.NewMockUIExecutor(t)
 	mockDetector := mocks.NewMockProjectDetector(t)
 	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Result", mock.Anything).Return().Maybe()
 
 	rendererFactory := func(*cobra.Command) interfaces.Renderer {
 		return mockRenderer
@@ -85,6 +87,7 @@ func TestUICommand_Run_ShowVersion(t *testing.T) {
 	mockExecutor := mocks.NewMockUIExecutor(t)
 	mockDetector := mocks.NewMockProjectDetector(t)
 	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Result", mock.Anything).Return().Maybe()
 
 	mockExecutor.On("Version", mock.Anything, ".").Return("v0.1.0", nil).Once()
 	mockRenderer.On("Title", "templUI v0.1.0").Once()
@@ -119,6 +122,7 @@ func TestUICommand_Run_VersionError(t *testing.T) {
 	mockExecutor := mocks.NewMockUIExecutor(t)
 	mockDetector := mocks.NewMockProjectDetector(t)
 	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Result", mock.Anything).Return().Maybe()
 
 	mockExecutor.On("Version", mock.Anything, ".").Return("", errors.New("templui not found")).Once()
 	mockRenderer.On("Section", mock.MatchedBy(func(s interfaces.Section) bool {
@@ -180,6 +184,7 @@ func TestUICommand_RendererFactoryCalledWithCommand(t *testing.T) {
 	mockExecutor := mocks.NewMockUIExecutor(t)
 	mockDetector := mocks.NewMockProjectDetector(t)
 	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Result", mock.Anything).Return().Maybe()
 
 	mockExecutor.On("Version", mock.Anything, ".").Return("v0.1.0", nil).Once()
 	mockRenderer.On("Title", mock.Anything).Once()
@@ -210,6 +215,7 @@ func TestUICommand_FlusherCalledWithCommandAndRenderer(t *testing.T) {
 	mockExecutor := mocks.NewMockUIExecutor(t)
 	mockDetector := mocks.NewMockProjectDetector(t)
 	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Result", mock.Anything).Return().Maybe()
 
 	mockExecutor.On("Version", mock.Anything, ".").Return("v0.1.0", nil).Once()
 	mockRenderer.On("Title", mock.Anything).Once()
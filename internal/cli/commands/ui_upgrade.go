@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	trackscontext "github.com/anomalousventures/tracks/internal/context"
 	"github.com/spf13/cobra"
 )
 
@@ -52,7 +53,7 @@ Use the @ref syntax to upgrade to a specific version.`,
 
 func (c *UIUpgradeCommand) runE(cmd *cobra.Command, args []string) error {
 	r := c.newRenderer(cmd)
-	ctx := cmd.Context()
+	ctx := trackscontext.WithOutput(cmd.Context(), r.Stream)
 	defer c.flushRenderer(cmd, r)
 
 	project, projectDir, err := c.detector.Detect(ctx, ".")
@@ -85,6 +86,7 @@ func (c *UIUpgradeCommand) runE(cmd *cobra.Command, args []string) error {
 		r.Section(interfaces.Section{
 			Body: "Upgrade completed successfully",
 		})
+		r.Result(UIUpgradeResult{Ref: ref})
 		return nil
 	}
 
@@ -96,6 +98,7 @@ func (c *UIUpgradeCommand) runE(cmd *cobra.Command, args []string) error {
 	r.Section(interfaces.Section{
 		Body: fmt.Sprintf("Successfully upgraded templUI to %s (version: %s)", targetMsg, version),
 	})
+	r.Result(UIUpgradeResult{Ref: ref, Version: version})
 
 	return nil
 }
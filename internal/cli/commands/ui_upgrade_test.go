@@ -16,6 +16,7 @@ func setupUIUpgradeTestCommand(t *testing.T) (*cobra.Command, *mocks.MockUIExecu
 	mockExecutor := mocks.NewMockUIExecutor(t)
 	mockDetector := mocks.NewMockProjectDetector(t)
 	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Result", mock.Anything).Return().Maybe()
 
 	factory := func(*cobra.Command) interfaces.Renderer {
 		return mockRenderer
@@ -60,6 +61,7 @@ func TestUIUpgradeCommand_Run_NotInProject(t *testing.T) {
 	mockExecutor := mocks.NewMockUIExecutor(t)
 	mockDetector := mocks.NewMockProjectDetector(t)
 	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Result", mock.Anything).Return().Maybe()
 
 	mockDetector.On("Detect", mock.Anything, ".").
 		Return(nil, "", nil).Once()
@@ -90,6 +92,7 @@ func TestUIUpgradeCommand_Run_Success(t *testing.T) {
 	mockExecutor := mocks.NewMockUIExecutor(t)
 	mockDetector := mocks.NewMockProjectDetector(t)
 	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Result", mock.Anything).Return().Maybe()
 
 	project := &interfaces.TracksProject{Name: "testapp"}
 	mockDetector.On("Detect", mock.Anything, ".").
@@ -130,6 +133,7 @@ func TestUIUpgradeCommand_Run_ExecutorError(t *testing.T) {
 	mockExecutor := mocks.NewMockUIExecutor(t)
 	mockDetector := mocks.NewMockProjectDetector(t)
 	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Result", mock.Anything).Return().Maybe()
 
 	project := &interfaces.TracksProject{Name: "testapp"}
 	mockDetector.On("Detect", mock.Anything, ".").
@@ -165,6 +169,7 @@ func TestUIUpgradeCommand_Run_VersionError(t *testing.T) {
 	mockExecutor := mocks.NewMockUIExecutor(t)
 	mockDetector := mocks.NewMockProjectDetector(t)
 	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Result", mock.Anything).Return().Maybe()
 
 	project := &interfaces.TracksProject{Name: "testapp"}
 	mockDetector.On("Detect", mock.Anything, ".").
@@ -201,6 +206,7 @@ func TestUIUpgradeCommand_Run_DetectorError(t *testing.T) {
 	mockExecutor := mocks.NewMockUIExecutor(t)
 	mockDetector := mocks.NewMockProjectDetector(t)
 	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Result", mock.Anything).Return().Maybe()
 
 	mockDetector.On("Detect", mock.Anything, ".").
 		Return(nil, "", errors.New("failed to detect project")).Once()
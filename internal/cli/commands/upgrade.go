@@ -0,0 +1,179 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	"github.com/anomalousventures/tracks/internal/generator"
+	"github.com/spf13/cobra"
+)
+
+// UpgradeCommand represents the 'upgrade' command.
+type UpgradeCommand struct {
+	detector      interfaces.ProjectDetector
+	upgrader      interfaces.ProjectUpgrader
+	build         interfaces.BuildInfo
+	newRenderer   RendererFactory
+	flushRenderer RendererFlusher
+}
+
+// NewUpgradeCommand creates a new instance of the 'upgrade' command with injected dependencies.
+func NewUpgradeCommand(
+	detector interfaces.ProjectDetector,
+	upgrader interfaces.ProjectUpgrader,
+	build interfaces.BuildInfo,
+	newRenderer RendererFactory,
+	flushRenderer RendererFlusher,
+) *UpgradeCommand {
+	return &UpgradeCommand{
+		detector:      detector,
+		upgrader:      upgrader,
+		build:         build,
+		newRenderer:   newRenderer,
+		flushRenderer: flushRenderer,
+	}
+}
+
+// Command returns the cobra.Command for the 'upgrade' command.
+func (c *UpgradeCommand) Command() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade",
+		Short: "Upgrade a project to the templates of this Tracks version",
+		Long: `Re-render the project templates embedded in this Tracks version and merge
+them into your project.
+
+Each file is merged three ways: the version Tracks last generated (kept in
+.tracks/base), your current file, and the new template. Changes on only one
+side are applied automatically. Where both sides changed the same lines the
+file gets git-style conflict markers to resolve by hand.
+
+Projects generated before .tracks/base existed are merged two ways: lines
+only present on one side are kept, and lines that differ between your file
+and the new template are reported as conflicts on the first upgrade.
+
+In modules adopted with 'tracks init', only the files recorded in
+.tracks/manifest.json are upgraded.
+
+Files you deleted stay deleted. .env and .tracks.yaml are never rewritten,
+apart from recording last_upgraded_version.`,
+		Example: `  # Preview the upgrade
+  tracks upgrade --dry-run
+
+  # Upgrade the project
+  tracks upgrade`,
+		Args: cobra.NoArgs,
+		RunE: c.runE,
+	}
+
+	cmd.Flags().Bool("dry-run", false, "Show what would change without writing files")
+
+	return cmd
+}
+
+func (c *UpgradeCommand) runE(cmd *cobra.Command, _ []string) error {
+	ctx := cmd.Context()
+
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+	project, projectDir, err := c.detector.Detect(ctx, ".")
+	if err != nil {
+		return errNotInProject(err)
+	}
+	if project == nil {
+		return errNotInProject(nil)
+	}
+
+	version := c.build.GetVersion()
+	cfg := generator.UpgradeConfig{
+		ProjectDir:     projectDir,
+		ProjectName:    project.Name,
+		ModulePath:     project.ModulePath,
+		DatabaseDriver: project.DBDriver,
+		EnvPrefix:      project.EnvPrefix,
+		GoVersion:      project.GoVersion,
+		Features:       project.Features,
+		Version:        version,
+		DryRun:         dryRun,
+	}
+
+	files, err := c.upgrader.Upgrade(ctx, cfg)
+	if err != nil {
+		return withCode(CodeGenerationFailed, fmt.Errorf("failed to upgrade project: %w", err))
+	}
+
+	r := c.newRenderer(cmd)
+	defer c.flushRenderer(cmd, r)
+
+	title := fmt.Sprintf("Upgraded project to Tracks %s", version)
+	if dryRun {
+		title = fmt.Sprintf("Upgrade to Tracks %s (dry run)", version)
+	}
+	r.Title(title)
+
+	changed, upToDate, conflicts := summarizeUpgrade(files)
+	r.Result(UpgradeResult{
+		Version:   version,
+		DryRun:    dryRun,
+		Files:     generatedFiles(changed),
+		UpToDate:  upToDate,
+		Conflicts: conflicts,
+	})
+
+	if len(changed) == 0 {
+		r.Section(interfaces.Section{Body: fmt.Sprintf("All %d generated files are up to date.", upToDate)})
+		return nil
+	}
+
+	r.Table(generatedFilesTable(changed))
+	if upToDate > 0 {
+		r.Section(interfaces.Section{Body: fmt.Sprintf("%d file(s) already up to date.", upToDate)})
+	}
+
+	if dryRun {
+		return nil
+	}
+
+	r.Section(interfaces.Section{
+		Title: "Next steps",
+		Body:  upgradeNextSteps(conflicts),
+	})
+
+	if conflicts > 0 {
+		return withCode(CodeConflict, fmt.Errorf("%d file(s) have conflicts", conflicts))
+	}
+
+	return nil
+}
+
+// summarizeUpgrade drops up-to-date files from the results, counting them
+// and the files left with conflicts.
+func summarizeUpgrade(files []interfaces.GeneratedFile) ([]interfaces.GeneratedFile, int, int) {
+	var changed []interfaces.GeneratedFile
+	var upToDate, conflicts int
+	for _, f := range files {
+		if f.Detail == generator.DetailUpToDate {
+			upToDate++
+			continue
+		}
+		if f.Action == interfaces.FileActionConflict {
+			conflicts++
+		}
+		changed = append(changed, f)
+	}
+	return changed, upToDate, conflicts
+}
+
+func upgradeNextSteps(conflicts int) string {
+	var steps []string
+	if conflicts > 0 {
+		steps = append(steps, "Resolve the conflict markers (<<<<<<< yours ... >>>>>>>) in the files above")
+	}
+	steps = append(steps, "go mod tidy", "make generate", "make test")
+
+	lines := make([]string, len(steps))
+	for i, step := range steps {
+		lines[i] = fmt.Sprintf("  %d. %s", i+1, step)
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,187 @@
+package commands
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	"github.com/anomalousventures/tracks/internal/generator"
+	"github.com/anomalousventures/tracks/tests/mocks"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/mock"
+)
+
+func setupUpgradeTestCommand(t *testing.T) (*cobra.Command, *mocks.MockProjectDetector, *mocks.MockProjectUpgrader, *mocks.MockRenderer) {
+	mockDetector := mocks.NewMockProjectDetector(t)
+	mockUpgrader := mocks.NewMockProjectUpgrader(t)
+	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Result", mock.Anything).Return().Maybe()
+	mockBuild := mocks.NewMockBuildInfo(t)
+	mockBuild.On("GetVersion").Return("v1.2.0").Maybe()
+
+	factory := func(*cobra.Command) interfaces.Renderer {
+		return mockRenderer
+	}
+	flusher := func(*cobra.Command, interfaces.Renderer) {
+		mockRenderer.Flush()
+	}
+
+	cmd := NewUpgradeCommand(mockDetector, mockUpgrader, mockBuild, factory, flusher)
+	cobraCmd := cmd.Command()
+	cobraCmd.SetOut(new(bytes.Buffer))
+	cobraCmd.SetErr(new(bytes.Buffer))
+
+	return cobraCmd, mockDetector, mockUpgrader, mockRenderer
+}
+
+var upgradeTestProject = &interfaces.TracksProject{
+	Name:       "testapp",
+	ModulePath: "github.com/example/testapp",
+	DBDriver:   "go-libsql",
+	EnvPrefix:  "APP",
+}
+
+func TestUpgradeCommand_Command(t *testing.T) {
+	cobraCmd, _, _, _ := setupUpgradeTestCommand(t)
+
+	if cobraCmd.Use != "upgrade" {
+		t.Errorf("expected Use to be 'upgrade', got %q", cobraCmd.Use)
+	}
+	if cobraCmd.Short == "" || cobraCmd.Long == "" || cobraCmd.Example == "" {
+		t.Error("expected Short, Long and Example to be set")
+	}
+	if cobraCmd.Flags().Lookup("dry-run") == nil {
+		t.Error("expected --dry-run flag")
+	}
+}
+
+func TestUpgradeCommand_NotInProject(t *testing.T) {
+	cobraCmd, mockDetector, _, _ := setupUpgradeTestCommand(t)
+
+	mockDetector.On("Detect", mock.Anything, ".").
+		Return(nil, "", errors.New("no .tracks.yaml found")).Once()
+
+	cobraCmd.SetArgs([]string{})
+	err := cobraCmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "not in a Tracks project") {
+		t.Fatalf("expected project error, got %v", err)
+	}
+}
+
+func TestUpgradeCommand_Success(t *testing.T) {
+	cobraCmd, mockDetector, mockUpgrader, mockRenderer := setupUpgradeTestCommand(t)
+
+	mockDetector.On("Detect", mock.Anything, ".").Return(upgradeTestProject, "/tmp/testapp", nil).Once()
+
+	expectedCfg := generator.UpgradeConfig{
+		ProjectDir:     "/tmp/testapp",
+		ProjectName:    "testapp",
+		ModulePath:     "github.com/example/testapp",
+		DatabaseDriver: "go-libsql",
+		EnvPrefix:      "APP",
+		Version:        "v1.2.0",
+	}
+	files := []interfaces.GeneratedFile{
+		{Path: "Makefile", Action: interfaces.FileActionUpdate, Detail: generator.DetailMerged},
+		{Path: "go.mod", Action: interfaces.FileActionSkip, Detail: generator.DetailUpToDate},
+		{Path: "internal/http/server.go", Action: interfaces.FileActionSkip, Detail: generator.DetailUpToDate},
+	}
+	mockUpgrader.On("Upgrade", mock.Anything, expectedCfg).Return(files, nil).Once()
+
+	mockRenderer.On("Title", "Upgraded project to Tracks v1.2.0").Once()
+	mockRenderer.On("Table", mock.MatchedBy(func(tbl interfaces.Table) bool {
+		return len(tbl.Rows) == 1 && tbl.Rows[0][0] == "Makefile"
+	})).Once()
+	mockRenderer.On("Section", interfaces.Section{Body: "2 file(s) already up to date."}).Once()
+	mockRenderer.On("Section", mock.MatchedBy(func(s interfaces.Section) bool {
+		return s.Title == "Next steps" && strings.Contains(s.Body, "make test") && !strings.Contains(s.Body, "conflict")
+	})).Once()
+	mockRenderer.On("Flush").Return(nil).Once()
+
+	cobraCmd.SetArgs([]string{})
+	if err := cobraCmd.Execute(); err != nil {
+		t.Fatalf("execution failed: %v", err)
+	}
+}
+
+func TestUpgradeCommand_UpToDate(t *testing.T) {
+	cobraCmd, mockDetector, mockUpgrader, mockRenderer := setupUpgradeTestCommand(t)
+
+	mockDetector.On("Detect", mock.Anything, ".").Return(upgradeTestProject, "/tmp/testapp", nil).Once()
+	files := []interfaces.GeneratedFile{
+		{Path: "go.mod", Action: interfaces.FileActionSkip, Detail: generator.DetailUpToDate},
+	}
+	mockUpgrader.On("Upgrade", mock.Anything, mock.Anything).Return(files, nil).Once()
+
+	mockRenderer.On("Title", "Upgraded project to Tracks v1.2.0").Once()
+	mockRenderer.On("Section", interfaces.Section{Body: "All 1 generated files are up to date."}).Once()
+	mockRenderer.On("Flush").Return(nil).Once()
+
+	cobraCmd.SetArgs([]string{})
+	if err := cobraCmd.Execute(); err != nil {
+		t.Fatalf("execution failed: %v", err)
+	}
+}
+
+func TestUpgradeCommand_DryRun(t *testing.T) {
+	cobraCmd, mockDetector, mockUpgrader, mockRenderer := setupUpgradeTestCommand(t)
+
+	mockDetector.On("Detect", mock.Anything, ".").Return(upgradeTestProject, "/tmp/testapp", nil).Once()
+	files := []interfaces.GeneratedFile{
+		{Path: "Makefile", Action: interfaces.FileActionConflict, Detail: "1 conflict(s)"},
+	}
+	mockUpgrader.On("Upgrade", mock.Anything, mock.MatchedBy(func(cfg generator.UpgradeConfig) bool {
+		return cfg.DryRun
+	})).Return(files, nil).Once()
+
+	mockRenderer.On("Title", "Upgrade to Tracks v1.2.0 (dry run)").Once()
+	mockRenderer.On("Table", mock.Anything).Once()
+	mockRenderer.On("Flush").Return(nil).Once()
+
+	cobraCmd.SetArgs([]string{"--dry-run"})
+	if err := cobraCmd.Execute(); err != nil {
+		t.Fatalf("dry run should not fail on conflicts: %v", err)
+	}
+}
+
+func TestUpgradeCommand_Conflicts(t *testing.T) {
+	cobraCmd, mockDetector, mockUpgrader, mockRenderer := setupUpgradeTestCommand(t)
+
+	mockDetector.On("Detect", mock.Anything, ".").Return(upgradeTestProject, "/tmp/testapp", nil).Once()
+	files := []interfaces.GeneratedFile{
+		{Path: "Makefile", Action: interfaces.FileActionConflict, Detail: "2 conflict(s)"},
+		{Path: "README.md", Action: interfaces.FileActionUpdate, Detail: generator.DetailUpdated},
+	}
+	mockUpgrader.On("Upgrade", mock.Anything, mock.Anything).Return(files, nil).Once()
+
+	mockRenderer.On("Title", mock.Anything).Once()
+	mockRenderer.On("Table", mock.MatchedBy(func(tbl interfaces.Table) bool {
+		return len(tbl.Rows) == 2
+	})).Once()
+	mockRenderer.On("Section", mock.MatchedBy(func(s interfaces.Section) bool {
+		return strings.HasPrefix(s.Body, "  1. Resolve the conflict markers")
+	})).Once()
+	mockRenderer.On("Flush").Return(nil).Once()
+
+	cobraCmd.SetArgs([]string{})
+	err := cobraCmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "1 file(s) have conflicts") {
+		t.Fatalf("expected conflict error, got %v", err)
+	}
+}
+
+func TestUpgradeCommand_UpgraderError(t *testing.T) {
+	cobraCmd, mockDetector, mockUpgrader, _ := setupUpgradeTestCommand(t)
+
+	mockDetector.On("Detect", mock.Anything, ".").Return(upgradeTestProject, "/tmp/testapp", nil).Once()
+	mockUpgrader.On("Upgrade", mock.Anything, mock.Anything).
+		Return(nil, errors.New(".tracks.yaml has no last_upgraded_version entry")).Once()
+
+	cobraCmd.SetArgs([]string{})
+	err := cobraCmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "failed to upgrade project") {
+		t.Fatalf("expected upgrade error, got %v", err)
+	}
+}
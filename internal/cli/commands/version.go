@@ -40,6 +40,11 @@ func (c *VersionCommand) run(cmd *cobra.Command, args []string) {
 	r.Section(interfaces.Section{
 		Body: fmt.Sprintf("Commit: %s\nBuilt: %s", c.build.GetCommit(), c.build.GetDate()),
 	})
+	r.Result(VersionResult{
+		Version: c.build.GetVersion(),
+		Commit:  c.build.GetCommit(),
+		Date:    c.build.GetDate(),
+	})
 
 	c.flushRenderer(cmd, r)
 }
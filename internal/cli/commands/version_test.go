@@ -18,6 +18,7 @@ func setupVersionTestCommand(t *testing.T, version, commit, date string) *cobra.
 	mockBuild.On("GetCommit").Return(commit).Maybe()
 	mockBuild.On("GetDate").Return(date).Maybe()
 	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Result", mock.Anything).Return().Maybe()
 	mockRenderer.On("Title", mock.Anything).Return().Maybe()
 	mockRenderer.On("Section", mock.Anything).Return().Maybe()
 	mockRenderer.On("Flush").Return(nil).Maybe()
@@ -43,6 +44,7 @@ func setupVersionTestCommandWithMock(t *testing.T, version, commit, date string)
 	mockBuild.On("GetDate").Return(date).Maybe()
 
 	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Result", mock.Anything).Return().Maybe()
 
 	factory := func(*cobra.Command) interfaces.Renderer {
 		return mockRenderer
@@ -62,6 +64,7 @@ func TestNewVersionCommand(t *testing.T) {
 	mockBuild.On("GetDate").Return("2025-10-29")
 
 	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Result", mock.Anything).Return().Maybe()
 	rendererFactory := func(*cobra.Command) interfaces.Renderer {
 		return mockRenderer
 	}
@@ -96,6 +99,7 @@ func TestVersionCommand_Command(t *testing.T) {
 	mockBuild := mocks.NewMockBuildInfo(t)
 
 	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Result", mock.Anything).Return().Maybe()
 	rendererFactory := func(*cobra.Command) interfaces.Renderer {
 		return mockRenderer
 	}
@@ -142,6 +146,7 @@ func TestVersionCommand_Run(t *testing.T) {
 	mockBuild.On("GetDate").Return("2025-10-29")
 
 	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Result", mock.Anything).Return().Maybe()
 	mockRenderer.On("Title", "Tracks v1.0.0").Once()
 	mockRenderer.On("Section", interfaces.Section{Body: "Commit: abc123\nBuilt: 2025-10-29"}).Once()
 
@@ -236,6 +241,7 @@ func TestVersionCommand_RendererFactoryCalledWithCommand(t *testing.T) {
 	mockBuild.On("GetDate").Return("2025-10-29")
 
 	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Result", mock.Anything).Return().Maybe()
 	mockRenderer.On("Title", mock.Anything).Once()
 	mockRenderer.On("Section", mock.Anything).Once()
 
@@ -268,6 +274,7 @@ func TestVersionCommand_FlusherCalledWithCommandAndRenderer(t *testing.T) {
 	mockBuild.On("GetDate").Return("2025-10-29")
 
 	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Result", mock.Anything).Return().Maybe()
 	mockRenderer.On("Title", mock.Anything).Once()
 	mockRenderer.On("Section", mock.Anything).Once()
 
@@ -327,3 +334,26 @@ func TestVersionCommand_CommandDescriptions(t *testing.T) {
 		t.Error("Short description should mention 'version'")
 	}
 }
+
+func TestVersionCommand_Result(t *testing.T) {
+	mockBuild := mocks.NewMockBuildInfo(t)
+	mockBuild.On("GetVersion").Return("v1.2.3")
+	mockBuild.On("GetCommit").Return("abc123")
+	mockBuild.On("GetDate").Return("2025-01-01")
+
+	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Title", mock.Anything).Return().Once()
+	mockRenderer.On("Section", mock.Anything).Return().Once()
+	mockRenderer.On("Result", VersionResult{Version: "v1.2.3", Commit: "abc123", Date: "2025-01-01"}).Return().Once()
+
+	factory := func(*cobra.Command) interfaces.Renderer {
+		return mockRenderer
+	}
+	flusher := func(*cobra.Command, interfaces.Renderer) {}
+
+	cobraCmd := NewVersionCommand(mockBuild, factory, flusher).Command()
+	cobraCmd.SetArgs([]string{})
+	if err := cobraCmd.Execute(); err != nil {
+		t.Fatalf("execution failed: %v", err)
+	}
+}
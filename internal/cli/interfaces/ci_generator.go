@@ -0,0 +1,19 @@
+package interfaces
+
+import "context"
+
+// CIGenerator writes a CI pipeline, and the make target it calls, into an
+// existing Tracks project.
+//
+// Interface defined by consumer per ADR-002 to avoid import cycles. The config
+// parameter uses 'any'; the implementation expects generator.CIConfig.
+type CIGenerator interface {
+	// Generate writes the pipeline, updates the Makefile and .tracks.yaml,
+	// and returns the files it touched. An existing pipeline that differs
+	// from the template is kept, reported with FileActionSkip, unless the
+	// config forces it to be replaced.
+	Generate(ctx context.Context, cfg any) ([]GeneratedFile, error)
+
+	// Validate checks the configuration, including the CI provider.
+	Validate(cfg any) error
+}
@@ -0,0 +1,41 @@
+package interfaces
+
+import "context"
+
+// CommandRunner runs the external programs Tracks depends on (go, make, npm,
+// git, templUI).
+//
+// Interface defined by consumer per ADR-002 to avoid import cycles.
+// Context parameter enables request-scoped logger and output access per
+// ADR-003.
+type CommandRunner interface {
+	// Run runs cmd and waits for it to finish. The result holds the captured
+	// output even when the command fails.
+	Run(ctx context.Context, cmd Command) (CommandResult, error)
+}
+
+// Command is an external command to run.
+type Command struct {
+	// Name is the program to run, looked up in PATH.
+	Name string
+
+	// Args are the program's arguments.
+	Args []string
+
+	// Dir is the working directory. Empty means the current directory.
+	Dir string
+
+	// Step prefixes each streamed line, e.g. "go mod tidy". Output of a
+	// command without a step is captured but not streamed.
+	Step string
+}
+
+// CommandResult is the captured output of a command.
+type CommandResult struct {
+	// Stdout is the standard output only.
+	Stdout []byte
+
+	// Output is standard output and standard error, interleaved in the
+	// order the lines were written.
+	Output []byte
+}
@@ -12,6 +12,11 @@ import (
 type DatabaseManager interface {
 	LoadEnv(ctx context.Context, projectDir string) error
 	GetDatabaseURL() string
+
+	// GetDatabaseURLSource reports where the URL came from: "environment",
+	// ".env", "default", or empty when no URL was found.
+	GetDatabaseURLSource() string
+
 	GetDriver() string
 
 	// Must call LoadEnv first.
@@ -17,7 +17,7 @@ import "context"
 //
 // Example usage:
 //
-//	gen := generator.NewProjectGenerator()
+//	gen := generator.NewProjectGenerator(process.NewRunner())
 //	cfg := generator.ProjectConfig{
 //	    Name: "myapp",
 //	    ModulePath: "github.com/user/myapp",
@@ -28,4 +28,13 @@ import "context"
 type ProjectGenerator interface {
 	Generate(ctx context.Context, cfg any) error
 	Validate(cfg any) error
+
+	// Plan renders every template in memory and returns the files and
+	// steps Generate would produce for cfg, without touching disk.
+	Plan(ctx context.Context, cfg any) (*ProjectPlan, error)
+
+	// LoadStarter reads the manifest of the starter kit at source, a
+	// directory or a .zip, .tar.gz or .tgz archive, so its variables can be
+	// collected before generation.
+	LoadStarter(ctx context.Context, source string) (*StarterKit, error)
 }
@@ -61,3 +61,11 @@ func (m *mockGenerator) Generate(ctx context.Context, cfg any) error {
 func (m *mockGenerator) Validate(cfg any) error {
 	return nil
 }
+
+func (m *mockGenerator) Plan(ctx context.Context, cfg any) (*interfaces.ProjectPlan, error) {
+	return &interfaces.ProjectPlan{}, nil
+}
+
+func (m *mockGenerator) LoadStarter(ctx context.Context, source string) (*interfaces.StarterKit, error) {
+	return &interfaces.StarterKit{}, nil
+}
@@ -0,0 +1,17 @@
+package interfaces
+
+import "context"
+
+// MigrationGenerator writes timestamped goose migrations into an existing
+// Tracks project.
+//
+// Interface defined by consumer per ADR-002 to avoid import cycles. The config
+// parameter uses 'any'; the implementation expects generator.MigrationConfig.
+type MigrationGenerator interface {
+	// Generate writes the migration file and returns it.
+	Generate(ctx context.Context, cfg any) ([]GeneratedFile, error)
+
+	// Validate checks the configuration, including whether a migration with
+	// the same name already exists.
+	Validate(cfg any) error
+}
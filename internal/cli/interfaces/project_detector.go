@@ -22,4 +22,13 @@ type TracksProject struct {
 	Name       string
 	ModulePath string
 	DBDriver   string
+	EnvPrefix  string
+
+	// GoVersion is the go directive the project was generated with. It is
+	// empty for projects that predate it.
+	GoVersion string
+
+	// Features are the project's optional parts. They are the zero value,
+	// meaning every feature, when .tracks.yaml has no features block.
+	Features ProjectFeatures
 }
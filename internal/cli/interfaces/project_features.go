@@ -0,0 +1,26 @@
+package interfaces
+
+// ProjectFeatures are the optional parts of a Tracks project, chosen with
+// the profile and feature flags of 'tracks new' and recorded in
+// .tracks.yaml.
+//
+// The zero value, with an empty CI, stands for every feature: the projects
+// generated before features could be turned off.
+type ProjectFeatures struct {
+	// UI installs templUI components. Without it, pages use plain HTML.
+	UI bool `json:"ui"`
+
+	// Node builds CSS and JavaScript with Tailwind and esbuild through npm.
+	// Without it, the files in internal/assets/dist are served as written.
+	Node bool `json:"node"`
+
+	// Docker adds a Dockerfile, .dockerignore and docker-compose.yml.
+	Docker bool `json:"docker"`
+
+	// CI is the provider whose pipeline is generated: github, gitlab or
+	// none.
+	CI string `json:"ci"`
+
+	// Examples adds the HTMX counter demo to the home page.
+	Examples bool `json:"examples"`
+}
@@ -0,0 +1,46 @@
+package interfaces
+
+import "context"
+
+// ProjectInitializer adopts an existing Go module as a Tracks project.
+//
+// Interface defined by consumer per ADR-002 to avoid import cycles. The config
+// parameter uses 'any'; the implementation expects generator.InitConfig.
+// Context parameter enables request-scoped logger access per ADR-003.
+type ProjectInitializer interface {
+	// Inspect finds the Go module containing dir and reads what Tracks
+	// needs to know about it.
+	Inspect(ctx context.Context, dir string) (*ModuleInfo, error)
+
+	// Plan reports the files and directories Init would write, without
+	// writing them. Existing files it would change are reported with
+	// FileActionUpdate.
+	Plan(ctx context.Context, cfg any) ([]GeneratedFile, error)
+
+	// Init writes .tracks.yaml and, if the config asks for them, the
+	// missing Makefile targets and directories. Existing files listed in
+	// the config as kept are left untouched and reported with
+	// FileActionSkip.
+	Init(ctx context.Context, cfg any) ([]GeneratedFile, error)
+}
+
+// ModuleInfo describes an existing Go module.
+type ModuleInfo struct {
+	// Dir is the directory containing go.mod.
+	Dir string
+
+	// ModulePath is the module path from go.mod.
+	ModulePath string
+
+	// DBDriver is the database driver the module's imports use: go-libsql,
+	// sqlite3 or postgres. It is empty if none or several are imported.
+	DBDriver string
+
+	// Drivers lists every database driver the module's imports use,
+	// sorted.
+	Drivers []string
+
+	// Features are the optional parts of a Tracks project the module
+	// already has, e.g. Docker when it has a Dockerfile.
+	Features ProjectFeatures
+}
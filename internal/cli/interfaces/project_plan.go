@@ -0,0 +1,30 @@
+package interfaces
+
+// ProjectPlan describes what generating a project would produce.
+type ProjectPlan struct {
+	// Files are the rendered project files, sorted by path.
+	Files []PlannedFile `json:"files"`
+
+	// Steps are the external commands run after rendering, in dependency
+	// order. Steps without a dependency on each other run concurrently.
+	Steps []PlannedStep `json:"steps"`
+}
+
+// PlannedFile is one file in a ProjectPlan.
+type PlannedFile struct {
+	// Path is relative to the project root, using forward slashes.
+	Path string `json:"path"`
+
+	// Template is the name of the template the file is rendered from.
+	Template string `json:"template"`
+
+	// Size is the rendered size in bytes.
+	Size int `json:"size"`
+}
+
+// PlannedStep is one external step in a ProjectPlan.
+type PlannedStep struct {
+	Name      string   `json:"name"`
+	DependsOn []string `json:"depends_on,omitempty"`
+	Optional  bool     `json:"optional"`
+}
@@ -0,0 +1,16 @@
+package interfaces
+
+import "context"
+
+// ProjectUpgrader brings an existing Tracks project up to date with the
+// templates embedded in the running CLI.
+//
+// Interface defined by consumer per ADR-002 to avoid import cycles. The config
+// parameter uses 'any'; the implementation expects generator.UpgradeConfig.
+type ProjectUpgrader interface {
+	// Upgrade merges template changes into the project's generated files and
+	// returns what happened to each. Files with conflicts are reported with
+	// FileActionConflict; the error return is reserved for failures that
+	// stop the upgrade.
+	Upgrade(ctx context.Context, cfg any) ([]GeneratedFile, error)
+}
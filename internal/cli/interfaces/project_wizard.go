@@ -0,0 +1,66 @@
+package interfaces
+
+import "context"
+
+// ProjectWizard walks the user through the options of a new project, then
+// shows its generation as it runs.
+//
+// Interface defined by consumer per ADR-002 to avoid import cycles.
+// Context parameter enables cancellation and request-scoped logger access
+// per ADR-003.
+type ProjectWizard interface {
+	// Run asks for each option, starting from the values in opts, and shows
+	// a summary. Once the user confirms, Run calls generate with the chosen
+	// options and a Renderer that draws into the wizard, and returns the
+	// chosen options and generate's error. Run returns ErrPromptCanceled
+	// if the user quits before confirming.
+	Run(ctx context.Context, opts ProjectOptions, generate GenerateFunc) (ProjectOptions, error)
+}
+
+// GenerateFunc generates the project described by opts, reporting progress
+// to r.
+type GenerateFunc func(ctx context.Context, opts ProjectOptions, r Renderer) error
+
+// ProjectOptions are the choices 'tracks new' makes about a project.
+type ProjectOptions struct {
+	// Name is the project name, also its directory.
+	Name string
+
+	// ModulePath is the Go module path. Empty means example.com/<Name>.
+	ModulePath string
+
+	// DatabaseDriver is go-libsql, sqlite3 or postgres.
+	DatabaseDriver string
+
+	// EnvPrefix prefixes the project's environment variables.
+	EnvPrefix string
+
+	// InitGit initializes a git repository.
+	InitGit bool
+
+	// OutputPath is the directory the project directory is created in.
+	OutputPath string
+
+	// GoVersion is the go directive of the generated go.mod.
+	GoVersion string
+
+	// Profile is the feature profile: full, api or minimal. Empty means
+	// full.
+	Profile string
+
+	// NoUI, NoNode, NoDocker and NoExamples turn features of the profile
+	// off.
+	NoUI       bool
+	NoNode     bool
+	NoDocker   bool
+	NoExamples bool
+
+	// CI replaces the profile's CI provider: github, gitlab or none.
+	CI string
+
+	// Starter is the starter kit directory or archive, if any.
+	Starter string
+
+	// StarterVars are values for the starter kit's variables.
+	StarterVars map[string]string
+}
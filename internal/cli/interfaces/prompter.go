@@ -0,0 +1,86 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+)
+
+// Prompter asks the user questions during a command.
+//
+// Interface defined by consumer per ADR-002 to avoid import cycles.
+// Context parameter enables cancellation of a prompt waiting for input per
+// ADR-003.
+//
+// Implementations:
+//   - TerminalPrompter: Interactive prompts using Bubble Tea
+//   - NonInteractivePrompter: Answers from --yes, or fails fast in CI, in
+//     JSON output and without a terminal
+//   - prompttest.Prompter: Scripted answers for tests
+type Prompter interface {
+	// Confirm asks a yes/no question.
+	Confirm(ctx context.Context, p ConfirmPrompt) (bool, error)
+
+	// Input asks for a line of text.
+	Input(ctx context.Context, p InputPrompt) (string, error)
+
+	// Select asks the user to pick one of p.Options and returns it.
+	Select(ctx context.Context, p SelectPrompt) (string, error)
+}
+
+// ErrPromptUnavailable is returned by a Prompter that cannot ask the user,
+// e.g. in CI or with JSON output, for a prompt without an assumed answer.
+var ErrPromptUnavailable = errors.New("interactive input required")
+
+// ErrPromptCanceled is returned when the user cancels a prompt with Esc or
+// Ctrl+C.
+var ErrPromptCanceled = errors.New("prompt canceled")
+
+// ConfirmPrompt is a yes/no question.
+type ConfirmPrompt struct {
+	// Title is the question, e.g. "Reset the database?".
+	Title string
+
+	// Description is optional detail shown below the question.
+	Description string
+
+	// Default is the answer selected initially. --yes answers yes
+	// regardless.
+	Default bool
+}
+
+// InputPrompt asks for a line of text.
+type InputPrompt struct {
+	// Title is the question.
+	Title string
+
+	// Description is optional detail shown below the question.
+	Description string
+
+	// Default is the answer when the user enters nothing, and the answer
+	// assumed with --yes.
+	Default string
+
+	// Placeholder is shown in the empty input.
+	Placeholder string
+
+	// Validate, if set, rejects an answer by returning an error, which is
+	// shown to the user before asking again.
+	Validate func(string) error
+}
+
+// SelectPrompt asks the user to pick one of a list of options.
+type SelectPrompt struct {
+	// Title is the question.
+	Title string
+
+	// Description is optional detail shown below the question.
+	Description string
+
+	// Options are the choices, in display order.
+	Options []string
+
+	// Default is the option selected initially, and the answer assumed
+	// with --yes. Empty selects the first option interactively but has no
+	// assumed answer.
+	Default string
+}
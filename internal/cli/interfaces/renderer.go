@@ -11,6 +11,7 @@ package interfaces
 // Implementations of this interface handle different output modes:
 //   - ConsoleRenderer: Human-friendly output with colors and formatting
 //   - JSONRenderer: Machine-readable JSON output for scripting
+//   - NDJSONRenderer: A stream of JSON events for editors and tools
 //   - TUIRenderer: Interactive terminal UI (future implementation)
 //
 // All Renderer methods are designed to be called sequentially during command
@@ -32,6 +33,17 @@ type Renderer interface {
 	// The returned Progress interface allows incremental updates and completion.
 	Progress(spec ProgressSpec) Progress
 
+	// Stream displays one line of output from an external command as it
+	// runs, attributed to source (usually a generation step name).
+	// Implementations may discard it, e.g. outside verbose mode.
+	Stream(source, line string)
+
+	// Result records the typed result of the command. Machine-readable
+	// renderers serialize it as-is; the console renderer ignores it, since
+	// commands render the same information with Title, Section and Table.
+	// A command calls Result at most once.
+	Result(res Result)
+
 	// Flush ensures all buffered output is written.
 	// Should be called after all other methods to guarantee output visibility.
 	// Returns an error if the flush operation fails.
@@ -105,12 +117,14 @@ type ProgressSpec struct {
 // Progress tracks incremental updates for long-running operations.
 //
 // Progress instances are created by calling Renderer.Progress() with a ProgressSpec.
-// Use Increment to update progress and Done to mark completion.
+// Use Increment to update progress, Status to describe the current work and
+// Done to mark completion.
 //
 // Example:
 //
 //	progress := renderer.Progress(ProgressSpec{Label: "Processing", Total: 100})
 //	for i := 0; i < 100; i++ {
+//	    progress.Status(fmt.Sprintf("file %d", i+1))
 //	    // do work
 //	    progress.Increment(1)
 //	}
@@ -120,7 +134,24 @@ type Progress interface {
 	// The total of all increments should equal ProgressSpec.Total.
 	Increment(n int64)
 
+	// Status describes the work in progress, such as the current phase,
+	// without changing the amount completed.
+	Status(s string)
+
 	// Done marks the progress as complete and finalizes the display.
 	// Should be called after all increments are complete.
 	Done()
 }
+
+// Result is the typed, machine-readable outcome of a command.
+//
+// Each command has its own result struct with JSON tags, documented by a
+// JSON Schema named after ResultKind.
+//
+// Example:
+//
+//	r.Result(commands.VersionResult{Version: "v0.1.0", Commit: "abc123"})
+type Result interface {
+	// ResultKind names the result type and its schema, e.g. "db-status".
+	ResultKind() string
+}
@@ -0,0 +1,50 @@
+package interfaces
+
+import "context"
+
+// ResourceGenerator scaffolds a CRUD resource inside an existing Tracks project.
+//
+// Interface defined by consumer per ADR-002 to avoid import cycles. As with
+// ProjectGenerator, the config parameter uses 'any'; the implementation
+// expects generator.ResourceConfig.
+//
+// Example usage:
+//
+//	gen := generator.NewResourceGenerator(runner)
+//	files, err := gen.Generate(ctx, generator.ResourceConfig{
+//	    Name:       "post",
+//	    ProjectDir: projectDir,
+//	})
+type ResourceGenerator interface {
+	// Generate writes the resource files and wires them into the project.
+	// The returned files describe every path that was created or updated,
+	// including those written before a failure.
+	Generate(ctx context.Context, cfg any) ([]GeneratedFile, error)
+
+	// Validate checks the configuration without touching the filesystem
+	// beyond detecting existing files.
+	Validate(cfg any) error
+}
+
+// GeneratedFile describes a file touched by a generator.
+type GeneratedFile struct {
+	// Path is relative to the project root, using forward slashes.
+	Path string
+
+	// Action is one of "create", "update", "skip" or "conflict".
+	Action string
+
+	// Detail explains skipped files, e.g. which markers were missing.
+	Detail string
+}
+
+// Generated file actions.
+const (
+	FileActionCreate = "create"
+	FileActionUpdate = "update"
+	FileActionSkip   = "skip"
+
+	// FileActionConflict marks a file written with merge conflict markers
+	// that the user must resolve.
+	FileActionConflict = "conflict"
+)
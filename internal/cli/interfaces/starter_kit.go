@@ -0,0 +1,26 @@
+package interfaces
+
+// StarterKit describes a template pack passed to `tracks new --starter`.
+type StarterKit struct {
+	Name        string
+	Description string
+
+	// Variables are the extra template variables the kit declares, in
+	// declaration order.
+	Variables []StarterVariable
+}
+
+// StarterVariable is a template variable declared by a starter kit and
+// exposed to its templates as {{.Vars.Name}}.
+type StarterVariable struct {
+	Name string
+
+	// Prompt is the question asked for the value.
+	Prompt string
+
+	// Default is used when no value is given.
+	Default string
+
+	// Required variables must have a non-empty value.
+	Required bool
+}
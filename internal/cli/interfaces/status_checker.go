@@ -0,0 +1,30 @@
+package interfaces
+
+import "context"
+
+// StatusChecker compares a project's files with the generation manifest
+// recorded by `tracks new` and `tracks upgrade`.
+//
+// Interface defined by consumer per ADR-002 to avoid import cycles.
+type StatusChecker interface {
+	// Status returns the state of every file in the manifest of the project
+	// rooted at projectDir, sorted by path. It returns an error if the
+	// project has no manifest.
+	Status(ctx context.Context, projectDir string) ([]FileStatus, error)
+}
+
+// FileStatus describes a generated file compared with its manifest entry.
+type FileStatus struct {
+	// Path is relative to the project root, using forward slashes.
+	Path string
+
+	// State is one of "untouched", "modified" or "deleted".
+	State string
+}
+
+// Generated file states.
+const (
+	FileStateUntouched = "untouched"
+	FileStateModified  = "modified"
+	FileStateDeleted   = "deleted"
+)
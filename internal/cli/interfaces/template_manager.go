@@ -0,0 +1,61 @@
+package interfaces
+
+import "context"
+
+// TemplateManager manages the override directories that shadow the
+// templates embedded in the CLI.
+//
+// Interface defined by consumer per ADR-002 to avoid import cycles.
+type TemplateManager interface {
+	// Overrides returns the override files in the user directory and, if
+	// projectDir is not empty, the project's .tracks/templates directory,
+	// sorted by template path.
+	Overrides(ctx context.Context, projectDir string) ([]TemplateOverride, error)
+
+	// Eject copies the embedded template name into the override directory
+	// of scope so it can be edited, and returns the path written. It fails
+	// if an override already exists unless force is set. projectDir is
+	// only used for TemplateScopeProject.
+	Eject(ctx context.Context, projectDir, name, scope string, force bool) (string, error)
+
+	// Check renders every project, resource and migration template, with
+	// the overrides for projectDir applied, for every database driver and
+	// returns one result per template and driver.
+	Check(ctx context.Context, projectDir string) ([]TemplateCheck, error)
+}
+
+// TemplateOverride is one file in an override directory.
+type TemplateOverride struct {
+	// Template is the template path, e.g. "project/Dockerfile.tmpl".
+	Template string
+
+	// Scope is TemplateScopeProject or TemplateScopeUser.
+	Scope string
+
+	// Path is the override file on disk.
+	Path string
+
+	// Active reports whether the override is used. It is false when the
+	// file matches no embedded template or a project override shadows it.
+	Active bool
+}
+
+// Template override scopes, in order of precedence.
+const (
+	TemplateScopeProject = "project"
+	TemplateScopeUser    = "user"
+)
+
+// TemplateCheck is the result of rendering one template for one database
+// driver.
+type TemplateCheck struct {
+	// Template is the template path, e.g. "resource/handler.go.tmpl".
+	Template string
+
+	// Driver is the database driver the template was rendered for.
+	Driver string
+
+	// Err is why rendering failed, or nil. Go and templ output that does
+	// not parse fails with the position of the error.
+	Err error
+}
@@ -12,4 +12,5 @@ type Validator interface {
 	ValidateDirectory(ctx context.Context, path string) error
 	ValidateDatabaseDriver(ctx context.Context, driver string) error
 	ValidateEnvPrefix(ctx context.Context, prefix string) error
+	ValidateGoVersion(ctx context.Context, version string) error
 }
@@ -8,6 +8,7 @@ package renderer
 import (
 	"fmt"
 	"io"
+	"sync"
 
 	"github.com/anomalousventures/tracks/internal/cli/interfaces"
 	"github.com/anomalousventures/tracks/internal/cli/ui"
@@ -20,6 +21,9 @@ var _ interfaces.Renderer = (*ConsoleRenderer)(nil)
 
 const (
 	columnPadding = 2
+
+	// clearLine erases from the cursor to the end of the line.
+	clearLine = "\x1b[K"
 )
 
 // ConsoleRenderer implements the Renderer interface for human-readable
@@ -43,6 +47,17 @@ const (
 // as the underlying io.Writer is thread-safe.
 type ConsoleRenderer struct {
 	out io.Writer
+
+	// verbose enables Stream output.
+	verbose bool
+
+	// streamMu serializes Stream and progress updates, which generation
+	// steps make concurrently.
+	streamMu sync.Mutex
+
+	// progress is the most recent progress bar, redrawn below streamed
+	// lines until it is done.
+	progress *ConsoleProgress
 }
 
 // NewConsoleRenderer creates a new ConsoleRenderer that writes to the
@@ -190,14 +205,58 @@ func (r *ConsoleRenderer) Table(t interfaces.Table) {
 //	progress.Done()         // Adds newline
 func (r *ConsoleRenderer) Progress(spec interfaces.ProgressSpec) interfaces.Progress {
 	bar := progress.New(progress.WithScaledGradient("#7D56F4", "#04B575"))
-	return &ConsoleProgress{
+	p := &ConsoleProgress{
+		mu:    &r.streamMu,
 		out:   r.out,
 		bar:   bar,
 		label: spec.Label,
 		total: spec.Total,
 	}
+
+	r.streamMu.Lock()
+	r.progress = p
+	r.streamMu.Unlock()
+
+	return p
+}
+
+// SetVerbose enables or disables Stream output. It is enabled by --verbose
+// and stays off with --quiet.
+func (r *ConsoleRenderer) SetVerbose(verbose bool) {
+	r.verbose = verbose
 }
 
+// Stream writes one line of external command output, indented and prefixed
+// with its source in the Theme.Muted style, when verbose output is enabled.
+// Otherwise the line is discarded. A progress bar in use is cleared and
+// redrawn below the line.
+//
+// Example:
+//
+//	renderer.Stream("go mod tidy", "go: downloading github.com/go-chi/chi/v5 v5.2.3")
+//	// Output:   [go mod tidy] go: downloading github.com/go-chi/chi/v5 v5.2.3
+func (r *ConsoleRenderer) Stream(source, line string) {
+	if !r.verbose {
+		return
+	}
+	r.streamMu.Lock()
+	defer r.streamMu.Unlock()
+
+	p := r.progress
+	active := p != nil && p.drawn && !p.done
+	if active {
+		fmt.Fprint(r.out, "\r"+clearLine)
+	}
+	fmt.Fprintf(r.out, "  %s %s\n", ui.Theme.Muted.Render("["+source+"]"), line)
+	if active {
+		p.render()
+	}
+}
+
+// Result is a no-op: commands render their results for people with Title,
+// Section and Table.
+func (r *ConsoleRenderer) Result(res interfaces.Result) {}
+
 // Flush ensures all buffered output is written.
 //
 // For ConsoleRenderer, this is a no-op since fmt.Fprintln writes directly
@@ -215,11 +274,17 @@ func (r *ConsoleRenderer) Flush() error {
 // Renders a progress bar using ViewAs for standalone rendering without
 // Bubble Tea event loop. Updates are written in-place using \r prefix.
 type ConsoleProgress struct {
+	// mu is the renderer's lock, shared so updates don't interleave with
+	// streamed lines.
+	mu *sync.Mutex
+
 	out     io.Writer
 	bar     progress.Model
 	label   string
+	status  string
 	total   int64
 	current int64
+	drawn   bool
 	done    bool
 }
 
@@ -230,7 +295,29 @@ type ConsoleProgress struct {
 // overflow gracefully. If a label was provided, it is displayed before
 // the progress bar using the Muted theme style.
 func (p *ConsoleProgress) Increment(n int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
 	p.current += n
+	p.render()
+}
+
+// Status sets the text shown after the progress bar, such as the current
+// phase, and redraws the bar.
+func (p *ConsoleProgress) Status(s string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.status = s
+	p.render()
+}
+
+// render draws the bar in place. The line is cleared first, so a shorter
+// status leaves nothing behind. Callers hold mu.
+func (p *ConsoleProgress) render() {
+	if p.done {
+		return
+	}
 
 	var percent float64
 	if p.total > 0 {
@@ -247,8 +334,12 @@ func (p *ConsoleProgress) Increment(n int64) {
 		output += ui.Theme.Muted.Render(p.label+": ")
 	}
 	output += p.bar.ViewAs(percent)
+	if p.status != "" {
+		output += " " + p.status
+	}
 
-	fmt.Fprint(p.out, output)
+	fmt.Fprint(p.out, output+clearLine)
+	p.drawn = true
 }
 
 // Done completes the progress bar and adds a newline.
@@ -256,6 +347,9 @@ func (p *ConsoleProgress) Increment(n int64) {
 // Marks the progress as complete and writes a final newline to move
 // to the next line. Subsequent calls are idempotent (no additional output).
 func (p *ConsoleProgress) Done() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
 	if p.done {
 		return
 	}
@@ -886,3 +886,86 @@ func TestConsoleProgressWithoutLabel(t *testing.T) {
 		t.Error("Progress output should contain carriage return for in-place updates")
 	}
 }
+
+func TestConsoleRendererStream(t *testing.T) {
+	var buf bytes.Buffer
+	renderer := NewConsoleRenderer(&buf)
+
+	renderer.Stream("go mod tidy", "ignored")
+	if buf.Len() != 0 {
+		t.Errorf("Stream should discard output unless verbose, got %q", buf.String())
+	}
+
+	renderer.SetVerbose(true)
+	renderer.Stream("go mod tidy", "go: downloading example.com/mod v1.0.0")
+
+	output := buf.String()
+	if !strings.HasPrefix(output, "  ") {
+		t.Errorf("streamed line should be indented, got %q", output)
+	}
+	if !strings.Contains(output, "[go mod tidy]") || !strings.HasSuffix(output, "go: downloading example.com/mod v1.0.0\n") {
+		t.Errorf("streamed line should be prefixed with its source, got %q", output)
+	}
+}
+
+func TestConsoleProgressStatus(t *testing.T) {
+	var buf bytes.Buffer
+	renderer := NewConsoleRenderer(&buf)
+
+	progress := renderer.Progress(interfaces.ProgressSpec{Label: "Generating project", Total: 6})
+	progress.Status("dependencies")
+	progress.Increment(1)
+
+	output := buf.String()
+	if strings.Count(output, "\r") != 2 {
+		t.Errorf("Status and Increment should each redraw the bar, got %q", output)
+	}
+	if !strings.Contains(output, "dependencies") {
+		t.Errorf("Progress output should contain the status, got %q", output)
+	}
+
+	progress.Done()
+	buf.Reset()
+	progress.Status("git")
+	if buf.Len() != 0 {
+		t.Errorf("Status after Done should not redraw the bar, got %q", buf.String())
+	}
+}
+
+func TestConsoleRendererStreamRedrawsProgress(t *testing.T) {
+	var buf bytes.Buffer
+	renderer := NewConsoleRenderer(&buf)
+	renderer.SetVerbose(true)
+
+	progress := renderer.Progress(interfaces.ProgressSpec{Label: "Generating project", Total: 6})
+	progress.Status("codegen")
+	buf.Reset()
+
+	renderer.Stream("make generate", "templ generate")
+
+	output := buf.String()
+	if !strings.HasPrefix(output, "\r"+clearLine+"  ") {
+		t.Errorf("Stream should clear the progress bar first, got %q", output)
+	}
+	if !strings.Contains(output, "templ generate\n\r") || !strings.Contains(output, "codegen") {
+		t.Errorf("Stream should redraw the progress bar after the line, got %q", output)
+	}
+
+	progress.Done()
+	buf.Reset()
+	renderer.Stream("make generate", "done")
+	if strings.Contains(buf.String(), "\r") {
+		t.Errorf("Stream should not redraw a finished progress bar, got %q", buf.String())
+	}
+}
+
+func TestConsoleRendererResultWritesNothing(t *testing.T) {
+	var buf bytes.Buffer
+	renderer := NewConsoleRenderer(&buf)
+
+	renderer.Result(testResult{Name: "users", Count: 2})
+
+	if buf.Len() != 0 {
+		t.Errorf("Result should write nothing, got %q", buf.String())
+	}
+}
@@ -8,6 +8,7 @@ package renderer
 import (
 	"encoding/json"
 	"io"
+	"sync"
 
 	"github.com/anomalousventures/tracks/internal/cli/interfaces"
 )
@@ -48,18 +49,48 @@ var _ interfaces.Renderer = (*JSONRenderer)(nil)
 //	  ]
 //	}
 //
-// JSONRenderer is not safe for concurrent use from multiple goroutines.
-// Each command should use a single JSONRenderer instance sequentially.
+// JSONRenderer is not safe for concurrent use from multiple goroutines,
+// except for updates to the Progress it returns. Each command should use a
+// single JSONRenderer instance sequentially.
 type JSONRenderer struct {
-	out      io.Writer
-	data     *jsonOutput
+	out  io.Writer
+	data *jsonOutput
+
+	// mu guards data.Progress, which generation steps update concurrently.
+	mu sync.Mutex
 }
 
+// ResultSchemaVersion is the version of the result schemas, sent as
+// "schema_version" with every typed result and error. It changes only when
+// a field is removed or changes meaning; fields may be added within a
+// version.
+const ResultSchemaVersion = 1
+
 // jsonOutput holds all accumulated data for JSON output.
 type jsonOutput struct {
-	Title    string               `json:"title,omitempty"`
-	Sections []interfaces.Section `json:"sections,omitempty"`
-	Tables   []interfaces.Table   `json:"tables,omitempty"`
+	Title         string               `json:"title,omitempty"`
+	Sections      []interfaces.Section `json:"sections,omitempty"`
+	Tables        []interfaces.Table   `json:"tables,omitempty"`
+	Progress      []progressEvent      `json:"progress,omitempty"`
+	SchemaVersion int                  `json:"schema_version,omitempty"`
+	Kind          string               `json:"kind,omitempty"`
+	Result        interfaces.Result    `json:"result,omitempty"`
+	Error         *errorEvent          `json:"error,omitempty"`
+}
+
+// errorEvent reports the error a command failed with.
+type errorEvent struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// progressEvent is one update to a progress tracker.
+type progressEvent struct {
+	Label   string `json:"label,omitempty"`
+	Status  string `json:"status,omitempty"`
+	Current int64  `json:"current"`
+	Total   int64  `json:"total"`
+	Done    bool   `json:"done,omitempty"`
 }
 
 // NewJSONRenderer creates a new JSONRenderer that writes to the
@@ -123,19 +154,68 @@ func (r *JSONRenderer) Table(t interfaces.Table) {
 	r.data.Tables = append(r.data.Tables, t)
 }
 
-// Progress returns a no-op Progress implementation for JSON output.
+// Progress returns a Progress that records each update as a discrete event.
 //
-// JSON output is not suitable for incremental progress updates, so this
-// method returns a Progress instance that discards all updates. Progress
-// is designed for interactive terminals, not machine-readable output.
+// Events are accumulated in the "progress" field and written with the rest
+// of the output when Flush is called, so scripts can see which phases ran.
 //
 // Example:
 //
-//	progress := renderer.Progress(interfaces.ProgressSpec{Label: "Downloading", Total: 100})
-//	progress.Increment(50)  // No output
-//	progress.Done()         // No output
+//	progress := renderer.Progress(interfaces.ProgressSpec{Label: "Generating project", Total: 2})
+//	progress.Status("templates")
+//	progress.Increment(1)
+//	progress.Done()
+//
+// Output:
+//
+//	"progress": [
+//	  {"label": "Generating project", "status": "templates", "current": 0, "total": 2},
+//	  {"label": "Generating project", "status": "templates", "current": 1, "total": 2},
+//	  {"label": "Generating project", "status": "templates", "current": 1, "total": 2, "done": true}
+//	]
 func (r *JSONRenderer) Progress(spec interfaces.ProgressSpec) interfaces.Progress {
-	return &jsonProgress{}
+	return &jsonProgress{renderer: r, label: spec.Label, total: spec.Total}
+}
+
+// Stream discards command output.
+//
+// Streamed lines would interleave with the JSON document, so they are not
+// written. A command that fails still reports its output in the error.
+func (r *JSONRenderer) Stream(source, line string) {}
+
+// Result stores the command's typed result in the "result" field, with its
+// kind and the schema version.
+//
+// Example:
+//
+//	renderer.Result(commands.VersionResult{Version: "v0.1.0"})
+//
+// Output:
+//
+//	"schema_version": 1,
+//	"kind": "version",
+//	"result": {"version": "v0.1.0", ...}
+func (r *JSONRenderer) Result(res interfaces.Result) {
+	r.data.SchemaVersion = ResultSchemaVersion
+	r.data.Kind = res.ResultKind()
+	r.data.Result = res
+}
+
+// Error stores the error a command failed with in the "error" field, with
+// its machine-readable code. It is not part of the Renderer interface: the
+// CLI reports the error a command returned on its own JSON document.
+//
+// Example:
+//
+//	renderer.Error("not_in_project", err)
+//
+// Output:
+//
+//	"schema_version": 1,
+//	"error": {"code": "not_in_project", "message": "not in a Tracks project ..."}
+func (r *JSONRenderer) Error(code string, err error) {
+	r.data.SchemaVersion = ResultSchemaVersion
+	r.data.Error = &errorEvent{Code: code, Message: err.Error()}
 }
 
 // Flush writes all accumulated data as formatted JSON.
@@ -152,19 +232,54 @@ func (r *JSONRenderer) Progress(spec interfaces.ProgressSpec) interfaces.Progres
 //	renderer.Title("Project Created")
 //	renderer.Flush()  // Writes JSON to output
 func (r *JSONRenderer) Flush() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
 	enc := json.NewEncoder(r.out)
 	enc.SetIndent("", "  ")
 	return enc.Encode(r.data)
 }
 
-// jsonProgress is a no-op Progress implementation for JSON output.
-//
-// Since JSON output is designed for machine-readable structured data,
-// incremental progress updates don't make sense. All methods are no-ops.
-type jsonProgress struct{}
+// jsonProgress records progress updates as events in the JSON output.
+type jsonProgress struct {
+	renderer *JSONRenderer
+	label    string
+	status   string
+	total    int64
+	current  int64
+	done     bool
+}
 
-// Increment is a no-op for JSON progress.
-func (p *jsonProgress) Increment(n int64) {}
+// Increment records an event with the new amount completed.
+func (p *jsonProgress) Increment(n int64) {
+	p.current += n
+	p.record()
+}
 
-// Done is a no-op for JSON progress.
-func (p *jsonProgress) Done() {}
+// Status records an event with the new status.
+func (p *jsonProgress) Status(s string) {
+	p.status = s
+	p.record()
+}
+
+// Done records a final event. Subsequent calls are idempotent.
+func (p *jsonProgress) Done() {
+	if p.done {
+		return
+	}
+	p.done = true
+	p.record()
+}
+
+func (p *jsonProgress) record() {
+	p.renderer.mu.Lock()
+	defer p.renderer.mu.Unlock()
+
+	p.renderer.data.Progress = append(p.renderer.data.Progress, progressEvent{
+		Label:   p.label,
+		Status:  p.status,
+		Current: p.current,
+		Total:   p.total,
+		Done:    p.done,
+	})
+}
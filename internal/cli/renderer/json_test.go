@@ -4,6 +4,7 @@ import (
 	"github.com/anomalousventures/tracks/internal/cli/interfaces"
 	"bytes"
 	"encoding/json"
+	"errors"
 	"strings"
 	"testing"
 )
@@ -154,7 +155,62 @@ func TestJSONRendererProgress(t *testing.T) {
 	progress.Done()
 
 	if buf.Len() > 0 {
-		t.Error("Progress updates should not write to output (no-op implementation)")
+		t.Error("Progress updates should not write to output before Flush")
+	}
+}
+
+func TestJSONRendererProgressEvents(t *testing.T) {
+	var buf bytes.Buffer
+	renderer := NewJSONRenderer(&buf)
+
+	progress := renderer.Progress(interfaces.ProgressSpec{Label: "Generating project", Total: 2})
+	progress.Status("templates")
+	progress.Increment(1)
+	progress.Status("git")
+	progress.Increment(1)
+	progress.Done()
+	progress.Done()
+
+	if err := renderer.Flush(); err != nil {
+		t.Fatalf("Flush should not return error: %v", err)
+	}
+
+	var result struct {
+		Progress []struct {
+			Label   string `json:"label"`
+			Status  string `json:"status"`
+			Current int64  `json:"current"`
+			Total   int64  `json:"total"`
+			Done    bool   `json:"done"`
+		} `json:"progress"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &result); err != nil {
+		t.Fatalf("Output should be valid JSON: %v", err)
+	}
+
+	events := result.Progress
+	if len(events) != 5 {
+		t.Fatalf("Expected 5 progress events (Done is idempotent), got %d", len(events))
+	}
+	if events[0].Label != "Generating project" || events[0].Status != "templates" || events[0].Current != 0 || events[0].Total != 2 {
+		t.Errorf("Unexpected first event: %+v", events[0])
+	}
+	if events[3].Status != "git" || events[3].Current != 2 {
+		t.Errorf("Unexpected fourth event: %+v", events[3])
+	}
+	if !events[4].Done || events[3].Done {
+		t.Error("Only the last event should be marked done")
+	}
+}
+
+func TestJSONRendererStream(t *testing.T) {
+	var buf bytes.Buffer
+	renderer := NewJSONRenderer(&buf)
+
+	renderer.Stream("npm install", "added 120 packages")
+
+	if buf.Len() > 0 {
+		t.Error("Stream should not write to output (no-op implementation)")
 	}
 }
 
@@ -308,3 +364,78 @@ func TestJSONRendererOutputFormatted(t *testing.T) {
 		t.Error("JSON output should be indented")
 	}
 }
+
+type testResult struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+func (testResult) ResultKind() string { return "test" }
+
+func TestJSONRendererResult(t *testing.T) {
+	var buf bytes.Buffer
+	renderer := NewJSONRenderer(&buf)
+
+	renderer.Title("Done")
+	renderer.Result(testResult{Name: "users", Count: 2})
+	if err := renderer.Flush(); err != nil {
+		t.Fatalf("Flush should not return error: %v", err)
+	}
+
+	var result struct {
+		Title         string     `json:"title"`
+		SchemaVersion int        `json:"schema_version"`
+		Kind          string     `json:"kind"`
+		Result        testResult `json:"result"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &result); err != nil {
+		t.Fatalf("Output should be valid JSON: %v", err)
+	}
+
+	if result.Title != "Done" || result.SchemaVersion != ResultSchemaVersion || result.Kind != "test" {
+		t.Errorf("unexpected envelope: %+v", result)
+	}
+	if result.Result != (testResult{Name: "users", Count: 2}) {
+		t.Errorf("unexpected result: %+v", result.Result)
+	}
+}
+
+func TestJSONRendererError(t *testing.T) {
+	var buf bytes.Buffer
+	renderer := NewJSONRenderer(&buf)
+
+	renderer.Error("not_in_project", errors.New("not in a Tracks project"))
+	if err := renderer.Flush(); err != nil {
+		t.Fatalf("Flush should not return error: %v", err)
+	}
+
+	var result map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &result); err != nil {
+		t.Fatalf("Output should be valid JSON: %v", err)
+	}
+
+	if result["schema_version"] != float64(ResultSchemaVersion) {
+		t.Errorf("Expected schema_version %d, got %v", ResultSchemaVersion, result["schema_version"])
+	}
+	errObj, ok := result["error"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("Expected error object, got %v", result["error"])
+	}
+	if errObj["code"] != "not_in_project" || errObj["message"] != "not in a Tracks project" {
+		t.Errorf("unexpected error: %v", errObj)
+	}
+}
+
+func TestJSONRendererWithoutResultOmitsSchemaVersion(t *testing.T) {
+	var buf bytes.Buffer
+	renderer := NewJSONRenderer(&buf)
+
+	renderer.Title("Test")
+	if err := renderer.Flush(); err != nil {
+		t.Fatalf("Flush should not return error: %v", err)
+	}
+
+	if strings.Contains(buf.String(), "schema_version") || strings.Contains(buf.String(), "result") {
+		t.Errorf("Expected no result fields, got %s", buf.String())
+	}
+}
@@ -0,0 +1,211 @@
+// Package renderer provides output formatting implementations for CLI commands.
+//
+// NDJSONRenderer outputs a stream of newline-delimited JSON events, written
+// as they happen, for editors and scripts that follow long-running commands.
+package renderer
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+)
+
+// Ensure NDJSONRenderer implements interfaces.Renderer at compile time.
+var _ interfaces.Renderer = (*NDJSONRenderer)(nil)
+
+// NDJSONVersion is the version of the NDJSON event schema, sent with every
+// event. It changes only when an existing field is removed or changes
+// meaning; new event types and fields may be added within a version.
+const NDJSONVersion = 1
+
+// NDJSON event types.
+const (
+	EventTitle    = "title"
+	EventSection  = "section"
+	EventTable    = "table"
+	EventProgress = "progress"
+	EventOutput   = "output"
+	EventResult   = "result"
+	EventError    = "error"
+)
+
+// ndjsonEvent is one line of NDJSON output. Type names the event and which
+// of the payload fields is set.
+type ndjsonEvent struct {
+	Version  int                 `json:"version"`
+	Type     string              `json:"type"`
+	Title    string              `json:"title,omitempty"`
+	Section  *interfaces.Section `json:"section,omitempty"`
+	Table    *interfaces.Table   `json:"table,omitempty"`
+	Progress *progressEvent      `json:"progress,omitempty"`
+	Output   *outputEvent        `json:"output,omitempty"`
+	Kind     string              `json:"kind,omitempty"`
+	Result   interfaces.Result   `json:"result,omitempty"`
+	Error    *errorEvent         `json:"error,omitempty"`
+}
+
+// outputEvent is a line printed by an external command.
+type outputEvent struct {
+	Source string `json:"source"`
+	Line   string `json:"line"`
+}
+
+// NDJSONRenderer implements the Renderer interface as a stream of
+// newline-delimited JSON events.
+//
+// Unlike JSONRenderer, which emits one document on Flush, every call writes
+// one event immediately, so a reader can show titles, sections and progress
+// while the command runs.
+//
+// Example usage:
+//
+//	renderer := NewNDJSONRenderer(os.Stdout)
+//	renderer.Title("Running migrations...")
+//	progress := renderer.Progress(interfaces.ProgressSpec{Label: "Applying migrations", Total: 1})
+//	progress.Status("20250101000000_initial_schema.sql")
+//	progress.Increment(1)
+//	progress.Done()
+//
+// Output:
+//
+//	{"version":1,"type":"title","title":"Running migrations..."}
+//	{"version":1,"type":"progress","progress":{"label":"Applying migrations","status":"20250101000000_initial_schema.sql","current":0,"total":1}}
+//	{"version":1,"type":"progress","progress":{"label":"Applying migrations","status":"20250101000000_initial_schema.sql","current":1,"total":1}}
+//	{"version":1,"type":"progress","progress":{"label":"Applying migrations","status":"20250101000000_initial_schema.sql","current":1,"total":1,"done":true}}
+//
+// NDJSONRenderer is safe for concurrent use from multiple goroutines.
+type NDJSONRenderer struct {
+	out io.Writer
+
+	// verbose enables output events for Stream.
+	verbose bool
+
+	// mu serializes writes, so events never interleave.
+	mu sync.Mutex
+}
+
+// NewNDJSONRenderer creates a new NDJSONRenderer that writes to the
+// provided io.Writer.
+//
+// Example:
+//
+//	renderer := NewNDJSONRenderer(os.Stdout)
+func NewNDJSONRenderer(out io.Writer) *NDJSONRenderer {
+	return &NDJSONRenderer{out: out}
+}
+
+// SetVerbose enables or disables output events for Stream. It is enabled by
+// --verbose and stays off with --quiet.
+func (r *NDJSONRenderer) SetVerbose(verbose bool) {
+	r.verbose = verbose
+}
+
+// Title writes a title event.
+func (r *NDJSONRenderer) Title(s string) {
+	r.write(ndjsonEvent{Type: EventTitle, Title: s})
+}
+
+// Section writes a section event.
+func (r *NDJSONRenderer) Section(sec interfaces.Section) {
+	r.write(ndjsonEvent{Type: EventSection, Section: &sec})
+}
+
+// Table writes a table event.
+func (r *NDJSONRenderer) Table(t interfaces.Table) {
+	r.write(ndjsonEvent{Type: EventTable, Table: &t})
+}
+
+// Progress returns a Progress that writes a progress event for each update,
+// with the same payload JSONRenderer records.
+func (r *NDJSONRenderer) Progress(spec interfaces.ProgressSpec) interfaces.Progress {
+	return &ndjsonProgress{renderer: r, label: spec.Label, total: spec.Total}
+}
+
+// Stream writes an output event for one line of external command output
+// when verbose output is enabled. Otherwise the line is discarded.
+func (r *NDJSONRenderer) Stream(source, line string) {
+	if !r.verbose {
+		return
+	}
+	r.write(ndjsonEvent{Type: EventOutput, Output: &outputEvent{Source: source, Line: line}})
+}
+
+// Result writes a result event with the command's typed result and its
+// kind. The result schemas are versioned with the events, by Version.
+func (r *NDJSONRenderer) Result(res interfaces.Result) {
+	r.write(ndjsonEvent{Type: EventResult, Kind: res.ResultKind(), Result: res})
+}
+
+// Error writes an error event with the error's machine-readable code. It is
+// not part of the Renderer interface: the CLI calls it once with the error a
+// command returned, after the command's own events.
+func (r *NDJSONRenderer) Error(code string, err error) {
+	r.write(ndjsonEvent{Type: EventError, Error: &errorEvent{Code: code, Message: err.Error()}})
+}
+
+// Flush is a no-op, since every event is written as it happens.
+//
+// Always returns nil.
+func (r *NDJSONRenderer) Flush() error {
+	return nil
+}
+
+// write encodes ev as one line. Encoding errors are ignored, like the
+// console renderer's write errors, since there is nowhere to report them.
+func (r *NDJSONRenderer) write(ev ndjsonEvent) {
+	ev.Version = NDJSONVersion
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	_ = json.NewEncoder(r.out).Encode(ev)
+}
+
+// ndjsonProgress writes a progress event for each update.
+type ndjsonProgress struct {
+	renderer *NDJSONRenderer
+	mu       sync.Mutex
+	label    string
+	status   string
+	total    int64
+	current  int64
+	done     bool
+}
+
+// Increment writes an event with the new amount completed.
+func (p *ndjsonProgress) Increment(n int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.current += n
+	p.write()
+}
+
+// Status writes an event with the new status.
+func (p *ndjsonProgress) Status(s string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.status = s
+	p.write()
+}
+
+// Done writes a final event. Subsequent calls are idempotent.
+func (p *ndjsonProgress) Done() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.done {
+		return
+	}
+	p.done = true
+	p.write()
+}
+
+func (p *ndjsonProgress) write() {
+	p.renderer.write(ndjsonEvent{Type: EventProgress, Progress: &progressEvent{
+		Label:   p.label,
+		Status:  p.status,
+		Current: p.current,
+		Total:   p.total,
+		Done:    p.done,
+	}})
+}
@@ -0,0 +1,172 @@
+package renderer
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+)
+
+func decodeNDJSON(t *testing.T, buf *bytes.Buffer) []ndjsonEvent {
+	t.Helper()
+	var events []ndjsonEvent
+	for _, line := range strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n") {
+		if line == "" {
+			continue
+		}
+		var ev ndjsonEvent
+		if err := json.Unmarshal([]byte(line), &ev); err != nil {
+			t.Fatalf("line %q is not valid JSON: %v", line, err)
+		}
+		if ev.Version != NDJSONVersion {
+			t.Errorf("event version = %d, want %d", ev.Version, NDJSONVersion)
+		}
+		events = append(events, ev)
+	}
+	return events
+}
+
+func TestNDJSONRendererImplementsInterface(t *testing.T) {
+	var _ interfaces.Renderer = NewNDJSONRenderer(&bytes.Buffer{})
+}
+
+func TestNDJSONRendererWritesEventsImmediately(t *testing.T) {
+	var buf bytes.Buffer
+	r := NewNDJSONRenderer(&buf)
+
+	r.Title("Creating project")
+	if got := buf.String(); got != `{"version":1,"type":"title","title":"Creating project"}`+"\n" {
+		t.Errorf("title event = %q", got)
+	}
+
+	r.Section(interfaces.Section{Title: "Next steps", Body: "cd myapp"})
+	r.Table(interfaces.Table{Headers: []string{"Name"}, Rows: [][]string{{"users"}}})
+
+	events := decodeNDJSON(t, &buf)
+	if len(events) != 3 {
+		t.Fatalf("got %d events, want 3", len(events))
+	}
+	if events[1].Type != EventSection || events[1].Section == nil || events[1].Section.Body != "cd myapp" {
+		t.Errorf("unexpected section event: %+v", events[1])
+	}
+	if events[2].Type != EventTable || events[2].Table == nil || events[2].Table.Rows[0][0] != "users" {
+		t.Errorf("unexpected table event: %+v", events[2])
+	}
+}
+
+func TestNDJSONRendererProgress(t *testing.T) {
+	var buf bytes.Buffer
+	r := NewNDJSONRenderer(&buf)
+
+	p := r.Progress(interfaces.ProgressSpec{Label: "Applying migrations", Total: 2})
+	p.Status("001_users.sql")
+	p.Increment(1)
+	p.Done()
+	p.Done()
+
+	events := decodeNDJSON(t, &buf)
+	if len(events) != 3 {
+		t.Fatalf("got %d events, want 3: %s", len(events), buf.String())
+	}
+	for _, ev := range events {
+		if ev.Type != EventProgress || ev.Progress == nil {
+			t.Fatalf("unexpected event: %+v", ev)
+		}
+	}
+
+	want := []progressEvent{
+		{Label: "Applying migrations", Status: "001_users.sql", Current: 0, Total: 2},
+		{Label: "Applying migrations", Status: "001_users.sql", Current: 1, Total: 2},
+		{Label: "Applying migrations", Status: "001_users.sql", Current: 1, Total: 2, Done: true},
+	}
+	for i, w := range want {
+		if *events[i].Progress != w {
+			t.Errorf("event %d = %+v, want %+v", i, *events[i].Progress, w)
+		}
+	}
+}
+
+func TestNDJSONRendererStream(t *testing.T) {
+	var buf bytes.Buffer
+	r := NewNDJSONRenderer(&buf)
+
+	r.Stream("go mod tidy", "go: downloading")
+	if buf.Len() != 0 {
+		t.Errorf("expected no output without verbose, got %q", buf.String())
+	}
+
+	r.SetVerbose(true)
+	r.Stream("go mod tidy", "go: downloading")
+
+	events := decodeNDJSON(t, &buf)
+	if len(events) != 1 || events[0].Type != EventOutput {
+		t.Fatalf("expected one output event, got %+v", events)
+	}
+	if *events[0].Output != (outputEvent{Source: "go mod tidy", Line: "go: downloading"}) {
+		t.Errorf("unexpected output event: %+v", *events[0].Output)
+	}
+}
+
+func TestNDJSONRendererError(t *testing.T) {
+	var buf bytes.Buffer
+	r := NewNDJSONRenderer(&buf)
+
+	r.Error("database_connection_failed", errors.New("connection refused"))
+
+	want := `{"version":1,"type":"error","error":{"code":"database_connection_failed","message":"connection refused"}}` + "\n"
+	if buf.String() != want {
+		t.Errorf("got %q, want %q", buf.String(), want)
+	}
+}
+
+func TestNDJSONRendererFlush(t *testing.T) {
+	var buf bytes.Buffer
+	r := NewNDJSONRenderer(&buf)
+
+	if err := r.Flush(); err != nil {
+		t.Errorf("Flush() error = %v", err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("Flush should write nothing, got %q", buf.String())
+	}
+}
+
+func TestNDJSONRendererConcurrentEvents(t *testing.T) {
+	var buf bytes.Buffer
+	r := NewNDJSONRenderer(&buf)
+	r.SetVerbose(true)
+	p := r.Progress(interfaces.ProgressSpec{Label: "Generating project", Total: 100})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 10; j++ {
+				p.Increment(1)
+				r.Stream("make generate", "line")
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := len(decodeNDJSON(t, &buf)); got != 200 {
+		t.Errorf("got %d events, want 200", got)
+	}
+}
+
+func TestNDJSONRendererResult(t *testing.T) {
+	var buf bytes.Buffer
+	r := NewNDJSONRenderer(&buf)
+
+	r.Result(testResult{Name: "users", Count: 2})
+
+	want := `{"version":1,"type":"result","kind":"test","result":{"name":"users","count":2}}` + "\n"
+	if buf.String() != want {
+		t.Errorf("got %q, want %q", buf.String(), want)
+	}
+}
@@ -0,0 +1,353 @@
+package renderer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	"github.com/anomalousventures/tracks/internal/cli/ui"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+var (
+	_ interfaces.Prompter = (*TerminalPrompter)(nil)
+	_ interfaces.Prompter = (*NonInteractivePrompter)(nil)
+)
+
+// TerminalPrompter asks questions interactively using Bubble Tea. Each
+// prompt runs as a small inline program and leaves its question and answer
+// on screen when done.
+type TerminalPrompter struct {
+	in  io.Reader
+	out io.Writer
+}
+
+// NewTerminalPrompter creates a TerminalPrompter that reads keys from in
+// and draws to out, normally the command's stdin and stdout.
+func NewTerminalPrompter(in io.Reader, out io.Writer) *TerminalPrompter {
+	return &TerminalPrompter{in: in, out: out}
+}
+
+// Confirm asks a yes/no question. y and n answer directly; arrow keys and
+// tab switch the selection and enter accepts it.
+func (p *TerminalPrompter) Confirm(ctx context.Context, prompt interfaces.ConfirmPrompt) (bool, error) {
+	m, err := p.run(ctx, &confirmModel{prompt: prompt, value: prompt.Default})
+	if err != nil {
+		return false, err
+	}
+	return m.(*confirmModel).value, nil
+}
+
+// Input asks for a line of text. Entering nothing answers the default.
+func (p *TerminalPrompter) Input(ctx context.Context, prompt interfaces.InputPrompt) (string, error) {
+	m, err := p.run(ctx, newInputModel(prompt))
+	if err != nil {
+		return "", err
+	}
+	return m.(*inputModel).value, nil
+}
+
+// Select asks the user to pick an option with the arrow keys and enter.
+func (p *TerminalPrompter) Select(ctx context.Context, prompt interfaces.SelectPrompt) (string, error) {
+	if len(prompt.Options) == 0 {
+		return "", fmt.Errorf("%s: no options to select from", prompt.Title)
+	}
+	m, err := p.run(ctx, &selectModel{prompt: prompt, cursor: max(slices.Index(prompt.Options, prompt.Default), 0)})
+	if err != nil {
+		return "", err
+	}
+	sm := m.(*selectModel)
+	return sm.prompt.Options[sm.cursor], nil
+}
+
+// promptModel is a Bubble Tea model that records whether the user
+// canceled.
+type promptModel interface {
+	tea.Model
+	canceled() bool
+}
+
+func (p *TerminalPrompter) run(ctx context.Context, m promptModel) (promptModel, error) {
+	program := tea.NewProgram(m,
+		tea.WithContext(ctx),
+		tea.WithInput(p.in),
+		tea.WithOutput(p.out),
+	)
+	final, err := program.Run()
+	if err != nil {
+		if errors.Is(err, tea.ErrProgramKilled) && ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if errors.Is(err, tea.ErrInterrupted) {
+			return nil, interfaces.ErrPromptCanceled
+		}
+		return nil, fmt.Errorf("prompt failed: %w", err)
+	}
+	pm := final.(promptModel)
+	if pm.canceled() {
+		return nil, interfaces.ErrPromptCanceled
+	}
+	return pm, nil
+}
+
+// header renders a prompt's title and description.
+func header(title, description string) string {
+	var b strings.Builder
+	b.WriteString(ui.Theme.Title.Render(title))
+	b.WriteString("\n")
+	if description != "" {
+		b.WriteString(ui.Theme.Muted.Render(description))
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// answered renders a finished prompt as a single line.
+func answered(title, answer string) string {
+	return fmt.Sprintf("%s %s\n", ui.Theme.Title.Render(title), answer)
+}
+
+// isCancel reports whether msg is Esc or Ctrl+C.
+func isCancel(msg tea.KeyMsg) bool {
+	return msg.Type == tea.KeyEsc || msg.Type == tea.KeyCtrlC
+}
+
+type confirmModel struct {
+	prompt interfaces.ConfirmPrompt
+	value  bool
+	done   bool
+	cancel bool
+}
+
+func (m *confirmModel) Init() tea.Cmd { return nil }
+
+func (m *confirmModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	key, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+	switch {
+	case isCancel(key):
+		m.cancel = true
+		return m, tea.Quit
+	case key.Type == tea.KeyEnter:
+		m.done = true
+		return m, tea.Quit
+	case key.Type == tea.KeyLeft || key.Type == tea.KeyRight || key.Type == tea.KeyTab:
+		m.value = !m.value
+		return m, nil
+	}
+	switch strings.ToLower(key.String()) {
+	case "y":
+		m.value, m.done = true, true
+		return m, tea.Quit
+	case "n":
+		m.value, m.done = false, true
+		return m, tea.Quit
+	}
+	return m, nil
+}
+
+func (m *confirmModel) View() string {
+	if m.done {
+		return answered(m.prompt.Title, yesNo(m.value))
+	}
+	if m.cancel {
+		return ""
+	}
+	yes, no := ui.Theme.Muted.Render("Yes"), ui.Theme.Muted.Render("No")
+	if m.value {
+		yes = ui.Theme.Success.Render("› Yes")
+	} else {
+		no = ui.Theme.Warning.Render("› No")
+	}
+	return header(m.prompt.Title, m.prompt.Description) + yes + "  " + no + "\n"
+}
+
+func (m *confirmModel) canceled() bool { return m.cancel }
+
+func yesNo(b bool) string {
+	if b {
+		return "Yes"
+	}
+	return "No"
+}
+
+type inputModel struct {
+	prompt interfaces.InputPrompt
+	input  textinput.Model
+	value  string
+	err    error
+	done   bool
+	cancel bool
+}
+
+func newInputModel(prompt interfaces.InputPrompt) *inputModel {
+	input := textinput.New()
+	input.Placeholder = prompt.Placeholder
+	if input.Placeholder == "" {
+		input.Placeholder = prompt.Default
+	}
+	input.Focus()
+	return &inputModel{prompt: prompt, input: input}
+}
+
+func (m *inputModel) Init() tea.Cmd { return textinput.Blink }
+
+func (m *inputModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if key, ok := msg.(tea.KeyMsg); ok {
+		switch {
+		case isCancel(key):
+			m.cancel = true
+			return m, tea.Quit
+		case key.Type == tea.KeyEnter:
+			value := strings.TrimSpace(m.input.Value())
+			if value == "" {
+				value = m.prompt.Default
+			}
+			if m.prompt.Validate != nil {
+				if m.err = m.prompt.Validate(value); m.err != nil {
+					return m, nil
+				}
+			}
+			m.value, m.done = value, true
+			return m, tea.Quit
+		}
+	}
+	var cmd tea.Cmd
+	m.input, cmd = m.input.Update(msg)
+	return m, cmd
+}
+
+func (m *inputModel) View() string {
+	if m.done {
+		return answered(m.prompt.Title, m.value)
+	}
+	if m.cancel {
+		return ""
+	}
+	view := header(m.prompt.Title, m.prompt.Description) + m.input.View() + "\n"
+	if m.err != nil {
+		view += ui.Theme.Error.Render(m.err.Error()) + "\n"
+	}
+	return view
+}
+
+func (m *inputModel) canceled() bool { return m.cancel }
+
+type selectModel struct {
+	prompt interfaces.SelectPrompt
+	cursor int
+	done   bool
+	cancel bool
+}
+
+func (m *selectModel) Init() tea.Cmd { return nil }
+
+func (m *selectModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	key, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+	switch {
+	case isCancel(key):
+		m.cancel = true
+		return m, tea.Quit
+	case key.Type == tea.KeyEnter:
+		m.done = true
+		return m, tea.Quit
+	case key.Type == tea.KeyUp || key.String() == "k":
+		if m.cursor > 0 {
+			m.cursor--
+		}
+	case key.Type == tea.KeyDown || key.String() == "j":
+		if m.cursor < len(m.prompt.Options)-1 {
+			m.cursor++
+		}
+	}
+	return m, nil
+}
+
+func (m *selectModel) View() string {
+	if m.done {
+		return answered(m.prompt.Title, m.prompt.Options[m.cursor])
+	}
+	if m.cancel {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteString(header(m.prompt.Title, m.prompt.Description))
+	for i, option := range m.prompt.Options {
+		if i == m.cursor {
+			b.WriteString(ui.Theme.Success.Render("› " + option))
+		} else {
+			b.WriteString("  " + option)
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+func (m *selectModel) canceled() bool { return m.cancel }
+
+// NonInteractivePrompter answers prompts when the user cannot be asked:
+// with JSON or NDJSON output, in CI, or without a terminal. With --yes it
+// answers yes to every confirmation and the default to every other prompt;
+// without it, or for a prompt with no default, it fails fast with an error
+// wrapping interfaces.ErrPromptUnavailable that explains why.
+type NonInteractivePrompter struct {
+	assumeYes bool
+	reason    string
+}
+
+// NewNonInteractivePrompter creates a NonInteractivePrompter. reason says
+// why prompts are unavailable, e.g. "output is JSON", and may be empty when
+// assumeYes is set.
+func NewNonInteractivePrompter(assumeYes bool, reason string) *NonInteractivePrompter {
+	return &NonInteractivePrompter{assumeYes: assumeYes, reason: reason}
+}
+
+// Confirm answers yes with --yes, and fails otherwise.
+func (p *NonInteractivePrompter) Confirm(_ context.Context, prompt interfaces.ConfirmPrompt) (bool, error) {
+	if p.assumeYes {
+		return true, nil
+	}
+	return false, p.unavailable(prompt.Title)
+}
+
+// Input answers the default with --yes, and fails otherwise.
+func (p *NonInteractivePrompter) Input(_ context.Context, prompt interfaces.InputPrompt) (string, error) {
+	if !p.assumeYes || prompt.Default == "" {
+		return "", p.unavailable(prompt.Title)
+	}
+	if prompt.Validate != nil {
+		if err := prompt.Validate(prompt.Default); err != nil {
+			return "", fmt.Errorf("%s: default %q is invalid: %w", prompt.Title, prompt.Default, err)
+		}
+	}
+	return prompt.Default, nil
+}
+
+// Select answers the default with --yes, and fails otherwise.
+func (p *NonInteractivePrompter) Select(_ context.Context, prompt interfaces.SelectPrompt) (string, error) {
+	if !p.assumeYes || prompt.Default == "" {
+		return "", p.unavailable(prompt.Title)
+	}
+	return prompt.Default, nil
+}
+
+func (p *NonInteractivePrompter) unavailable(title string) error {
+	if p.assumeYes {
+		return fmt.Errorf("%w: %q has no default to assume with --yes", interfaces.ErrPromptUnavailable, title)
+	}
+	reason := p.reason
+	if reason == "" {
+		reason = "the terminal is not interactive"
+	}
+	return fmt.Errorf("%w: cannot ask %q because %s; rerun with --yes to proceed", interfaces.ErrPromptUnavailable, title, reason)
+}
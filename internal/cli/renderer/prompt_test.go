@@ -0,0 +1,169 @@
+package renderer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func key(s string) tea.KeyMsg {
+	switch s {
+	case "enter":
+		return tea.KeyMsg{Type: tea.KeyEnter}
+	case "esc":
+		return tea.KeyMsg{Type: tea.KeyEsc}
+	case "up":
+		return tea.KeyMsg{Type: tea.KeyUp}
+	case "down":
+		return tea.KeyMsg{Type: tea.KeyDown}
+	case "tab":
+		return tea.KeyMsg{Type: tea.KeyTab}
+	}
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)}
+}
+
+func send(m tea.Model, keys ...string) tea.Model {
+	for _, k := range keys {
+		m, _ = m.Update(key(k))
+	}
+	return m
+}
+
+func TestConfirmModel(t *testing.T) {
+	tests := []struct {
+		name   string
+		def    bool
+		keys   []string
+		want   bool
+		cancel bool
+	}{
+		{name: "y answers yes", keys: []string{"y"}, want: true},
+		{name: "N answers no", def: true, keys: []string{"N"}, want: false},
+		{name: "enter accepts default", def: true, keys: []string{"enter"}, want: true},
+		{name: "tab toggles", keys: []string{"tab", "enter"}, want: true},
+		{name: "esc cancels", keys: []string{"esc"}, cancel: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := send(&confirmModel{prompt: interfaces.ConfirmPrompt{Title: "Continue?"}, value: tt.def}, tt.keys...).(*confirmModel)
+			assert.Equal(t, tt.cancel, m.canceled())
+			if !tt.cancel {
+				assert.True(t, m.done)
+				assert.Equal(t, tt.want, m.value)
+				assert.Contains(t, m.View(), "Continue?")
+			}
+		})
+	}
+}
+
+func TestInputModel(t *testing.T) {
+	t.Run("typed value", func(t *testing.T) {
+		m := send(newInputModel(interfaces.InputPrompt{Title: "Name?"}), "m", "y", "a", "p", "p", "enter").(*inputModel)
+		assert.True(t, m.done)
+		assert.Equal(t, "myapp", m.value)
+	})
+
+	t.Run("empty answers default", func(t *testing.T) {
+		m := send(newInputModel(interfaces.InputPrompt{Title: "Name?", Default: "web"}), "enter").(*inputModel)
+		assert.Equal(t, "web", m.value)
+	})
+
+	t.Run("invalid answer asks again", func(t *testing.T) {
+		prompt := interfaces.InputPrompt{
+			Title: "Name?",
+			Validate: func(s string) error {
+				if s == "" {
+					return errors.New("name is required")
+				}
+				return nil
+			},
+		}
+		m := send(newInputModel(prompt), "enter").(*inputModel)
+		assert.False(t, m.done)
+		assert.Contains(t, m.View(), "name is required")
+
+		m = send(m, "x", "enter").(*inputModel)
+		assert.True(t, m.done)
+		assert.Equal(t, "x", m.value)
+	})
+}
+
+func TestSelectModel(t *testing.T) {
+	prompt := interfaces.SelectPrompt{Title: "Database?", Options: []string{"go-libsql", "sqlite3", "postgres"}}
+
+	m := send(&selectModel{prompt: prompt}, "down", "down", "down", "up", "enter").(*selectModel)
+	assert.True(t, m.done)
+	assert.Equal(t, "sqlite3", m.prompt.Options[m.cursor])
+
+	m = send(&selectModel{prompt: prompt}, "k", "enter").(*selectModel)
+	assert.Equal(t, 0, m.cursor, "the cursor should stop at the first option")
+}
+
+func TestTerminalPrompter(t *testing.T) {
+	ctx := context.Background()
+	out := new(bytes.Buffer)
+
+	ok, err := NewTerminalPrompter(strings.NewReader("y"), out).Confirm(ctx, interfaces.ConfirmPrompt{Title: "Reset?"})
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Contains(t, out.String(), "Reset?")
+
+	got, err := NewTerminalPrompter(strings.NewReader("\r"), out).Select(ctx, interfaces.SelectPrompt{
+		Title:   "Database?",
+		Options: []string{"go-libsql", "postgres"},
+		Default: "postgres",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "postgres", got)
+
+	_, err = NewTerminalPrompter(strings.NewReader("\x1b"), out).Input(ctx, interfaces.InputPrompt{Title: "Name?"})
+	assert.ErrorIs(t, err, interfaces.ErrPromptCanceled)
+}
+
+func TestNonInteractivePrompter(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("fails fast without --yes", func(t *testing.T) {
+		p := NewNonInteractivePrompter(false, "output is JSON")
+
+		_, err := p.Confirm(ctx, interfaces.ConfirmPrompt{Title: "Reset the database?"})
+		require.ErrorIs(t, err, interfaces.ErrPromptUnavailable)
+		assert.Contains(t, err.Error(), `"Reset the database?"`)
+		assert.Contains(t, err.Error(), "output is JSON")
+		assert.Contains(t, err.Error(), "--yes")
+
+		_, err = p.Input(ctx, interfaces.InputPrompt{Title: "Name?", Default: "web"})
+		assert.ErrorIs(t, err, interfaces.ErrPromptUnavailable)
+
+		_, err = p.Select(ctx, interfaces.SelectPrompt{Title: "Database?", Options: []string{"postgres"}, Default: "postgres"})
+		assert.ErrorIs(t, err, interfaces.ErrPromptUnavailable)
+	})
+
+	t.Run("assumes answers with --yes", func(t *testing.T) {
+		p := NewNonInteractivePrompter(true, "")
+
+		ok, err := p.Confirm(ctx, interfaces.ConfirmPrompt{Title: "Reset the database?"})
+		require.NoError(t, err)
+		assert.True(t, ok)
+
+		got, err := p.Input(ctx, interfaces.InputPrompt{Title: "Name?", Default: "web"})
+		require.NoError(t, err)
+		assert.Equal(t, "web", got)
+
+		got, err = p.Select(ctx, interfaces.SelectPrompt{Title: "Database?", Options: []string{"sqlite3", "postgres"}, Default: "postgres"})
+		require.NoError(t, err)
+		assert.Equal(t, "postgres", got)
+
+		_, err = p.Input(ctx, interfaces.InputPrompt{Title: "Module path?"})
+		require.ErrorIs(t, err, interfaces.ErrPromptUnavailable)
+		assert.Contains(t, err.Error(), "no default")
+	})
+}
@@ -0,0 +1,137 @@
+// Package prompttest provides a scripted interfaces.Prompter, so commands
+// that ask questions can be tested without a terminal.
+//
+// Example:
+//
+//	prompter := prompttest.NewPrompter()
+//	prompter.Answer("Reset the database?", true)
+//	prompter.Answer("Project name?", "myapp")
+//	cmd := commands.NewDBResetCommand(detector, newRenderer, flushRenderer,
+//	    func(*cobra.Command) interfaces.Prompter { return prompter })
+//	// prompter.Asked() lists every question the command asked
+package prompttest
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+)
+
+var _ interfaces.Prompter = (*Prompter)(nil)
+
+// Prompter records each prompt it is asked and replies with scripted
+// answers, matched by prompt title. A prompt without an answer fails with
+// interfaces.ErrPromptUnavailable, like a non-interactive terminal. It is
+// safe for concurrent use.
+type Prompter struct {
+	mu      sync.Mutex
+	asked   []string
+	answers map[string]answer
+}
+
+type answer struct {
+	value any
+	err   error
+}
+
+// NewPrompter creates a Prompter with no answers.
+func NewPrompter() *Prompter {
+	return &Prompter{answers: make(map[string]answer)}
+}
+
+// Answer replies value to the prompt titled title: a bool for Confirm, or a
+// string for Input and Select.
+func (p *Prompter) Answer(title string, value any) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.answers[title] = answer{value: value}
+}
+
+// Fail makes the prompt titled title return err, e.g.
+// interfaces.ErrPromptCanceled to simulate the user pressing Esc.
+func (p *Prompter) Fail(title string, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.answers[title] = answer{err: err}
+}
+
+// Asked returns the titles of the prompts asked so far, in order.
+func (p *Prompter) Asked() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]string(nil), p.asked...)
+}
+
+// Confirm replies the scripted bool.
+func (p *Prompter) Confirm(ctx context.Context, prompt interfaces.ConfirmPrompt) (bool, error) {
+	value, err := p.reply(ctx, prompt.Title)
+	if err != nil {
+		return false, err
+	}
+	b, ok := value.(bool)
+	if !ok {
+		return false, fmt.Errorf("prompttest: answer to confirm %q is %T, want bool", prompt.Title, value)
+	}
+	return b, nil
+}
+
+// Input replies the scripted string, checked with prompt.Validate. An
+// empty answer replies the default.
+func (p *Prompter) Input(ctx context.Context, prompt interfaces.InputPrompt) (string, error) {
+	s, err := p.replyString(ctx, "input", prompt.Title)
+	if err != nil {
+		return "", err
+	}
+	if s == "" {
+		s = prompt.Default
+	}
+	if prompt.Validate != nil {
+		if err := prompt.Validate(s); err != nil {
+			return "", fmt.Errorf("prompttest: answer %q to %q is invalid: %w", s, prompt.Title, err)
+		}
+	}
+	return s, nil
+}
+
+// Select replies the scripted string, which must be one of the options.
+func (p *Prompter) Select(ctx context.Context, prompt interfaces.SelectPrompt) (string, error) {
+	s, err := p.replyString(ctx, "select", prompt.Title)
+	if err != nil {
+		return "", err
+	}
+	if !slices.Contains(prompt.Options, s) {
+		return "", fmt.Errorf("prompttest: answer %q to %q is not one of %v", s, prompt.Title, prompt.Options)
+	}
+	return s, nil
+}
+
+func (p *Prompter) replyString(ctx context.Context, kind, title string) (string, error) {
+	value, err := p.reply(ctx, title)
+	if err != nil {
+		return "", err
+	}
+	s, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("prompttest: answer to %s %q is %T, want string", kind, title, value)
+	}
+	return s, nil
+}
+
+// reply records title and returns its scripted answer.
+func (p *Prompter) reply(ctx context.Context, title string) (any, error) {
+	p.mu.Lock()
+	p.asked = append(p.asked, title)
+	a, ok := p.answers[title]
+	p.mu.Unlock()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("%w: prompttest has no answer for %q", interfaces.ErrPromptUnavailable, title)
+	}
+	return a.value, a.err
+}
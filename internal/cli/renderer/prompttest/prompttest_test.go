@@ -0,0 +1,66 @@
+package prompttest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrompter_Answers(t *testing.T) {
+	ctx := context.Background()
+	p := NewPrompter()
+	p.Answer("Reset?", true)
+	p.Answer("Name?", "")
+	p.Answer("Database?", "postgres")
+
+	ok, err := p.Confirm(ctx, interfaces.ConfirmPrompt{Title: "Reset?"})
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	name, err := p.Input(ctx, interfaces.InputPrompt{Title: "Name?", Default: "web"})
+	require.NoError(t, err)
+	assert.Equal(t, "web", name, "an empty answer should reply the default")
+
+	db, err := p.Select(ctx, interfaces.SelectPrompt{Title: "Database?", Options: []string{"sqlite3", "postgres"}})
+	require.NoError(t, err)
+	assert.Equal(t, "postgres", db)
+
+	assert.Equal(t, []string{"Reset?", "Name?", "Database?"}, p.Asked())
+}
+
+func TestPrompter_Unanswered(t *testing.T) {
+	p := NewPrompter()
+
+	_, err := p.Confirm(context.Background(), interfaces.ConfirmPrompt{Title: "Reset?"})
+	assert.ErrorIs(t, err, interfaces.ErrPromptUnavailable)
+	assert.Equal(t, []string{"Reset?"}, p.Asked())
+}
+
+func TestPrompter_BadAnswers(t *testing.T) {
+	ctx := context.Background()
+	p := NewPrompter()
+	p.Answer("Reset?", "yes")
+	p.Answer("Database?", "mysql")
+	p.Answer("Name?", "My App")
+
+	_, err := p.Confirm(ctx, interfaces.ConfirmPrompt{Title: "Reset?"})
+	assert.ErrorContains(t, err, "want bool")
+
+	_, err = p.Select(ctx, interfaces.SelectPrompt{Title: "Database?", Options: []string{"postgres"}})
+	assert.ErrorContains(t, err, "not one of")
+
+	_, err = p.Input(ctx, interfaces.InputPrompt{Title: "Name?", Validate: func(string) error { return errors.New("no spaces") }})
+	assert.ErrorContains(t, err, "no spaces")
+}
+
+func TestPrompter_Fail(t *testing.T) {
+	p := NewPrompter()
+	p.Fail("Reset?", interfaces.ErrPromptCanceled)
+
+	_, err := p.Confirm(context.Background(), interfaces.ConfirmPrompt{Title: "Reset?"})
+	assert.ErrorIs(t, err, interfaces.ErrPromptCanceled)
+}
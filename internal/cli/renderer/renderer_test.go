@@ -181,11 +181,14 @@ func (m *mockRenderer) Title(s string)
 func (m *mockRenderer) Section(sec interfaces.Section)                             {}
 func (m *mockRenderer) Table(t interfaces.Table)                                   {}
 func (m *mockRenderer) Progress(spec interfaces.ProgressSpec) interfaces.Progress  { return &mockProgress{} }
+func (m *mockRenderer) Stream(source, line string)                                 {}
+func (m *mockRenderer) Result(res interfaces.Result)                               {}
 func (m *mockRenderer) Flush() error                                               { return nil }
 
 type mockProgress struct{}
 
 func (m *mockProgress) Increment(n int64) {}
+func (m *mockProgress) Status(s string)    {}
 func (m *mockProgress) Done()              {}
 
 func TestRendererInterface(t *testing.T) {
@@ -235,6 +238,10 @@ func TestProgressInterfaceMethods(t *testing.T) {
 		p.Increment(100)
 	})
 
+	t.Run("Status method exists", func(t *testing.T) {
+		p.Status("templates")
+	})
+
 	t.Run("Done method exists", func(t *testing.T) {
 		p.Done()
 	})
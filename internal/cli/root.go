@@ -2,6 +2,7 @@ package cli
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"runtime/debug"
@@ -10,9 +11,11 @@ import (
 	"github.com/anomalousventures/tracks/internal/cli/commands"
 	"github.com/anomalousventures/tracks/internal/cli/interfaces"
 	"github.com/anomalousventures/tracks/internal/cli/renderer"
+	"github.com/anomalousventures/tracks/internal/cli/tui"
 	"github.com/anomalousventures/tracks/internal/cli/ui"
 	trackscontext "github.com/anomalousventures/tracks/internal/context"
 	"github.com/anomalousventures/tracks/internal/generator"
+	"github.com/anomalousventures/tracks/internal/process"
 	"github.com/anomalousventures/tracks/internal/project"
 	"github.com/anomalousventures/tracks/internal/templui"
 	"github.com/anomalousventures/tracks/internal/validation"
@@ -26,13 +29,22 @@ type BuildInfo struct {
 	Date    string
 }
 
+// Output formats accepted by --output.
+const (
+	OutputConsole = "console"
+	OutputJSON    = "json"
+	OutputNDJSON  = "ndjson"
+)
+
 // Config holds the global CLI configuration.
 type Config struct {
 	JSON        bool
+	Output      string
 	NoColor     bool
 	Interactive bool
 	Verbose     bool
 	Quiet       bool
+	Yes         bool
 	LogLevel    string
 }
 
@@ -75,6 +87,9 @@ Generates idiomatic Go code you'd write yourself. No magic, full control.`,
   # Get JSON output for scripting
   tracks --json version
 
+  # Stream events as newline-delimited JSON
+  tracks --output ndjson new myapp
+
   # View help for any command
   tracks help new`,
 		Version: build.GetVersion(),
@@ -83,13 +98,22 @@ Generates idiomatic Go code you'd write yourself. No magic, full control.`,
 			if v.GetBool("verbose") && v.GetBool("quiet") {
 				return fmt.Errorf("--verbose and --quiet flags are mutually exclusive")
 			}
+			if err := validateOutput(v.GetString("output"), v.GetBool("json")); err != nil {
+				return err
+			}
+			if isMachineOutput(GetConfig(cmd)) {
+				// reportError writes command errors in the output format
+				// instead of cobra's plain-text message and usage.
+				cmd.Root().SilenceErrors = true
+				cmd.Root().SilenceUsage = true
+			}
 			return nil
 		},
 		Run: func(cmd *cobra.Command, args []string) {
 			r := NewRendererFromCommand(cmd)
 
 			r.Section(interfaces.Section{
-				Body: "Interactive TUI mode coming in Phase 4. Use --help for available commands.",
+				Body: "Run 'tracks new' to create a project; without a name it starts an interactive wizard. Use --help for available commands.",
 			})
 
 			FlushRenderer(cmd, r)
@@ -97,16 +121,21 @@ Generates idiomatic Go code you'd write yourself. No magic, full control.`,
 	}
 
 	rootCmd.PersistentFlags().Bool("json", false, "Output in JSON format (useful for scripting)")
+	rootCmd.PersistentFlags().String("output", "", "Output format: console, json or ndjson (default: auto-detect)")
 	rootCmd.PersistentFlags().Bool("no-color", false, "Disable color output (respects NO_COLOR env var)")
 	rootCmd.PersistentFlags().Bool("interactive", false, "Force interactive TUI mode even in non-TTY environments")
 	rootCmd.PersistentFlags().BoolP("verbose", "v", false, "Enable verbose output (shows detailed information)")
 	rootCmd.PersistentFlags().BoolP("quiet", "q", false, "Quiet mode (suppress non-error output)")
+	rootCmd.PersistentFlags().BoolP("yes", "y", false, "Answer yes to confirmations and accept defaults instead of prompting")
 
 	// Configure viper to read flags and environment variables
 	v := viper.New()
 	if err := v.BindPFlag("json", rootCmd.PersistentFlags().Lookup("json")); err != nil {
 		return nil, fmt.Errorf("failed to bind json flag: %w", err)
 	}
+	if err := v.BindPFlag("output", rootCmd.PersistentFlags().Lookup("output")); err != nil {
+		return nil, fmt.Errorf("failed to bind output flag: %w", err)
+	}
 	if err := v.BindPFlag("no-color", rootCmd.PersistentFlags().Lookup("no-color")); err != nil {
 		return nil, fmt.Errorf("failed to bind no-color flag: %w", err)
 	}
@@ -119,6 +148,9 @@ Generates idiomatic Go code you'd write yourself. No magic, full control.`,
 	if err := v.BindPFlag("quiet", rootCmd.PersistentFlags().Lookup("quiet")); err != nil {
 		return nil, fmt.Errorf("failed to bind quiet flag: %w", err)
 	}
+	if err := v.BindPFlag("yes", rootCmd.PersistentFlags().Lookup("yes")); err != nil {
+		return nil, fmt.Errorf("failed to bind yes flag: %w", err)
+	}
 
 	v.SetEnvPrefix("TRACKS")
 	v.SetEnvKeyReplacer(strings.NewReplacer("-", "_"))
@@ -138,7 +170,8 @@ Generates idiomatic Go code you'd write yourself. No magic, full control.`,
 	logger := NewLogger(logLevelStr)
 
 	validator := validation.NewValidator()
-	projectGenerator := generator.NewProjectGenerator()
+	runner := process.NewRunner()
+	projectGenerator := generator.NewProjectGenerator(runner)
 
 	ctx := WithViper(context.Background(), v)
 	ctx = trackscontext.WithLogger(ctx, logger)
@@ -147,17 +180,35 @@ Generates idiomatic Go code you'd write yourself. No magic, full control.`,
 	versionCmd := commands.NewVersionCommand(build, NewRendererFromCommand, FlushRenderer)
 	rootCmd.AddCommand(versionCmd.Command())
 
-	newCmd := commands.NewNewCommand(validator, projectGenerator, NewRendererFromCommand, FlushRenderer)
+	newCmd := commands.NewNewCommand(validator, projectGenerator, NewRendererFromCommand, FlushRenderer, NewPrompterFromCommand, newProjectWizardFactory(validator, projectGenerator))
 	rootCmd.AddCommand(newCmd.Command())
 
+	initCmd := commands.NewInitCommand(validator, generator.NewProjectInitializer(), NewRendererFromCommand, FlushRenderer, NewPrompterFromCommand)
+	rootCmd.AddCommand(initCmd.Command())
+
 	detector := project.NewDetector()
-	uiExecutor := templui.NewExecutor()
+	uiExecutor := templui.NewExecutor(runner)
 	uiCmd := commands.NewUICommand(detector, uiExecutor, NewRendererFromCommand, FlushRenderer)
 	rootCmd.AddCommand(uiCmd.Command())
 
-	dbCmd := commands.NewDBCommand(detector, NewRendererFromCommand, FlushRenderer)
+	dbCmd := commands.NewDBCommand(detector, NewRendererFromCommand, FlushRenderer, NewPrompterFromCommand)
 	rootCmd.AddCommand(dbCmd.Command())
 
+	resourceGenerator := generator.NewResourceGenerator(runner)
+	migrationGenerator := generator.NewMigrationGenerator()
+	ciGenerator := generator.NewCIGenerator()
+	generateCmd := commands.NewGenerateCommand(detector, resourceGenerator, migrationGenerator, ciGenerator, NewRendererFromCommand, FlushRenderer)
+	rootCmd.AddCommand(generateCmd.Command())
+
+	upgradeCmd := commands.NewUpgradeCommand(detector, generator.NewProjectUpgrader(), build, NewRendererFromCommand, FlushRenderer)
+	rootCmd.AddCommand(upgradeCmd.Command())
+
+	statusCmd := commands.NewStatusCommand(detector, generator.NewStatusChecker(), NewRendererFromCommand, FlushRenderer)
+	rootCmd.AddCommand(statusCmd.Command())
+
+	templatesCmd := commands.NewTemplatesCommand(detector, generator.NewTemplateManager(), NewRendererFromCommand, FlushRenderer)
+	rootCmd.AddCommand(templatesCmd.Command())
+
 	return rootCmd, nil
 }
 
@@ -179,7 +230,69 @@ func Execute(versionStr, commitStr, dateStr string) error {
 		// Return user-friendly error
 		return fmt.Errorf("failed to initialize tracks CLI - please report this issue at https://github.com/anomalousventures/tracks/issues")
 	}
-	return rootCmd.Execute()
+	cmd, err := rootCmd.ExecuteC()
+	if err != nil && reportError(cmd, err) {
+		return reportedError{err}
+	}
+	return err
+}
+
+// reportedError is an error Execute has already written in the selected
+// output format.
+type reportedError struct {
+	error
+}
+
+func (e reportedError) Unwrap() error {
+	return e.error
+}
+
+// IsReported reports whether Execute has already written err as JSON or
+// NDJSON, so the caller should exit without printing it again.
+func IsReported(err error) bool {
+	return errors.As(err, new(reportedError))
+}
+
+// validateOutput checks the --output flag. --json is shorthand for
+// --output json, so it conflicts with any other format.
+func validateOutput(output string, jsonFlag bool) error {
+	switch output {
+	case "", OutputConsole, OutputJSON, OutputNDJSON:
+	default:
+		return fmt.Errorf("invalid --output %q: must be %s, %s or %s", output, OutputConsole, OutputJSON, OutputNDJSON)
+	}
+	if jsonFlag && output != "" && output != OutputJSON {
+		return fmt.Errorf("--json and --output %s are mutually exclusive", output)
+	}
+	return nil
+}
+
+// isMachineOutput reports whether cfg selects JSON or NDJSON output.
+func isMachineOutput(cfg Config) bool {
+	return cfg.JSON || cfg.Output == OutputJSON || cfg.Output == OutputNDJSON
+}
+
+// reportError writes err with its error code in the machine-readable
+// output format, if one is selected, and reports whether it did. With
+// NDJSON the error is the last event on stdout. With JSON it is a separate
+// document on stderr, since the command has already written its own
+// document to stdout.
+func reportError(cmd *cobra.Command, err error) bool {
+	cfg := GetConfig(cmd)
+	if !isMachineOutput(cfg) {
+		return false
+	}
+
+	code := commands.ErrorCode(err)
+	if cfg.Output == OutputNDJSON {
+		renderer.NewNDJSONRenderer(cmd.OutOrStdout()).Error(code, err)
+		return true
+	}
+
+	r := renderer.NewJSONRenderer(cmd.ErrOrStderr())
+	r.Error(code, err)
+	_ = r.Flush()
+	return true
 }
 
 // GetViper extracts the Viper instance from the command's context.
@@ -197,10 +310,12 @@ func GetConfig(cmd *cobra.Command) Config {
 	v := GetViper(cmd)
 	return Config{
 		JSON:        v.GetBool("json"),
+		Output:      v.GetString("output"),
 		NoColor:     v.GetBool("no-color"),
 		Interactive: v.GetBool("interactive"),
 		Verbose:     v.GetBool("verbose"),
 		Quiet:       v.GetBool("quiet"),
+		Yes:         v.GetBool("yes"),
 		LogLevel:    v.GetString("log-level"),
 	}
 }
@@ -209,15 +324,26 @@ func GetConfig(cmd *cobra.Command) Config {
 func NewRendererFromCommand(cmd *cobra.Command) interfaces.Renderer {
 	cfg := GetConfig(cmd)
 
+	mode := ui.ModeAuto
+	if cfg.Output == OutputConsole {
+		mode = ui.ModeConsole
+	}
+
 	uiMode := ui.DetectMode(ui.UIConfig{
-		Mode:        ui.ModeAuto,
-		JSON:        cfg.JSON,
+		Mode:        mode,
+		JSON:        cfg.JSON || cfg.Output == OutputJSON,
+		NDJSON:      cfg.Output == OutputNDJSON,
 		NoColor:     cfg.NoColor,
 		Interactive: cfg.Interactive,
 	})
 
-	if uiMode == ui.ModeJSON {
+	switch uiMode {
+	case ui.ModeJSON:
 		return renderer.NewJSONRenderer(cmd.OutOrStdout())
+	case ui.ModeNDJSON:
+		r := renderer.NewNDJSONRenderer(cmd.OutOrStdout())
+		r.SetVerbose(cfg.Verbose && !cfg.Quiet)
+		return r
 	}
 
 	// Set NO_COLOR env var if --no-color flag is set, so Lip Gloss respects it
@@ -225,7 +351,49 @@ func NewRendererFromCommand(cmd *cobra.Command) interfaces.Renderer {
 		os.Setenv("NO_COLOR", "1")
 	}
 
-	return renderer.NewConsoleRenderer(cmd.OutOrStdout())
+	r := renderer.NewConsoleRenderer(cmd.OutOrStdout())
+	r.SetVerbose(cfg.Verbose && !cfg.Quiet)
+	return r
+}
+
+// NewPrompterFromCommand creates a prompter based on command configuration.
+// Prompts are interactive only when the user can answer them: --yes, JSON
+// output, CI and a missing terminal all select a prompter that answers for
+// the user or fails with the reason.
+func NewPrompterFromCommand(cmd *cobra.Command) interfaces.Prompter {
+	cfg := GetConfig(cmd)
+	if cfg.Yes {
+		return renderer.NewNonInteractivePrompter(true, "")
+	}
+
+	if ok, reason := canPrompt(cfg); !ok {
+		return renderer.NewNonInteractivePrompter(false, reason)
+	}
+	return renderer.NewTerminalPrompter(cmd.InOrStdin(), cmd.OutOrStdout())
+}
+
+// newProjectWizardFactory returns the factory of the 'tracks new' wizard,
+// which is available when the user can be prompted and --yes is not set.
+func newProjectWizardFactory(validator interfaces.Validator, gen interfaces.ProjectGenerator) commands.ProjectWizardFactory {
+	return func(cmd *cobra.Command) (interfaces.ProjectWizard, error) {
+		cfg := GetConfig(cmd)
+		if cfg.Yes {
+			return nil, fmt.Errorf("%w: cannot start the project wizard with --yes", interfaces.ErrPromptUnavailable)
+		}
+		if ok, reason := canPrompt(cfg); !ok {
+			return nil, fmt.Errorf("%w: cannot start the project wizard because %s", interfaces.ErrPromptUnavailable, reason)
+		}
+		return tui.NewProjectWizard(validator, gen, cmd.InOrStdin(), cmd.OutOrStdout()), nil
+	}
+}
+
+// canPrompt reports whether cfg allows prompting the user, or why not.
+func canPrompt(cfg Config) (bool, string) {
+	return ui.CanPrompt(ui.UIConfig{
+		JSON:        cfg.JSON || cfg.Output == OutputJSON,
+		NDJSON:      cfg.Output == OutputNDJSON,
+		Interactive: cfg.Interactive,
+	})
 }
 
 // FlushRenderer flushes the renderer and handles errors by writing to stderr and exiting.
@@ -3,11 +3,13 @@ package cli
 import (
 	"bytes"
 	"context"
+	"errors"
 	"io"
 	"os"
 	"strings"
 	"testing"
 
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
 	"github.com/anomalousventures/tracks/internal/cli/renderer"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -55,10 +57,12 @@ func TestGlobalFlagsExist(t *testing.T) {
 		flagName string
 	}{
 		{"json flag exists", "json"},
+		{"output flag exists", "output"},
 		{"no-color flag exists", "no-color"},
 		{"interactive flag exists", "interactive"},
 		{"verbose flag exists", "verbose"},
 		{"quiet flag exists", "quiet"},
+		{"yes flag exists", "yes"},
 	}
 
 	for _, tt := range tests {
@@ -710,7 +714,7 @@ func TestRootCommandWithoutArgs(t *testing.T) {
 	}
 
 	output := buf.String()
-	expectedMessage := "Interactive TUI mode coming in Phase 4. Use --help for available commands."
+	expectedMessage := "Run 'tracks new' to create a project"
 	if !strings.Contains(output, expectedMessage) {
 		t.Errorf("root command without args output = %q, want to contain %q", output, expectedMessage)
 	}
@@ -742,7 +746,7 @@ func TestRootCommandHelpStillWorks(t *testing.T) {
 		t.Error("help output should contain 'Flags:'")
 	}
 
-	placeholderMessage := "Interactive TUI mode coming in Phase 4"
+	placeholderMessage := "Run 'tracks new' to create a project"
 	if strings.Contains(output, placeholderMessage) {
 		t.Error("help output should NOT contain the placeholder message")
 	}
@@ -1093,3 +1097,238 @@ func TestNewRendererFromCommand(t *testing.T) {
 		})
 	}
 }
+
+func TestNewRendererFromCommand_Output(t *testing.T) {
+	tests := []struct {
+		name       string
+		setupViper func(*viper.Viper)
+		want       string
+	}{
+		{
+			name:       "output json returns json renderer",
+			setupViper: func(v *viper.Viper) { v.Set("output", "json") },
+			want:       "json",
+		},
+		{
+			name:       "output ndjson returns ndjson renderer",
+			setupViper: func(v *viper.Viper) { v.Set("output", "ndjson") },
+			want:       "ndjson",
+		},
+		{
+			name:       "output console returns console renderer",
+			setupViper: func(v *viper.Viper) { v.Set("output", "console") },
+			want:       "console",
+		},
+		{
+			name: "output ndjson with interactive returns ndjson renderer",
+			setupViper: func(v *viper.Viper) {
+				v.Set("output", "ndjson")
+				v.Set("interactive", true)
+			},
+			want: "ndjson",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := setupTestCommand(t, tt.setupViper)
+			r := NewRendererFromCommand(cmd)
+
+			var got string
+			switch r.(type) {
+			case *renderer.JSONRenderer:
+				got = "json"
+			case *renderer.NDJSONRenderer:
+				got = "ndjson"
+			case *renderer.ConsoleRenderer:
+				got = "console"
+			default:
+				t.Fatalf("unexpected renderer type: %T", r)
+			}
+			if got != tt.want {
+				t.Errorf("got %s renderer, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewPrompterFromCommand(t *testing.T) {
+	tests := []struct {
+		name       string
+		setupViper func(*viper.Viper)
+		want       string
+	}{
+		{
+			name:       "yes assumes answers",
+			setupViper: func(v *viper.Viper) { v.Set("yes", true) },
+			want:       "assume yes",
+		},
+		{
+			name: "yes beats interactive",
+			setupViper: func(v *viper.Viper) {
+				v.Set("yes", true)
+				v.Set("interactive", true)
+			},
+			want: "assume yes",
+		},
+		{
+			name:       "json output cannot prompt",
+			setupViper: func(v *viper.Viper) { v.Set("output", "json") },
+			want:       "output is JSON",
+		},
+		{
+			name:       "interactive forces terminal prompts",
+			setupViper: func(v *viper.Viper) { v.Set("interactive", true) },
+			want:       "terminal",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := setupTestCommand(t, tt.setupViper)
+			p := NewPrompterFromCommand(cmd)
+
+			if _, ok := p.(*renderer.TerminalPrompter); ok {
+				if tt.want != "terminal" {
+					t.Fatalf("got terminal prompter, want %s", tt.want)
+				}
+				return
+			}
+			ok, err := p.Confirm(context.Background(), interfaces.ConfirmPrompt{Title: "Continue?"})
+			switch {
+			case tt.want == "assume yes":
+				if err != nil || !ok {
+					t.Errorf("Confirm() = %v, %v, want true", ok, err)
+				}
+			case err == nil || !strings.Contains(err.Error(), tt.want):
+				t.Errorf("Confirm() error = %v, want it to mention %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateOutput(t *testing.T) {
+	tests := []struct {
+		name     string
+		output   string
+		jsonFlag bool
+		wantErr  string
+	}{
+		{name: "unset", output: ""},
+		{name: "console", output: "console"},
+		{name: "json", output: "json"},
+		{name: "ndjson", output: "ndjson"},
+		{name: "json flag alone", jsonFlag: true},
+		{name: "json flag with output json", output: "json", jsonFlag: true},
+		{name: "unknown format", output: "yaml", wantErr: "invalid --output"},
+		{name: "json flag with ndjson", output: "ndjson", jsonFlag: true, wantErr: "mutually exclusive"},
+		{name: "json flag with console", output: "console", jsonFlag: true, wantErr: "mutually exclusive"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateOutput(tt.output, tt.jsonFlag)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("validateOutput() error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("validateOutput() error = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestInvalidOutputFlag(t *testing.T) {
+	rootCmd := newTestRootCmd(t)
+	rootCmd.SetOut(io.Discard)
+	rootCmd.SetErr(io.Discard)
+	rootCmd.SetArgs([]string{"--output", "yaml", "version"})
+
+	err := rootCmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "invalid --output") {
+		t.Errorf("expected invalid --output error, got: %v", err)
+	}
+}
+
+func TestReportError(t *testing.T) {
+	t.Run("writes error event with ndjson output", func(t *testing.T) {
+		cmd := setupTestCommand(t, func(v *viper.Viper) { v.Set("output", "ndjson") })
+		var buf bytes.Buffer
+		cmd.SetOut(&buf)
+
+		if !reportError(cmd, errors.New("migration failed")) {
+			t.Error("reportError() = false, want true")
+		}
+
+		want := `{"version":1,"type":"error","error":{"code":"unknown","message":"migration failed"}}` + "\n"
+		if buf.String() != want {
+			t.Errorf("got %q, want %q", buf.String(), want)
+		}
+	})
+
+	t.Run("writes error document to stderr with json output", func(t *testing.T) {
+		cmd := setupTestCommand(t, func(v *viper.Viper) { v.Set("json", true) })
+		var stdout, stderr bytes.Buffer
+		cmd.SetOut(&stdout)
+		cmd.SetErr(&stderr)
+
+		if !reportError(cmd, errors.New("migration failed")) {
+			t.Error("reportError() = false, want true")
+		}
+
+		if stdout.Len() != 0 {
+			t.Errorf("expected nothing on stdout, got %q", stdout.String())
+		}
+		for _, want := range []string{`"schema_version": 1`, `"code": "unknown"`, `"message": "migration failed"`} {
+			if !strings.Contains(stderr.String(), want) {
+				t.Errorf("expected %s in %s", want, stderr.String())
+			}
+		}
+	})
+
+	t.Run("writes nothing with console output", func(t *testing.T) {
+		cmd := setupTestCommand(t, func(v *viper.Viper) {})
+		var stdout, stderr bytes.Buffer
+		cmd.SetOut(&stdout)
+		cmd.SetErr(&stderr)
+
+		if reportError(cmd, errors.New("migration failed")) {
+			t.Error("reportError() = true, want false")
+		}
+
+		if stdout.Len() != 0 || stderr.Len() != 0 {
+			t.Errorf("expected no output, got %q and %q", stdout.String(), stderr.String())
+		}
+	})
+}
+
+func TestIsReported(t *testing.T) {
+	err := errors.New("boom")
+	if IsReported(err) {
+		t.Error("IsReported() = true for a plain error")
+	}
+	if !IsReported(reportedError{err}) {
+		t.Error("IsReported() = false for a reported error")
+	}
+	if !errors.Is(reportedError{err}, err) {
+		t.Error("reportedError should unwrap to the command's error")
+	}
+}
+
+func TestMachineOutputSilencesCobraErrors(t *testing.T) {
+	rootCmd := newTestRootCmd(t)
+	var stderr bytes.Buffer
+	rootCmd.SetOut(io.Discard)
+	rootCmd.SetErr(&stderr)
+	rootCmd.SetArgs([]string{"--output", "ndjson", "status"})
+
+	if err := rootCmd.Execute(); err == nil {
+		t.Fatal("expected status to fail outside a project")
+	}
+	if stderr.Len() != 0 {
+		t.Errorf("expected cobra to print nothing, got %q", stderr.String())
+	}
+}
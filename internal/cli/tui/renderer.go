@@ -0,0 +1,101 @@
+package tui
+
+import (
+	"bytes"
+	"sync/atomic"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	"github.com/anomalousventures/tracks/internal/cli/renderer"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+var _ interfaces.Renderer = (*wizardRenderer)(nil)
+
+// logMsg is console-formatted output of a Title, Section or Table call.
+type logMsg struct {
+	text string
+}
+
+// streamMsg is a line of external command output.
+type streamMsg struct {
+	source, line string
+}
+
+// progressStartMsg adds a progress bar. Bars are numbered in the order
+// they start.
+type progressStartMsg struct {
+	spec interfaces.ProgressSpec
+}
+
+// progressMsg updates progress bar id.
+type progressMsg struct {
+	id        int
+	increment int64
+	status    string
+	done      bool
+}
+
+// wizardRenderer renders a generation into the wizard by sending each call
+// to the running program as a message. It is safe for concurrent use.
+type wizardRenderer struct {
+	send     func(tea.Msg)
+	progress atomic.Int64
+}
+
+func newWizardRenderer(send func(tea.Msg)) *wizardRenderer {
+	return &wizardRenderer{send: send}
+}
+
+// console formats output the way the console renderer would.
+func (r *wizardRenderer) console(render func(*renderer.ConsoleRenderer)) {
+	var buf bytes.Buffer
+	render(renderer.NewConsoleRenderer(&buf))
+	r.send(logMsg{text: buf.String()})
+}
+
+func (r *wizardRenderer) Title(s string) {
+	r.console(func(c *renderer.ConsoleRenderer) { c.Title(s) })
+}
+
+func (r *wizardRenderer) Section(sec interfaces.Section) {
+	r.console(func(c *renderer.ConsoleRenderer) { c.Section(sec) })
+}
+
+func (r *wizardRenderer) Table(t interfaces.Table) {
+	r.console(func(c *renderer.ConsoleRenderer) { c.Table(t) })
+}
+
+func (r *wizardRenderer) Progress(spec interfaces.ProgressSpec) interfaces.Progress {
+	id := int(r.progress.Add(1) - 1)
+	r.send(progressStartMsg{spec: spec})
+	return &wizardProgress{id: id, send: r.send}
+}
+
+func (r *wizardRenderer) Stream(source, line string) {
+	r.send(streamMsg{source: source, line: line})
+}
+
+// Result is a no-op: the wizard shows what the console renderer would.
+func (r *wizardRenderer) Result(interfaces.Result) {}
+
+func (r *wizardRenderer) Flush() error {
+	return nil
+}
+
+// wizardProgress is a progress bar drawn by the wizard.
+type wizardProgress struct {
+	id   int
+	send func(tea.Msg)
+}
+
+func (p *wizardProgress) Increment(n int64) {
+	p.send(progressMsg{id: p.id, increment: n})
+}
+
+func (p *wizardProgress) Status(s string) {
+	p.send(progressMsg{id: p.id, status: s})
+}
+
+func (p *wizardProgress) Done() {
+	p.send(progressMsg{id: p.id, done: true})
+}
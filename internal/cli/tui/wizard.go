@@ -0,0 +1,627 @@
+// Package tui implements the interactive Bubble Tea screens of the CLI.
+package tui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	"github.com/anomalousventures/tracks/internal/cli/ui"
+	"github.com/charmbracelet/bubbles/progress"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+var _ interfaces.ProjectWizard = (*ProjectWizard)(nil)
+
+// databaseDrivers are the drivers offered by the wizard, default first.
+var databaseDrivers = []string{"go-libsql", "sqlite3", "postgres"}
+
+// streamLines is how many lines of external command output the progress
+// view shows.
+const streamLines = 5
+
+// ProjectWizard is the 'tracks new' wizard. It asks for the project name,
+// module path, database driver, env prefix, git and starter kit, validating
+// each answer as it is typed, then shows a summary and the generation's
+// progress in the same screen.
+type ProjectWizard struct {
+	validator interfaces.Validator
+	generator interfaces.ProjectGenerator
+	in        io.Reader
+	out       io.Writer
+}
+
+// NewProjectWizard creates a ProjectWizard that validates answers with
+// validator, loads starter kits with generator, reads keys from in and
+// draws to out.
+func NewProjectWizard(validator interfaces.Validator, generator interfaces.ProjectGenerator, in io.Reader, out io.Writer) *ProjectWizard {
+	return &ProjectWizard{validator: validator, generator: generator, in: in, out: out}
+}
+
+// Run shows the wizard and, once the user confirms the summary, runs
+// generate. The output generate renders with Title, Section and Table is
+// written to out when the wizard exits, so it stays in the terminal.
+func (w *ProjectWizard) Run(ctx context.Context, opts interfaces.ProjectOptions, generate interfaces.GenerateFunc) (interfaces.ProjectOptions, error) {
+	m := newWizardModel(ctx, w.validator, w.generator, opts, generate)
+	program := tea.NewProgram(m,
+		tea.WithContext(ctx),
+		tea.WithInput(w.in),
+		tea.WithOutput(w.out),
+	)
+	m.send = program.Send
+
+	final, err := program.Run()
+	if err != nil {
+		if errors.Is(err, tea.ErrProgramKilled) && ctx.Err() != nil {
+			return opts, ctx.Err()
+		}
+		if errors.Is(err, tea.ErrInterrupted) {
+			return opts, interfaces.ErrPromptCanceled
+		}
+		return opts, fmt.Errorf("wizard failed: %w", err)
+	}
+
+	fm := final.(*wizardModel)
+	if fm.step != stepDone {
+		return fm.options(), interfaces.ErrPromptCanceled
+	}
+	if _, err := io.WriteString(w.out, fm.log.String()); err != nil {
+		return fm.options(), err
+	}
+	return fm.options(), fm.err
+}
+
+type wizardStep int
+
+const (
+	stepForm wizardStep = iota
+	stepSummary
+	stepGenerating
+	stepDone
+)
+
+type fieldKind int
+
+const (
+	inputField fieldKind = iota
+	selectField
+	confirmField
+)
+
+// field is one question of the wizard.
+type field struct {
+	key   string
+	title string
+	kind  fieldKind
+
+	// input holds the answer of an input field. An empty answer means
+	// def.
+	input textinput.Model
+	def   string
+
+	// options and cursor are the choices of a select field.
+	options []string
+	cursor  int
+
+	// yes is the answer of a confirm field.
+	yes bool
+
+	// validate checks an answer as it is typed.
+	validate func(string) error
+
+	// check runs once an answer is entered, for checks too slow or with
+	// too many side effects to run on every key.
+	check func(string) error
+
+	err error
+}
+
+func newInputField(key, title, value, def string, validate func(string) error) *field {
+	input := textinput.New()
+	input.SetValue(value)
+	input.Placeholder = def
+	return &field{key: key, title: title, kind: inputField, input: input, def: def, validate: validate}
+}
+
+// value returns the field's answer.
+func (f *field) value() string {
+	switch f.kind {
+	case selectField:
+		return f.options[f.cursor]
+	case confirmField:
+		return yesNo(f.yes)
+	}
+	if v := strings.TrimSpace(f.input.Value()); v != "" {
+		return v
+	}
+	return f.def
+}
+
+// wizardModel is the Bubble Tea model of the wizard.
+type wizardModel struct {
+	ctx       context.Context
+	validator interfaces.Validator
+	generator interfaces.ProjectGenerator
+	generate  interfaces.GenerateFunc
+
+	// send delivers messages from the generation's renderer.
+	send func(tea.Msg)
+
+	step   wizardStep
+	fields []*field
+	index  int
+
+	// base holds the options the wizard doesn't ask for.
+	base interfaces.ProjectOptions
+
+	// vars are starter kit variables set before the wizard, with --var.
+	vars map[string]string
+
+	// cancel stops a running generation; canceling is set once it has.
+	cancel    context.CancelFunc
+	canceling bool
+
+	// log collects what the generation renders, printed on exit.
+	log      strings.Builder
+	progress []*progressView
+	stream   []string
+	err      error
+}
+
+// progressView is a progress bar of the generation.
+type progressView struct {
+	label   string
+	status  string
+	total   int64
+	current int64
+	done    bool
+	bar     progress.Model
+}
+
+func newWizardModel(ctx context.Context, validator interfaces.Validator, generator interfaces.ProjectGenerator, opts interfaces.ProjectOptions, generate interfaces.GenerateFunc) *wizardModel {
+	m := &wizardModel{
+		ctx:       ctx,
+		validator: validator,
+		generator: generator,
+		generate:  generate,
+		send:      func(tea.Msg) {},
+		base:      opts,
+		vars:      maps.Clone(opts.StarterVars),
+	}
+
+	name := newInputField("name", "Project name", opts.Name, "", func(s string) error {
+		return validator.ValidateProjectName(ctx, s)
+	})
+	name.check = func(s string) error {
+		return validator.ValidateDirectory(ctx, filepath.Join(opts.OutputPath, s))
+	}
+
+	module := newInputField("module", "Go module path", opts.ModulePath, "", func(s string) error {
+		return validator.ValidateModulePath(ctx, s)
+	})
+
+	driver := &field{key: "db", title: "Database driver", kind: selectField, options: databaseDrivers}
+	driver.cursor = max(slices.Index(databaseDrivers, opts.DatabaseDriver), 0)
+	driver.check = func(s string) error {
+		return validator.ValidateDatabaseDriver(ctx, s)
+	}
+
+	envPrefix := opts.EnvPrefix
+	if envPrefix == "" {
+		envPrefix = "APP"
+	}
+	env := newInputField("env", "Environment variable prefix", envPrefix, "", func(s string) error {
+		return validator.ValidateEnvPrefix(ctx, s)
+	})
+
+	git := &field{key: "git", title: "Initialize a git repository?", kind: confirmField, yes: opts.InitGit}
+
+	starter := newInputField("starter", "Starter kit (directory or archive, blank for none)", opts.Starter, "", nil)
+	starter.check = m.loadStarter
+
+	m.fields = []*field{name, module, driver, env, git, starter}
+	m.focus()
+	return m
+}
+
+// loadStarter checks the starter kit source and adds a question for each of
+// its variables not set with --var, replacing those of a previous kit.
+func (m *wizardModel) loadStarter(source string) error {
+	fields := slices.DeleteFunc(m.fields, func(f *field) bool {
+		return strings.HasPrefix(f.key, "var:")
+	})
+	m.fields = fields
+	if source == "" {
+		return nil
+	}
+
+	kit, err := m.generator.LoadStarter(m.ctx, source)
+	if err != nil {
+		return err
+	}
+	for _, v := range kit.Variables {
+		if _, ok := m.vars[v.Name]; ok {
+			continue
+		}
+		title := v.Prompt
+		if title == "" {
+			title = v.Name
+		}
+		var validate func(string) error
+		if v.Required {
+			name := v.Name
+			validate = func(s string) error {
+				if s == "" {
+					return fmt.Errorf("%s is required", name)
+				}
+				return nil
+			}
+		}
+		m.fields = append(m.fields, newInputField("var:"+v.Name, title, "", v.Default, validate))
+	}
+	return nil
+}
+
+// options returns the answers as project options.
+func (m *wizardModel) options() interfaces.ProjectOptions {
+	opts := m.base
+	opts.StarterVars = maps.Clone(m.vars)
+	for _, f := range m.fields {
+		switch {
+		case f.key == "name":
+			opts.Name = f.value()
+		case f.key == "module":
+			opts.ModulePath = f.value()
+		case f.key == "db":
+			opts.DatabaseDriver = f.value()
+		case f.key == "env":
+			opts.EnvPrefix = f.value()
+		case f.key == "git":
+			opts.InitGit = f.yes
+		case f.key == "starter":
+			opts.Starter = f.value()
+		case strings.HasPrefix(f.key, "var:"):
+			if opts.StarterVars == nil {
+				opts.StarterVars = make(map[string]string)
+			}
+			opts.StarterVars[strings.TrimPrefix(f.key, "var:")] = f.value()
+		}
+	}
+	return opts
+}
+
+func (m *wizardModel) current() *field {
+	return m.fields[m.index]
+}
+
+// field returns the field with key.
+func (m *wizardModel) field(key string) *field {
+	i := slices.IndexFunc(m.fields, func(f *field) bool { return f.key == key })
+	return m.fields[i]
+}
+
+// focus gives the current field the cursor.
+func (m *wizardModel) focus() tea.Cmd {
+	for i, f := range m.fields {
+		if f.kind != inputField {
+			continue
+		}
+		if i == m.index {
+			return f.input.Focus()
+		}
+		f.input.Blur()
+	}
+	return nil
+}
+
+func (m *wizardModel) Init() tea.Cmd {
+	return textinput.Blink
+}
+
+func (m *wizardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if key, ok := msg.(tea.KeyMsg); ok && key.Type == tea.KeyCtrlC {
+		if m.step == stepGenerating {
+			m.cancel()
+			m.canceling = true
+			return m, nil
+		}
+		return m, tea.Quit
+	}
+
+	switch m.step {
+	case stepForm:
+		return m.updateForm(msg)
+	case stepSummary:
+		return m.updateSummary(msg)
+	case stepGenerating:
+		return m.updateGenerating(msg)
+	}
+	return m, nil
+}
+
+func (m *wizardModel) updateForm(msg tea.Msg) (tea.Model, tea.Cmd) {
+	f := m.current()
+	key, ok := msg.(tea.KeyMsg)
+	if !ok {
+		if f.kind == inputField {
+			var cmd tea.Cmd
+			f.input, cmd = f.input.Update(msg)
+			return m, cmd
+		}
+		return m, nil
+	}
+
+	switch key.Type {
+	case tea.KeyEsc:
+		return m, tea.Quit
+	case tea.KeyEnter:
+		return m, m.submit()
+	case tea.KeyShiftTab:
+		return m, m.back()
+	}
+
+	switch f.kind {
+	case selectField:
+		switch {
+		case key.Type == tea.KeyUp || key.String() == "k":
+			f.cursor = max(f.cursor-1, 0)
+		case key.Type == tea.KeyDown || key.String() == "j":
+			f.cursor = min(f.cursor+1, len(f.options)-1)
+		}
+	case confirmField:
+		switch {
+		case key.Type == tea.KeyLeft || key.Type == tea.KeyRight || key.Type == tea.KeyTab:
+			f.yes = !f.yes
+		case strings.EqualFold(key.String(), "y"):
+			f.yes = true
+			return m, m.submit()
+		case strings.EqualFold(key.String(), "n"):
+			f.yes = false
+			return m, m.submit()
+		}
+	case inputField:
+		var cmd tea.Cmd
+		f.input, cmd = f.input.Update(msg)
+		// Validate as the user types, but don't complain about an answer
+		// not started yet.
+		f.err = nil
+		if f.validate != nil && f.input.Value() != "" {
+			f.err = f.validate(f.value())
+		}
+		return m, cmd
+	}
+	return m, nil
+}
+
+// submit accepts the current answer if it is valid and moves to the next
+// question, or to the summary after the last one.
+func (m *wizardModel) submit() tea.Cmd {
+	f := m.current()
+	value := f.value()
+	f.err = nil
+	if f.validate != nil {
+		f.err = f.validate(value)
+	}
+	if f.err == nil && f.check != nil {
+		f.err = f.check(value)
+	}
+	if f.err != nil {
+		return nil
+	}
+
+	if f.key == "name" {
+		module := m.field("module")
+		module.def = "example.com/" + value
+		module.input.Placeholder = module.def
+	}
+
+	if m.index == len(m.fields)-1 {
+		m.step = stepSummary
+		return m.focus()
+	}
+	m.index++
+	return m.focus()
+}
+
+// back returns to the previous question.
+func (m *wizardModel) back() tea.Cmd {
+	if m.index > 0 {
+		m.index--
+	}
+	return m.focus()
+}
+
+func (m *wizardModel) updateSummary(msg tea.Msg) (tea.Model, tea.Cmd) {
+	key, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+	switch {
+	case key.Type == tea.KeyEnter || strings.EqualFold(key.String(), "y"):
+		return m, m.start()
+	case key.Type == tea.KeyShiftTab || key.String() == "b":
+		m.step = stepForm
+		return m, m.focus()
+	case key.Type == tea.KeyEsc || key.String() == "q":
+		return m, tea.Quit
+	}
+	return m, nil
+}
+
+// generateDoneMsg reports that generation finished.
+type generateDoneMsg struct {
+	err error
+}
+
+// start runs the generation in the background, rendering into the wizard.
+func (m *wizardModel) start() tea.Cmd {
+	m.step = stepGenerating
+	ctx, cancel := context.WithCancel(m.ctx)
+	m.cancel = cancel
+	opts := m.options()
+	r := newWizardRenderer(m.send)
+	return func() tea.Msg {
+		defer cancel()
+		return generateDoneMsg{err: m.generate(ctx, opts, r)}
+	}
+}
+
+func (m *wizardModel) updateGenerating(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case logMsg:
+		m.log.WriteString(msg.text)
+	case streamMsg:
+		m.stream = append(m.stream, fmt.Sprintf("[%s] %s", msg.source, msg.line))
+		if len(m.stream) > streamLines {
+			m.stream = m.stream[len(m.stream)-streamLines:]
+		}
+	case progressStartMsg:
+		m.progress = append(m.progress, &progressView{
+			label: msg.spec.Label,
+			total: msg.spec.Total,
+			bar:   progress.New(progress.WithScaledGradient("#7D56F4", "#04B575")),
+		})
+	case progressMsg:
+		if msg.id >= len(m.progress) {
+			return m, nil
+		}
+		p := m.progress[msg.id]
+		p.current = min(p.current+msg.increment, p.total)
+		if msg.status != "" {
+			p.status = msg.status
+		}
+		p.done = p.done || msg.done
+	case generateDoneMsg:
+		m.err = msg.err
+		m.step = stepDone
+		return m, tea.Quit
+	}
+	return m, nil
+}
+
+func (m *wizardModel) View() string {
+	var b strings.Builder
+	switch m.step {
+	case stepForm:
+		m.viewForm(&b)
+	case stepSummary:
+		m.viewSummary(&b)
+		b.WriteString(ui.Theme.Muted.Render("enter: create project • shift+tab: back • esc: quit"))
+		b.WriteString("\n")
+	case stepGenerating:
+		m.viewSummary(&b)
+		m.viewProgress(&b)
+	case stepDone:
+		m.viewSummary(&b)
+	}
+	return b.String()
+}
+
+func (m *wizardModel) viewForm(b *strings.Builder) {
+	b.WriteString(ui.Theme.Title.Render("Create a new Tracks application"))
+	b.WriteString("\n\n")
+	for _, f := range m.fields[:m.index] {
+		fmt.Fprintf(b, "%s %s\n", ui.Theme.Success.Render("✓"), ui.Theme.Muted.Render(f.title+": "+f.value()))
+	}
+
+	f := m.current()
+	fmt.Fprintf(b, "%s\n", ui.Theme.Title.Render(f.title))
+	switch f.kind {
+	case inputField:
+		b.WriteString(f.input.View())
+		b.WriteString("\n")
+	case selectField:
+		for i, option := range f.options {
+			if i == f.cursor {
+				b.WriteString(ui.Theme.Success.Render("› " + option))
+			} else {
+				b.WriteString("  " + option)
+			}
+			b.WriteString("\n")
+		}
+	case confirmField:
+		yes, no := ui.Theme.Muted.Render("Yes"), ui.Theme.Muted.Render("No")
+		if f.yes {
+			yes = ui.Theme.Success.Render("› Yes")
+		} else {
+			no = ui.Theme.Warning.Render("› No")
+		}
+		b.WriteString(yes + "  " + no + "\n")
+	}
+	if f.err != nil {
+		b.WriteString(ui.Theme.Error.Render(f.err.Error()))
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
+	b.WriteString(ui.Theme.Muted.Render("enter: next • shift+tab: back • esc: quit"))
+	b.WriteString("\n")
+}
+
+func (m *wizardModel) viewSummary(b *strings.Builder) {
+	b.WriteString(ui.Theme.Title.Render("New Tracks application"))
+	b.WriteString("\n")
+	width := 0
+	for _, f := range m.fields {
+		width = max(width, len(summaryLabel(f)))
+	}
+	for _, f := range m.fields {
+		fmt.Fprintf(b, "  %-*s  %s\n", width, summaryLabel(f), f.value())
+	}
+	b.WriteString("\n")
+}
+
+// summaryLabel is the short name of a field in the summary.
+func summaryLabel(f *field) string {
+	switch f.key {
+	case "name":
+		return "Name"
+	case "module":
+		return "Module"
+	case "db":
+		return "Database"
+	case "env":
+		return "Env prefix"
+	case "git":
+		return "Git"
+	case "starter":
+		return "Starter kit"
+	}
+	return strings.TrimPrefix(f.key, "var:")
+}
+
+func (m *wizardModel) viewProgress(b *strings.Builder) {
+	for _, p := range m.progress {
+		percent := 1.0
+		if p.total > 0 && !p.done {
+			percent = float64(p.current) / float64(p.total)
+		}
+		label := p.label
+		if p.status != "" && !p.done {
+			label += ": " + p.status
+		}
+		fmt.Fprintf(b, "%s %s\n", p.bar.ViewAs(percent), ui.Theme.Muted.Render(label))
+	}
+	for _, line := range m.stream {
+		fmt.Fprintf(b, "  %s\n", ui.Theme.Muted.Render(line))
+	}
+	if m.canceling {
+		b.WriteString(ui.Theme.Warning.Render("Canceling..."))
+	} else {
+		b.WriteString(ui.Theme.Muted.Render("ctrl+c: cancel"))
+	}
+	b.WriteString("\n")
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
@@ -0,0 +1,209 @@
+package tui
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	"github.com/anomalousventures/tracks/tests/mocks"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func key(s string) tea.KeyMsg {
+	switch s {
+	case "enter":
+		return tea.KeyMsg{Type: tea.KeyEnter}
+	case "esc":
+		return tea.KeyMsg{Type: tea.KeyEsc}
+	case "ctrl+c":
+		return tea.KeyMsg{Type: tea.KeyCtrlC}
+	case "shift+tab":
+		return tea.KeyMsg{Type: tea.KeyShiftTab}
+	case "down":
+		return tea.KeyMsg{Type: tea.KeyDown}
+	}
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)}
+}
+
+// send delivers keys to m, typing any key longer than one rune that is not
+// a named key one rune at a time.
+func send(m *wizardModel, keys ...string) tea.Cmd {
+	var cmd tea.Cmd
+	for _, k := range keys {
+		if k := key(k); k.Type == tea.KeyRunes && len(k.Runes) > 1 {
+			for _, r := range k.Runes {
+				_, cmd = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+			}
+			continue
+		}
+		_, cmd = m.Update(key(k))
+	}
+	return cmd
+}
+
+// newTestValidator accepts everything except project names containing
+// "bad".
+func newTestValidator(t *testing.T) *mocks.MockValidator {
+	v := mocks.NewMockValidator(t)
+	v.On("ValidateProjectName", mock.Anything, mock.MatchedBy(func(s string) bool {
+		return strings.Contains(s, "bad")
+	})).Return(errors.New("project name is invalid")).Maybe()
+	v.On("ValidateProjectName", mock.Anything, mock.Anything).Return(nil).Maybe()
+	v.On("ValidateDirectory", mock.Anything, mock.Anything).Return(nil).Maybe()
+	v.On("ValidateModulePath", mock.Anything, mock.Anything).Return(nil).Maybe()
+	v.On("ValidateDatabaseDriver", mock.Anything, mock.Anything).Return(nil).Maybe()
+	v.On("ValidateEnvPrefix", mock.Anything, mock.Anything).Return(nil).Maybe()
+	return v
+}
+
+func TestWizardModel_Form(t *testing.T) {
+	ctx := context.Background()
+	m := newWizardModel(ctx, newTestValidator(t), mocks.NewMockProjectGenerator(t),
+		interfaces.ProjectOptions{InitGit: true}, nil)
+
+	send(m, "bad")
+	assert.Contains(t, m.View(), "project name is invalid", "the name should be validated as it is typed")
+
+	send(m, "enter")
+	assert.Equal(t, "name", m.current().key, "an invalid name should not be accepted")
+
+	m.current().input.SetValue("")
+	send(m, "webapp", "enter")
+	require.Equal(t, "module", m.current().key)
+	assert.Equal(t, "example.com/webapp", m.current().value(), "the module path should default from the name")
+
+	send(m, "enter", "down", "down", "enter")
+	assert.Equal(t, "postgres", m.field("db").value())
+
+	send(m, "shift+tab")
+	assert.Equal(t, "db", m.current().key)
+	send(m, "enter", "enter", "n", "enter")
+	assert.Equal(t, stepSummary, m.step)
+
+	view := m.View()
+	assert.Contains(t, view, "example.com/webapp")
+	assert.Contains(t, view, "postgres")
+
+	assert.Equal(t, interfaces.ProjectOptions{
+		Name:           "webapp",
+		ModulePath:     "example.com/webapp",
+		DatabaseDriver: "postgres",
+		EnvPrefix:      "APP",
+	}, m.options())
+}
+
+func TestWizardModel_StarterVars(t *testing.T) {
+	ctx := context.Background()
+	gen := mocks.NewMockProjectGenerator(t)
+	gen.On("LoadStarter", mock.Anything, "./kit").Return(&interfaces.StarterKit{
+		Name: "kit",
+		Variables: []interfaces.StarterVariable{
+			{Name: "Brand", Prompt: "Brand name", Required: true},
+			{Name: "Color", Default: "blue"},
+			{Name: "Owner"},
+		},
+	}, nil).Once()
+
+	m := newWizardModel(ctx, newTestValidator(t), gen, interfaces.ProjectOptions{
+		Name:        "webapp",
+		StarterVars: map[string]string{"Owner": "ops"},
+	}, nil)
+	send(m, "enter", "enter", "enter", "enter", "n", "./kit", "enter")
+
+	require.Equal(t, "var:Brand", m.current().key, "each unset kit variable should be asked")
+	send(m, "enter")
+	assert.Contains(t, m.View(), "Brand is required")
+
+	send(m, "Acme", "enter", "enter")
+	assert.Equal(t, stepSummary, m.step)
+	assert.Equal(t, map[string]string{"Brand": "Acme", "Color": "blue", "Owner": "ops"}, m.options().StarterVars)
+}
+
+func TestWizardModel_Generate(t *testing.T) {
+	ctx := context.Background()
+	var got interfaces.ProjectOptions
+	generate := func(_ context.Context, opts interfaces.ProjectOptions, r interfaces.Renderer) error {
+		got = opts
+		r.Title("Creating webapp")
+		return errors.New("boom")
+	}
+
+	m := newWizardModel(ctx, newTestValidator(t), mocks.NewMockProjectGenerator(t),
+		interfaces.ProjectOptions{Name: "webapp", EnvPrefix: "WEB"}, generate)
+	var msgs []tea.Msg
+	m.send = func(msg tea.Msg) { msgs = append(msgs, msg) }
+
+	send(m, "enter", "enter", "enter", "enter", "y", "enter")
+	require.Equal(t, stepSummary, m.step)
+
+	cmd := send(m, "enter")
+	require.Equal(t, stepGenerating, m.step)
+	done := cmd()
+
+	assert.Equal(t, "WEB", got.EnvPrefix)
+	assert.True(t, got.InitGit)
+	for _, msg := range msgs {
+		m.Update(msg)
+	}
+	m.Update(progressStartMsg{spec: interfaces.ProgressSpec{Label: "Generating files", Total: 2}})
+	m.Update(progressMsg{id: 0, increment: 1, status: "go.mod"})
+	m.Update(streamMsg{source: "go", line: "downloading"})
+	view := m.View()
+	assert.Contains(t, view, "Generating files: go.mod")
+	assert.Contains(t, view, "[go] downloading")
+	assert.Contains(t, view, "ctrl+c: cancel")
+
+	m.Update(done)
+	assert.Equal(t, stepDone, m.step)
+	assert.Contains(t, m.log.String(), "Creating webapp")
+	assert.EqualError(t, m.err, "boom")
+}
+
+func TestWizardModel_CancelGenerate(t *testing.T) {
+	generate := func(ctx context.Context, _ interfaces.ProjectOptions, _ interfaces.Renderer) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	m := newWizardModel(context.Background(), newTestValidator(t), mocks.NewMockProjectGenerator(t),
+		interfaces.ProjectOptions{Name: "webapp"}, generate)
+
+	send(m, "enter", "enter", "enter", "enter", "n", "enter")
+	cmd := send(m, "enter")
+	send(m, "ctrl+c")
+	assert.Contains(t, m.View(), "Canceling...")
+
+	m.Update(cmd())
+	assert.Equal(t, stepDone, m.step)
+	assert.ErrorIs(t, m.err, context.Canceled)
+}
+
+func TestProjectWizard_Run(t *testing.T) {
+	t.Run("quit before confirming", func(t *testing.T) {
+		w := NewProjectWizard(newTestValidator(t), mocks.NewMockProjectGenerator(t), strings.NewReader("\x1b"), new(bytes.Buffer))
+		_, err := w.Run(context.Background(), interfaces.ProjectOptions{}, nil)
+		assert.ErrorIs(t, err, interfaces.ErrPromptCanceled)
+	})
+
+	t.Run("confirm and generate", func(t *testing.T) {
+		out := new(bytes.Buffer)
+		in := strings.NewReader("webapp\r\r\r\ry\r\r")
+		w := NewProjectWizard(newTestValidator(t), mocks.NewMockProjectGenerator(t), in, out)
+
+		generated := false
+		opts, err := w.Run(context.Background(), interfaces.ProjectOptions{}, func(_ context.Context, opts interfaces.ProjectOptions, r interfaces.Renderer) error {
+			generated = true
+			r.Title("Creating new Tracks application: " + opts.Name)
+			return nil
+		})
+		require.NoError(t, err)
+		assert.True(t, generated)
+		assert.Equal(t, "webapp", opts.Name)
+		assert.Contains(t, out.String(), "Creating new Tracks application: webapp")
+	})
+}
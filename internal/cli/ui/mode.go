@@ -23,9 +23,14 @@ const (
 	// and automation.
 	ModeJSON
 
-	// ModeTUI launches interactive Terminal UI using Bubble Tea
-	// (coming in Phase 4).
+	// ModeTUI is forced with --interactive: prompts and the 'tracks new'
+	// wizard run even without a terminal. Output renders as in console
+	// mode.
 	ModeTUI
+
+	// ModeNDJSON renders output as a stream of newline-delimited JSON
+	// events, written as they happen, for editors and long-running scripts.
+	ModeNDJSON
 )
 
 // String returns the string representation of UIMode.
@@ -39,6 +44,8 @@ func (m UIMode) String() string {
 		return "json"
 	case ModeTUI:
 		return "tui"
+	case ModeNDJSON:
+		return "ndjson"
 	default:
 		return "unknown"
 	}
@@ -56,6 +63,10 @@ type UIConfig struct {
 	// Takes highest precedence in mode detection.
 	JSON bool
 
+	// NDJSON enables the newline-delimited JSON event stream.
+	// Takes precedence over JSON.
+	NDJSON bool
+
 	// Interactive forces interactive TUI mode even in non-TTY environments.
 	// Takes precedence over auto-detection but lower than JSON.
 	Interactive bool
@@ -73,18 +84,22 @@ var defaultTTYDetector ttyDetector = isatty.IsTerminal
 
 // DetectMode determines the appropriate UI mode based on configuration and environment.
 // Detection priority (highest to lowest):
-//  1. JSON set → returns ModeJSON (for scripting/automation)
-//  2. Interactive set → returns ModeTUI (force interactive)
-//  3. cfg.Mode (if not ModeAuto) → returns explicitly set mode
-//  4. NO_COLOR, CI environment, or non-TTY → returns ModeConsole
-//  5. Default → returns ModeConsole (TUI coming in Phase 4)
+//  1. NDJSON set → returns ModeNDJSON (for editors and live progress)
+//  2. JSON set → returns ModeJSON (for scripting/automation)
+//  3. Interactive set → returns ModeTUI (force interactive)
+//  4. cfg.Mode (if not ModeAuto) → returns explicitly set mode
+//  5. NO_COLOR, CI environment, or non-TTY → returns ModeConsole
+//  6. Default → returns ModeConsole
 func DetectMode(cfg UIConfig) UIMode {
 	return detectModeWithTTY(cfg, defaultTTYDetector)
 }
 
 // detectModeWithTTY is an internal helper that allows TTY detection to be mocked for testing.
 func detectModeWithTTY(cfg UIConfig, isTTY ttyDetector) UIMode {
-	// Highest priority: JSON output mode
+	// Highest priority: machine-readable output modes
+	if cfg.NDJSON {
+		return ModeNDJSON
+	}
 	if cfg.JSON {
 		return ModeJSON
 	}
@@ -103,6 +118,36 @@ func detectModeWithTTY(cfg UIConfig, isTTY ttyDetector) UIMode {
 		return ModeConsole
 	}
 
-	// Default to console mode (TUI coming in Phase 4)
+	// Default to console mode; CanPrompt decides separately whether prompts
+	// and the wizard are interactive
 	return ModeConsole
 }
+
+// CanPrompt reports whether the CLI can ask the user questions. When it
+// cannot, reason explains why, for error messages.
+// Detection priority (highest to lowest):
+//  1. NDJSON or JSON set → no prompts (output is for programs)
+//  2. Interactive set → prompts (force interactive)
+//  3. CI environment, or stdin or stdout not a TTY → no prompts
+func CanPrompt(cfg UIConfig) (ok bool, reason string) {
+	return canPromptWithTTY(cfg, defaultTTYDetector)
+}
+
+// canPromptWithTTY is an internal helper that allows TTY detection to be mocked for testing.
+func canPromptWithTTY(cfg UIConfig, isTTY ttyDetector) (bool, string) {
+	switch {
+	case cfg.NDJSON:
+		return false, "output is NDJSON"
+	case cfg.JSON:
+		return false, "output is JSON"
+	case cfg.Interactive:
+		return true, ""
+	case os.Getenv("CI") != "":
+		return false, "running in CI"
+	case !isTTY(os.Stdin.Fd()):
+		return false, "stdin is not a terminal"
+	case !isTTY(os.Stdout.Fd()):
+		return false, "stdout is not a terminal"
+	}
+	return true, ""
+}
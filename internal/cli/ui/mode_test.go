@@ -1,6 +1,9 @@
 package ui
 
-import "testing"
+import (
+	"os"
+	"testing"
+)
 
 func TestUIModeConstants(t *testing.T) {
 	tests := []struct {
@@ -12,6 +15,7 @@ func TestUIModeConstants(t *testing.T) {
 		{"ModeConsole is 1", ModeConsole, 1},
 		{"ModeJSON is 2", ModeJSON, 2},
 		{"ModeTUI is 3", ModeTUI, 3},
+		{"ModeNDJSON is 4", ModeNDJSON, 4},
 	}
 
 	for _, tt := range tests {
@@ -33,6 +37,7 @@ func TestUIModeString(t *testing.T) {
 		{"ModeConsole string", ModeConsole, "console"},
 		{"ModeJSON string", ModeJSON, "json"},
 		{"ModeTUI string", ModeTUI, "tui"},
+		{"ModeNDJSON string", ModeNDJSON, "ndjson"},
 		{"Unknown mode", UIMode(999), "unknown"},
 	}
 
@@ -137,7 +142,7 @@ func TestUIConfigFields(t *testing.T) {
 }
 
 func TestUIModeStringRoundTrip(t *testing.T) {
-	modes := []UIMode{ModeAuto, ModeConsole, ModeJSON, ModeTUI}
+	modes := []UIMode{ModeAuto, ModeConsole, ModeJSON, ModeTUI, ModeNDJSON}
 
 	for _, mode := range modes {
 		t.Run(mode.String(), func(t *testing.T) {
@@ -145,7 +150,7 @@ func TestUIModeStringRoundTrip(t *testing.T) {
 			if str == "" {
 				t.Error("String() should not return empty string")
 			}
-			if str == "unknown" && mode <= ModeTUI {
+			if str == "unknown" && mode <= ModeNDJSON {
 				t.Errorf("valid mode %v should not return 'unknown'", mode)
 			}
 		})
@@ -187,6 +192,26 @@ func TestDetectModeExplicitMode(t *testing.T) {
 	}
 }
 
+func TestDetectModeNDJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  UIConfig
+	}{
+		{"NDJSON alone", UIConfig{NDJSON: true}},
+		{"NDJSON over JSON", UIConfig{NDJSON: true, JSON: true}},
+		{"NDJSON over interactive", UIConfig{NDJSON: true, Interactive: true}},
+		{"NDJSON over explicit mode", UIConfig{NDJSON: true, Mode: ModeConsole}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DetectMode(tt.cfg); got != ModeNDJSON {
+				t.Errorf("DetectMode() = %v, want ModeNDJSON", got)
+			}
+		})
+	}
+}
+
 func TestDetectModeCIEnvironment(t *testing.T) {
 	t.Run("CI env set returns console mode", func(t *testing.T) {
 		t.Setenv("CI", "true")
@@ -524,3 +549,75 @@ func TestUIConfigLogLevel(t *testing.T) {
 		})
 	}
 }
+
+func TestCanPrompt(t *testing.T) {
+	tty := func(fd uintptr) bool { return true }
+	tests := []struct {
+		name       string
+		cfg        UIConfig
+		ci         bool
+		mockTTY    ttyDetector
+		wantOK     bool
+		wantReason string
+	}{
+		{
+			name:    "terminal",
+			cfg:     UIConfig{},
+			mockTTY: tty,
+			wantOK:  true,
+		},
+		{
+			name:       "JSON output",
+			cfg:        UIConfig{JSON: true},
+			mockTTY:    tty,
+			wantReason: "output is JSON",
+		},
+		{
+			name:       "NDJSON output beats interactive",
+			cfg:        UIConfig{NDJSON: true, Interactive: true},
+			mockTTY:    tty,
+			wantReason: "output is NDJSON",
+		},
+		{
+			name:       "CI",
+			cfg:        UIConfig{},
+			ci:         true,
+			mockTTY:    tty,
+			wantReason: "running in CI",
+		},
+		{
+			name:       "piped stdin",
+			cfg:        UIConfig{},
+			mockTTY:    func(fd uintptr) bool { return fd != os.Stdin.Fd() },
+			wantReason: "stdin is not a terminal",
+		},
+		{
+			name:       "redirected stdout",
+			cfg:        UIConfig{},
+			mockTTY:    func(fd uintptr) bool { return fd != os.Stdout.Fd() },
+			wantReason: "stdout is not a terminal",
+		},
+		{
+			name:    "interactive forces prompts in CI",
+			cfg:     UIConfig{Interactive: true},
+			ci:      true,
+			mockTTY: func(fd uintptr) bool { return false },
+			wantOK:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.ci {
+				t.Setenv("CI", "true")
+			} else {
+				t.Setenv("CI", "")
+			}
+
+			ok, reason := canPromptWithTTY(tt.cfg, tt.mockTTY)
+			if ok != tt.wantOK || reason != tt.wantReason {
+				t.Errorf("CanPrompt() = %v, %q, want %v, %q", ok, reason, tt.wantOK, tt.wantReason)
+			}
+		})
+	}
+}
@@ -12,8 +12,13 @@ type contextKey string
 
 const (
 	loggerKey contextKey = "logger"
+	outputKey contextKey = "output"
 )
 
+// OutputFunc receives one line of output from an external command, with the
+// name of the step that ran it.
+type OutputFunc func(step, line string)
+
 // WithLogger attaches a logger to the context for propagation through the request lifecycle.
 // This enables commands and services to access logging without direct dependencies.
 func WithLogger(ctx context.Context, logger zerolog.Logger) context.Context {
@@ -29,3 +34,19 @@ func GetLogger(ctx context.Context) zerolog.Logger {
 	}
 	return zerolog.Nop()
 }
+
+// WithOutput attaches the destination for the output of external commands,
+// normally the command's Renderer.Stream, so long-running steps can show
+// their output as it is produced.
+func WithOutput(ctx context.Context, output OutputFunc) context.Context {
+	return context.WithValue(ctx, outputKey, output)
+}
+
+// GetOutput retrieves the output destination from the context.
+// Returns a function that discards output if none is found.
+func GetOutput(ctx context.Context) OutputFunc {
+	if output, ok := ctx.Value(outputKey).(OutputFunc); ok && output != nil {
+		return output
+	}
+	return func(string, string) {}
+}
@@ -129,3 +129,24 @@ func TestContextPropagation_Nested(t *testing.T) {
 		t.Error("Second logger should not receive first message")
 	}
 }
+
+func TestWithOutput(t *testing.T) {
+	var got []string
+	ctx := WithOutput(context.Background(), func(step, line string) {
+		got = append(got, step+": "+line)
+	})
+
+	GetOutput(ctx)("go mod tidy", "go: downloading example.com/mod v1.0.0")
+
+	if len(got) != 1 || got[0] != "go mod tidy: go: downloading example.com/mod v1.0.0" {
+		t.Errorf("unexpected output %q", got)
+	}
+}
+
+func TestGetOutput_WithoutOutput(t *testing.T) {
+	output := GetOutput(context.Background())
+	if output == nil {
+		t.Fatal("GetOutput returned nil, expected a discarding function")
+	}
+	output("step", "line")
+}
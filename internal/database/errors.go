@@ -3,8 +3,9 @@ package database
 import "errors"
 
 var (
-	// ErrDatabaseURLNotSet is returned when DATABASE_URL is not found in environment.
-	ErrDatabaseURLNotSet = errors.New("DATABASE_URL environment variable not set")
+	// ErrDatabaseURLNotSet is returned when no database URL is found in the
+	// environment, the .env file or the driver defaults.
+	ErrDatabaseURLNotSet = errors.New("database URL not set")
 
 	// ErrUnsupportedDriver is returned when the database driver is not supported.
 	ErrUnsupportedDriver = errors.New("unsupported database driver")
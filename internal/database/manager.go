@@ -4,47 +4,116 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"net/url"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/joho/godotenv"
 	"github.com/rs/zerolog"
 
-	// Postgres driver imported for direct CLI connections.
-	// SQLite-based drivers (sqlite3, go-libsql) have symbol conflicts when linked together,
-	// so SQLite projects use generated make commands instead (avoids CGO conflicts).
+	// Database drivers imported for direct CLI connections. Both SQLite
+	// drivers are pure Go: the CLI is built with CGO disabled, and the CGO
+	// drivers generated projects use (mattn/go-sqlite3, go-libsql) have
+	// symbol conflicts when linked together. modernc.org/sqlite opens local
+	// files for sqlite3 and go-libsql projects; libsql-client-go connects to
+	// sqld/Turso URLs.
 	_ "github.com/lib/pq"
+	_ "github.com/tursodatabase/libsql-client-go/libsql"
+	_ "modernc.org/sqlite"
 )
 
 // Manager implements the DatabaseManager interface for CLI database operations.
 type Manager struct {
 	driver      string
+	envPrefix   string
 	databaseURL string
+	urlSource   string
+	projectDir  string
 	db          *sql.DB
 	envLoaded   bool
 }
 
+// NewManager creates a Manager that reads the unprefixed DATABASE_URL.
 func NewManager(driver string) *Manager {
 	return &Manager{
 		driver: driver,
 	}
 }
 
+// NewManagerWithEnvPrefix creates a Manager that reads <envPrefix>_DATABASE_URL,
+// matching the key generated projects load through config.Load.
+func NewManagerWithEnvPrefix(driver, envPrefix string) *Manager {
+	return &Manager{
+		driver:    driver,
+		envPrefix: envPrefix,
+	}
+}
+
+// DatabaseURLEnvKey returns the environment variable holding the database URL
+// for a project with the given env_prefix.
+func DatabaseURLEnvKey(envPrefix string) string {
+	if envPrefix == "" {
+		return "DATABASE_URL"
+	}
+	return strings.ToUpper(envPrefix) + "_DATABASE_URL"
+}
+
+// defaultDatabaseURLs mirrors the database.url defaults set by the generated
+// config.Load for each driver.
+var defaultDatabaseURLs = map[string]string{
+	"go-libsql": "http://localhost:8081",
+	"sqlite3":   ":memory:",
+	"postgres":  "postgres://localhost:5432/myapp?sslmode=disable",
+}
+
+// IsInMemoryURL reports whether rawURL is a SQLite in-memory database, which
+// is discarded when the connection closes.
+func IsInMemoryURL(rawURL string) bool {
+	return strings.Contains(rawURL, ":memory:")
+}
+
+// LoadEnv resolves the database URL with the same precedence as the generated
+// config.Load: driver default, then the project's .env file, then the process
+// environment. The .env file is read without modifying the environment.
 func (m *Manager) LoadEnv(ctx context.Context, projectDir string) error {
 	logger := zerolog.Ctx(ctx)
+	key := DatabaseURLEnvKey(m.envPrefix)
+
+	m.databaseURL = defaultDatabaseURLs[m.driver]
+	m.urlSource = URLSourceNone
+	if m.databaseURL != "" {
+		m.urlSource = URLSourceDefault
+	}
 
 	envPath := filepath.Join(projectDir, ".env")
 	if _, err := os.Stat(envPath); err == nil {
-		if err := godotenv.Load(envPath); err != nil {
+		values, err := godotenv.Read(envPath)
+		if err != nil {
 			logger.Debug().Err(err).Str("path", envPath).Msg("failed to load .env file")
 			return fmt.Errorf("failed to load .env file: %w", err)
 		}
 		logger.Debug().Str("path", envPath).Msg("loaded .env file")
+
+		if value := values[key]; value != "" {
+			m.databaseURL = value
+			m.urlSource = URLSourceDotEnv
+		}
 	} else {
 		logger.Debug().Str("path", envPath).Msg(".env file not found, using environment variables only")
 	}
 
-	m.databaseURL = os.Getenv("DATABASE_URL")
+	if value := os.Getenv(key); value != "" {
+		m.databaseURL = value
+		m.urlSource = URLSourceEnv
+	}
+
+	logger.Debug().
+		Str("key", key).
+		Str("source", m.urlSource).
+		Msg("resolved database URL")
+
+	m.projectDir = projectDir
 	m.envLoaded = true
 
 	return nil
@@ -54,6 +123,12 @@ func (m *Manager) GetDatabaseURL() string {
 	return m.databaseURL
 }
 
+// GetDatabaseURLSource reports where the database URL was resolved from
+// (URLSourceEnv, URLSourceDotEnv, URLSourceDefault or URLSourceNone).
+func (m *Manager) GetDatabaseURLSource() string {
+	return m.urlSource
+}
+
 func (m *Manager) GetDriver() string {
 	return m.driver
 }
@@ -68,21 +143,30 @@ func (m *Manager) Connect(ctx context.Context) (*sql.DB, error) {
 	}
 
 	if m.databaseURL == "" {
-		return nil, ErrDatabaseURLNotSet
+		return nil, fmt.Errorf("%w: %s", ErrDatabaseURLNotSet, DatabaseURLEnvKey(m.envPrefix))
 	}
 
 	logger := zerolog.Ctx(ctx)
 
-	driverName, err := m.sqlDriverName()
+	driverName, dsn, err := m.dataSource()
 	if err != nil {
 		return nil, err
 	}
 
 	logger.Debug().
 		Str("driver", driverName).
+		Str("url", SanitizeURL(dsn)).
 		Msg("connecting to database")
 
-	db, err := sql.Open(driverName, m.databaseURL)
+	if driverName == sqliteDriverName {
+		// SQLite creates the database file on first use but not its
+		// directory (e.g. data/ in generated projects).
+		if err := os.MkdirAll(filepath.Dir(sqliteFilePath(dsn)), 0755); err != nil {
+			return nil, fmt.Errorf("failed to create database directory: %w", err)
+		}
+	}
+
+	db, err := sql.Open(driverName, dsn)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open database: %w", err)
 	}
@@ -117,13 +201,95 @@ func (m *Manager) IsConnected() bool {
 	return m.db != nil
 }
 
-func (m *Manager) sqlDriverName() (string, error) {
+// Sources reported by GetDatabaseURLSource.
+const (
+	URLSourceEnv     = "environment"
+	URLSourceDotEnv  = ".env"
+	URLSourceDefault = "default"
+	URLSourceNone    = ""
+)
+
+// database/sql driver names registered by the imported drivers.
+const (
+	postgresDriverName = "postgres"
+	sqliteDriverName   = "sqlite"
+	libsqlDriverName   = "libsql"
+)
+
+// dataSource returns the database/sql driver name and data source name for
+// the project's driver and DATABASE_URL. SQLite file paths are resolved
+// relative to the project directory so that commands behave the same from
+// any subdirectory.
+func (m *Manager) dataSource() (string, string, error) {
 	switch m.driver {
 	case "postgres":
-		return "postgres", nil
+		return postgresDriverName, m.databaseURL, nil
 	case "sqlite3", "go-libsql":
-		return "", fmt.Errorf("%w: %s (use project's make commands for SQLite databases)", ErrUnsupportedDriver, m.driver)
+		if m.driver == "go-libsql" && isRemoteLibSQLURL(m.databaseURL) {
+			return libsqlDriverName, m.databaseURL, nil
+		}
+		dsn, err := sqliteDSN(m.databaseURL, m.projectDir)
+		if err != nil {
+			return "", "", err
+		}
+		return sqliteDriverName, dsn, nil
+	default:
+		return "", "", fmt.Errorf("%w: %s", ErrUnsupportedDriver, m.driver)
+	}
+}
+
+// isRemoteLibSQLURL reports whether rawURL points at a libsql server (sqld or
+// Turso) rather than a local file.
+func isRemoteLibSQLURL(rawURL string) bool {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return false
+	}
+	switch u.Scheme {
+	case "libsql", "http", "https", "ws", "wss":
+		return true
 	default:
-		return "", fmt.Errorf("%w: %s", ErrUnsupportedDriver, m.driver)
+		return false
+	}
+}
+
+// sqliteDSN converts a SQLite DATABASE_URL ("file:data/app.db",
+// "sqlite://data/app.db" or a bare path, optionally with query parameters)
+// into an absolute file: URI for modernc.org/sqlite.
+func sqliteDSN(rawURL, projectDir string) (string, error) {
+	path, query, _ := strings.Cut(rawURL, "?")
+
+	switch {
+	case strings.HasPrefix(path, "sqlite3://"):
+		path = strings.TrimPrefix(path, "sqlite3://")
+	case strings.HasPrefix(path, "sqlite://"):
+		path = strings.TrimPrefix(path, "sqlite://")
+	case strings.HasPrefix(path, "file://"):
+		path = strings.TrimPrefix(path, "file://")
+	case strings.HasPrefix(path, "file:"):
+		path = strings.TrimPrefix(path, "file:")
+	}
+
+	if path == "" {
+		return "", fmt.Errorf("invalid SQLite DATABASE_URL %q: missing file path", rawURL)
+	}
+	if path == ":memory:" {
+		return "file::memory:", nil
 	}
+
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(projectDir, path)
+	}
+
+	dsn := "file:" + filepath.ToSlash(path)
+	if query != "" {
+		dsn += "?" + query
+	}
+	return dsn, nil
+}
+
+// sqliteFilePath returns the file path of a DSN built by sqliteDSN.
+func sqliteFilePath(dsn string) string {
+	path, _, _ := strings.Cut(strings.TrimPrefix(dsn, "file:"), "?")
+	return filepath.FromSlash(path)
 }
@@ -108,18 +108,121 @@ func TestManager_Connect_BeforeLoadEnv(t *testing.T) {
 
 func TestManager_Connect_NoDatabaseURL(t *testing.T) {
 	ctx := testContext()
-	m := NewManager("postgres")
-
-	tmpDir := t.TempDir()
-	os.Unsetenv("DATABASE_URL")
-	defer os.Unsetenv("DATABASE_URL")
-
-	require.NoError(t, m.LoadEnv(ctx, tmpDir))
+	m := NewManagerWithEnvPrefix("postgres", "APP")
+	m.envLoaded = true
 
 	_, err := m.Connect(ctx)
 
 	require.Error(t, err)
 	assert.ErrorIs(t, err, ErrDatabaseURLNotSet)
+	assert.Contains(t, err.Error(), "APP_DATABASE_URL")
+}
+
+func TestDatabaseURLEnvKey(t *testing.T) {
+	assert.Equal(t, "DATABASE_URL", DatabaseURLEnvKey(""))
+	assert.Equal(t, "APP_DATABASE_URL", DatabaseURLEnvKey("APP"))
+	assert.Equal(t, "MYAPP_DATABASE_URL", DatabaseURLEnvKey("myapp"))
+}
+
+func TestIsInMemoryURL(t *testing.T) {
+	assert.True(t, IsInMemoryURL(":memory:"))
+	assert.True(t, IsInMemoryURL("file::memory:?cache=shared"))
+	assert.False(t, IsInMemoryURL("file:data/app.db"))
+	assert.False(t, IsInMemoryURL("postgres://localhost:5432/myapp"))
+}
+
+func TestManager_LoadEnv_EnvPrefix(t *testing.T) {
+	tests := []struct {
+		name       string
+		driver     string
+		dotEnv     string
+		env        map[string]string
+		wantURL    string
+		wantSource string
+	}{
+		{
+			name:       "driver default",
+			driver:     "go-libsql",
+			wantURL:    "http://localhost:8081",
+			wantSource: URLSourceDefault,
+		},
+		{
+			name:       "prefixed key in .env",
+			driver:     "postgres",
+			dotEnv:     "APP_DATABASE_URL=postgres://localhost/from_file\n",
+			wantURL:    "postgres://localhost/from_file",
+			wantSource: URLSourceDotEnv,
+		},
+		{
+			name:       "environment overrides .env",
+			driver:     "postgres",
+			dotEnv:     "APP_DATABASE_URL=postgres://localhost/from_file\n",
+			env:        map[string]string{"APP_DATABASE_URL": "postgres://localhost/from_env"},
+			wantURL:    "postgres://localhost/from_env",
+			wantSource: URLSourceEnv,
+		},
+		{
+			name:       "unprefixed key ignored",
+			driver:     "sqlite3",
+			dotEnv:     "DATABASE_URL=file:other.db\n",
+			env:        map[string]string{"DATABASE_URL": "file:other.db"},
+			wantURL:    ":memory:",
+			wantSource: URLSourceDefault,
+		},
+		{
+			name:       "empty value falls back to default",
+			driver:     "sqlite3",
+			dotEnv:     "APP_DATABASE_URL=\n",
+			wantURL:    ":memory:",
+			wantSource: URLSourceDefault,
+		},
+		{
+			name:       "unknown driver has no default",
+			driver:     "mysql",
+			wantURL:    "",
+			wantSource: URLSourceNone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := testContext()
+			m := NewManagerWithEnvPrefix(tt.driver, "APP")
+
+			tmpDir := t.TempDir()
+			if tt.dotEnv != "" {
+				require.NoError(t, os.WriteFile(filepath.Join(tmpDir, ".env"), []byte(tt.dotEnv), 0600))
+			}
+
+			t.Setenv("APP_DATABASE_URL", "")
+			t.Setenv("DATABASE_URL", "")
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+
+			require.NoError(t, m.LoadEnv(ctx, tmpDir))
+
+			assert.Equal(t, tt.wantURL, m.GetDatabaseURL())
+			assert.Equal(t, tt.wantSource, m.GetDatabaseURLSource())
+		})
+	}
+}
+
+func TestManager_LoadEnv_DoesNotModifyEnvironment(t *testing.T) {
+	ctx := testContext()
+	m := NewManagerWithEnvPrefix("postgres", "APP")
+
+	tmpDir := t.TempDir()
+	envContent := "APP_DATABASE_URL=postgres://localhost/from_file\n"
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, ".env"), []byte(envContent), 0600))
+
+	os.Unsetenv("APP_DATABASE_URL")
+	defer os.Unsetenv("APP_DATABASE_URL")
+
+	require.NoError(t, m.LoadEnv(ctx, tmpDir))
+
+	_, set := os.LookupEnv("APP_DATABASE_URL")
+	assert.False(t, set)
 }
 
 func TestManager_Close_NotConnected(t *testing.T) {
@@ -166,3 +269,126 @@ func TestManager_Connect_ContextCancelled(t *testing.T) {
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "context cancelled")
 }
+
+func TestManager_Connect_SQLite(t *testing.T) {
+	for _, driver := range []string{"sqlite3", "go-libsql"} {
+		t.Run(driver, func(t *testing.T) {
+			ctx := testContext()
+			m := NewManager(driver)
+
+			tmpDir := t.TempDir()
+			envContent := "DATABASE_URL=file:./data/app.db\n"
+			require.NoError(t, os.WriteFile(filepath.Join(tmpDir, ".env"), []byte(envContent), 0600))
+
+			os.Unsetenv("DATABASE_URL")
+			defer os.Unsetenv("DATABASE_URL")
+
+			require.NoError(t, m.LoadEnv(ctx, tmpDir))
+
+			db, err := m.Connect(ctx)
+			require.NoError(t, err)
+			defer m.Close()
+
+			_, err = db.ExecContext(ctx, "CREATE TABLE items (id INTEGER PRIMARY KEY)")
+			require.NoError(t, err)
+			assert.FileExists(t, filepath.Join(tmpDir, "data", "app.db"))
+		})
+	}
+}
+
+func TestManager_DataSource(t *testing.T) {
+	projectDir := filepath.FromSlash("/srv/app")
+
+	tests := []struct {
+		name       string
+		driver     string
+		url        string
+		wantDriver string
+		wantDSN    string
+		wantErr    error
+	}{
+		{
+			name:       "postgres",
+			driver:     "postgres",
+			url:        "postgres://localhost/app",
+			wantDriver: "postgres",
+			wantDSN:    "postgres://localhost/app",
+		},
+		{
+			name:       "sqlite3 relative file URL",
+			driver:     "sqlite3",
+			url:        "file:./data/app.db",
+			wantDriver: "sqlite",
+			wantDSN:    "file:" + filepath.ToSlash(filepath.Join(projectDir, "data", "app.db")),
+		},
+		{
+			name:       "sqlite3 absolute path with query",
+			driver:     "sqlite3",
+			url:        "sqlite:///tmp/app.db?_pragma=foreign_keys(1)",
+			wantDriver: "sqlite",
+			wantDSN:    "file:/tmp/app.db?_pragma=foreign_keys(1)",
+		},
+		{
+			name:       "go-libsql local file",
+			driver:     "go-libsql",
+			url:        "file:app.db",
+			wantDriver: "sqlite",
+			wantDSN:    "file:" + filepath.ToSlash(filepath.Join(projectDir, "app.db")),
+		},
+		{
+			name:       "go-libsql sqld URL",
+			driver:     "go-libsql",
+			url:        "http://localhost:8081",
+			wantDriver: "libsql",
+			wantDSN:    "http://localhost:8081",
+		},
+		{
+			name:       "go-libsql turso URL",
+			driver:     "go-libsql",
+			url:        "libsql://app.turso.io?authToken=secret",
+			wantDriver: "libsql",
+			wantDSN:    "libsql://app.turso.io?authToken=secret",
+		},
+		{
+			name:       "in-memory",
+			driver:     "sqlite3",
+			url:        ":memory:",
+			wantDriver: "sqlite",
+			wantDSN:    "file::memory:",
+		},
+		{
+			name:    "unknown driver",
+			driver:  "mysql",
+			url:     "mysql://localhost/app",
+			wantErr: ErrUnsupportedDriver,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewManager(tt.driver)
+			m.databaseURL = tt.url
+			m.projectDir = projectDir
+
+			driverName, dsn, err := m.dataSource()
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantDriver, driverName)
+			assert.Equal(t, tt.wantDSN, dsn)
+		})
+	}
+}
+
+func TestManager_DataSource_MissingSQLitePath(t *testing.T) {
+	m := NewManager("sqlite3")
+	m.databaseURL = "file:"
+
+	_, _, err := m.dataSource()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "missing file path")
+}
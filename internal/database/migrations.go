@@ -164,8 +164,14 @@ func (r *MigrationRunner) Reset(ctx context.Context) (*MigrationResult, error) {
 	}, nil
 }
 
+// GetMigrationsDir returns the migrations directory for the driver. Both
+// SQLite drivers share the sqlite directory created by `tracks new`.
 func GetMigrationsDir(projectDir, driver string) string {
-	return filepath.Join(projectDir, "internal", "db", "migrations", driver)
+	dialectDir := driver
+	if driver == "sqlite3" || driver == "go-libsql" {
+		dialectDir = "sqlite"
+	}
+	return filepath.Join(projectDir, "internal", "db", "migrations", dialectDir)
 }
 
 func gooseDialect(driver string) (goose.Dialect, error) {
@@ -26,13 +26,13 @@ func TestGetMigrationsDir(t *testing.T) {
 			name:       "sqlite3 driver",
 			projectDir: "/home/user/myproject",
 			driver:     "sqlite3",
-			want:       filepath.Join("/home/user/myproject", "internal", "db", "migrations", "sqlite3"),
+			want:       filepath.Join("/home/user/myproject", "internal", "db", "migrations", "sqlite"),
 		},
 		{
 			name:       "go-libsql driver",
 			projectDir: "/tmp/testapp",
 			driver:     "go-libsql",
-			want:       filepath.Join("/tmp/testapp", "internal", "db", "migrations", "go-libsql"),
+			want:       filepath.Join("/tmp/testapp", "internal", "db", "migrations", "sqlite"),
 		},
 		{
 			name:       "relative project dir",
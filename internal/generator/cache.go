@@ -0,0 +1,187 @@
+package generator
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// stepCache stores the files produced by slow external steps whose output
+// depends only on their inputs, so later generations restore them instead of
+// downloading them again. Go modules and npm packages already have their own
+// caches; this covers templUI, which fetches components over the network on
+// every run.
+//
+// A zero stepCache is disabled: lookups miss and saves do nothing.
+type stepCache struct {
+	dir string
+}
+
+// newStepCache returns the cache under the user cache directory
+// (e.g. ~/.cache/tracks/steps), or a disabled cache if disabled is set or
+// the directory cannot be determined.
+func newStepCache(disabled bool) stepCache {
+	if disabled {
+		return stepCache{}
+	}
+	base, err := os.UserCacheDir()
+	if err != nil {
+		return stepCache{}
+	}
+	return stepCache{dir: filepath.Join(base, "tracks", "steps")}
+}
+
+// cacheKey hashes the inputs of a step into a cache key.
+func cacheKey(parts ...string) string {
+	h := sha256.New()
+	for _, p := range parts {
+		h.Write([]byte(p))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// cacheCodec converts files between their form in a project and their form
+// in a cache entry, so an entry saved by one project can be restored into
+// another.
+type cacheCodec interface {
+	// encode returns content as it should be cached, or nil to leave the
+	// file out of the entry.
+	encode(rel string, content []byte) ([]byte, error)
+	// decode returns the cached content as it should be written into the
+	// project.
+	decode(rel string, cached []byte) ([]byte, error)
+}
+
+// restore copies the files cached under key into projectRoot, passing each
+// through codec, and reports whether there was a cache entry.
+func (c stepCache) restore(key, projectRoot string, codec cacheCodec) (bool, error) {
+	if c.dir == "" {
+		return false, nil
+	}
+	entry := filepath.Join(c.dir, key)
+	if _, err := os.Stat(entry); errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+
+	err := filepath.WalkDir(entry, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(entry, path)
+		if err != nil {
+			return err
+		}
+		cached, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		content, err := codec.decode(rel, cached)
+		if err != nil {
+			return fmt.Errorf("%s: %w", rel, err)
+		}
+		return writeFile(filepath.Join(projectRoot, rel), content)
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to restore cached files: %w", err)
+	}
+	return true, nil
+}
+
+// save stores files, given relative to projectRoot, under key after passing
+// each through codec. The entry is
+// assembled in a temporary directory and renamed into place so concurrent
+// generations never see a partial entry.
+func (c stepCache) save(key, projectRoot string, files []string, codec cacheCodec) error {
+	if c.dir == "" || len(files) == 0 {
+		return nil
+	}
+	if err := os.MkdirAll(c.dir, 0755); err != nil {
+		return fmt.Errorf("failed to create cache directory: %w", err)
+	}
+
+	tmp, err := os.MkdirTemp(c.dir, "tmp-")
+	if err != nil {
+		return fmt.Errorf("failed to create cache entry: %w", err)
+	}
+	defer os.RemoveAll(tmp)
+
+	for _, rel := range files {
+		content, err := os.ReadFile(filepath.Join(projectRoot, rel))
+		if err != nil {
+			return fmt.Errorf("failed to cache %s: %w", rel, err)
+		}
+		cached, err := codec.encode(rel, content)
+		if err != nil {
+			return fmt.Errorf("failed to cache %s: %w", rel, err)
+		}
+		if cached == nil {
+			continue
+		}
+		if err := writeFile(filepath.Join(tmp, rel), cached); err != nil {
+			return fmt.Errorf("failed to cache %s: %w", rel, err)
+		}
+	}
+
+	entry := filepath.Join(c.dir, key)
+	if err := os.Rename(tmp, entry); err != nil {
+		// Another generation stored the same entry first.
+		if _, statErr := os.Stat(entry); statErr == nil {
+			return nil
+		}
+		return fmt.Errorf("failed to store cache entry: %w", err)
+	}
+	return nil
+}
+
+// snapshotFiles hashes every file under dirs, given relative to
+// projectRoot. Missing directories are skipped.
+func snapshotFiles(projectRoot string, dirs []string) (map[string]string, error) {
+	snapshot := make(map[string]string)
+	for _, dir := range dirs {
+		err := filepath.WalkDir(filepath.Join(projectRoot, dir), func(path string, d fs.DirEntry, err error) error {
+			if errors.Is(err, fs.ErrNotExist) {
+				return filepath.SkipDir
+			}
+			if err != nil || d.IsDir() {
+				return err
+			}
+			content, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			rel, err := filepath.Rel(projectRoot, path)
+			if err != nil {
+				return err
+			}
+			snapshot[rel] = contentHash(content)
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan %s: %w", dir, err)
+		}
+	}
+	return snapshot, nil
+}
+
+// changedFiles returns the files in after that are new or differ from before.
+func changedFiles(before, after map[string]string) []string {
+	var changed []string
+	for path, hash := range after {
+		if before[path] != hash {
+			changed = append(changed, path)
+		}
+	}
+	return changed
+}
+
+func writeFile(dst string, content []byte) error {
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(dst, content, 0644)
+}
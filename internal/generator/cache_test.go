@@ -0,0 +1,74 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rawCodec caches files unchanged.
+type rawCodec struct{}
+
+func (rawCodec) encode(_ string, content []byte) ([]byte, error) { return content, nil }
+func (rawCodec) decode(_ string, cached []byte) ([]byte, error)  { return cached, nil }
+
+func TestStepCache_SaveRestore(t *testing.T) {
+	cache := stepCache{dir: t.TempDir()}
+	key := cacheKey("step", "v1.0.0")
+
+	source := t.TempDir()
+	writeTestFile(t, source, "internal/http/views/components/ui/button/button.templ", "button\n")
+	writeTestFile(t, source, "go.sum", "sum\n")
+
+	hit, err := cache.restore(key, t.TempDir(), rawCodec{})
+	require.NoError(t, err)
+	assert.False(t, hit)
+
+	files := []string{"internal/http/views/components/ui/button/button.templ", "go.sum"}
+	require.NoError(t, cache.save(key, source, files, rawCodec{}))
+	require.NoError(t, cache.save(key, source, files, rawCodec{}), "saving an existing entry should be a no-op")
+
+	target := t.TempDir()
+	hit, err = cache.restore(key, target, rawCodec{})
+	require.NoError(t, err)
+	assert.True(t, hit)
+	assert.Equal(t, "button\n", readTestFile(t, target, "internal/http/views/components/ui/button/button.templ"))
+	assert.Equal(t, "sum\n", readTestFile(t, target, "go.sum"))
+
+	hit, err = cache.restore(cacheKey("step", "v1.1.0"), t.TempDir(), rawCodec{})
+	require.NoError(t, err)
+	assert.False(t, hit, "different inputs should miss")
+}
+
+func TestStepCache_Disabled(t *testing.T) {
+	cache := newStepCache(true)
+	source := t.TempDir()
+	writeTestFile(t, source, "go.sum", "sum\n")
+
+	require.NoError(t, cache.save("key", source, []string{"go.sum"}, rawCodec{}))
+	hit, err := cache.restore("key", t.TempDir(), rawCodec{})
+	require.NoError(t, err)
+	assert.False(t, hit)
+}
+
+func TestSnapshotFiles_ChangedFiles(t *testing.T) {
+	projectDir := t.TempDir()
+	writeTestFile(t, projectDir, "go.mod", "module example.com/app\n")
+	writeTestFile(t, projectDir, "ui/kept.templ", "kept\n")
+
+	dirs := []string{"go.mod", "ui", "missing"}
+	before, err := snapshotFiles(projectDir, dirs)
+	require.NoError(t, err)
+	assert.Len(t, before, 2)
+
+	writeTestFile(t, projectDir, "go.mod", "module example.com/app\n\nrequire example.com/ui v1.0.0\n")
+	writeTestFile(t, projectDir, "ui/new.templ", "new\n")
+	require.NoError(t, os.WriteFile(filepath.Join(projectDir, "untracked.txt"), []byte("x"), 0644))
+
+	after, err := snapshotFiles(projectDir, dirs)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"go.mod", filepath.Join("ui", "new.templ")}, changedFiles(before, after))
+}
@@ -0,0 +1,104 @@
+package generator
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"strings"
+	"time"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	"github.com/anomalousventures/tracks/internal/generator/template"
+	"github.com/anomalousventures/tracks/internal/templates"
+	"github.com/rs/zerolog"
+)
+
+// databaseDrivers are the drivers a project can be generated for.
+var databaseDrivers = []string{"go-libsql", "sqlite3", "postgres"}
+
+// checkedTemplateDirs are the embedded template directories the generators
+// render from. Examples are documentation and never rendered.
+var checkedTemplateDirs = []string{"project", "resource", "migration"}
+
+// checkFields declares one field of every type so resource and migration
+// templates exercise each branch of the type mapping.
+var checkFields = []string{
+	"title:string", "body:text", "views:int", "rating:float", "published:bool", "published_at:time",
+}
+
+func (m *templateManager) Check(ctx context.Context, projectDir string) ([]interfaces.TemplateCheck, error) {
+	logger := zerolog.Ctx(ctx)
+
+	var names []string
+	for _, dir := range checkedTemplateDirs {
+		err := fs.WalkDir(templates.FS, dir, func(path string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() || !strings.HasSuffix(path, ".tmpl") {
+				return err
+			}
+			names = append(names, path)
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list templates: %w", err)
+		}
+	}
+
+	fields, err := ParseFields(checkFields)
+	if err != nil {
+		return nil, err
+	}
+
+	renderer := newTemplateRenderer(projectDir)
+	var results []interfaces.TemplateCheck
+	for _, name := range names {
+		for _, driver := range databaseDrivers {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			data, err := checkData(name, driver, fields)
+			if err != nil {
+				return nil, err
+			}
+			_, err = renderer.Render(name, data)
+			results = append(results, interfaces.TemplateCheck{
+				Template: name,
+				Driver:   driver,
+				Err:      err,
+			})
+		}
+	}
+
+	logger.Debug().
+		Int("template_count", len(names)).
+		Int("check_count", len(results)).
+		Msg("templates checked")
+
+	return results, nil
+}
+
+// checkData returns sample data of the type the generators pass to the
+// template name for driver.
+func checkData(name, driver string, fields []Field) (any, error) {
+	switch {
+	case strings.HasPrefix(name, "resource/"):
+		return newResourceData(ResourceConfig{
+			Name:           "blog_post",
+			Fields:         fields,
+			ModulePath:     "example.com/checkapp",
+			DatabaseDriver: driver,
+		}), nil
+	case strings.HasPrefix(name, "migration/"):
+		return template.MigrationData{
+			Name:     "create_blog_posts",
+			DBDriver: driver,
+			Table:    "blog_posts",
+			Fields:   newResourceFields(fields, driver),
+		}, nil
+	}
+	return newTemplateData(ProjectConfig{
+		ProjectName:    "checkapp",
+		ModulePath:     "example.com/checkapp",
+		DatabaseDriver: driver,
+		EnvPrefix:      "APP",
+	}, time.Now())
+}
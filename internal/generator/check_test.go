@@ -0,0 +1,59 @@
+package generator
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/anomalousventures/tracks/internal/generator/template"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTemplateManager_Check(t *testing.T) {
+	setUserConfigDir(t)
+
+	results, err := NewTemplateManager().Check(context.Background(), "")
+	require.NoError(t, err)
+
+	templatesChecked := make(map[string]int)
+	for _, result := range results {
+		assert.NoError(t, result.Err, "%s for %s", result.Template, result.Driver)
+		templatesChecked[result.Template]++
+	}
+	assert.Contains(t, templatesChecked, "project/go.mod.tmpl")
+	assert.Contains(t, templatesChecked, "resource/handler.go.tmpl")
+	assert.Contains(t, templatesChecked, "migration/create_table.sql.tmpl")
+	assert.NotContains(t, templatesChecked, "examples/routes/users.go.tmpl")
+	for name, count := range templatesChecked {
+		assert.Equal(t, len(databaseDrivers), count, "%s should be rendered once per driver", name)
+	}
+}
+
+func TestTemplateManager_Check_BrokenOverride(t *testing.T) {
+	setUserConfigDir(t)
+	projectDir := t.TempDir()
+	override := filepath.Join(projectDir, projectTemplatesDir, "resource", "handler.go.tmpl")
+	require.NoError(t, os.MkdirAll(filepath.Dir(override), 0755))
+	require.NoError(t, os.WriteFile(override, []byte("package handlers\n\nfunc {{.Type}}( {\n}\n"), 0644))
+
+	results, err := NewTemplateManager().Check(context.Background(), projectDir)
+	require.NoError(t, err)
+
+	var failed int
+	for _, result := range results {
+		if result.Err == nil {
+			continue
+		}
+		failed++
+		assert.Equal(t, "resource/handler.go.tmpl", result.Template)
+
+		var tmplErr *template.TemplateError
+		require.True(t, errors.As(result.Err, &tmplErr))
+		assert.Equal(t, 3, tmplErr.Line)
+		assert.Positive(t, tmplErr.Column)
+	}
+	assert.Equal(t, len(databaseDrivers), failed)
+}
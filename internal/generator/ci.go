@@ -0,0 +1,318 @@
+package generator
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	generatorinterfaces "github.com/anomalousventures/tracks/internal/generator/interfaces"
+	"github.com/anomalousventures/tracks/internal/generator/template"
+	"github.com/rs/zerolog"
+	"gopkg.in/yaml.v3"
+)
+
+// CIConfig holds the inputs for `tracks generate ci`.
+type CIConfig struct {
+	// Provider is the CI provider to generate the pipeline for: github or
+	// gitlab.
+	Provider string `json:"provider"`
+
+	// ProjectDir is the root of the Tracks project being extended.
+	ProjectDir string `json:"project_dir"`
+
+	// ProjectName, ModulePath, DatabaseDriver and EnvPrefix come from
+	// .tracks.yaml and are used to render the pipeline.
+	ProjectName    string `json:"project_name"`
+	ModulePath     string `json:"module_path"`
+	DatabaseDriver string `json:"database_driver"`
+	EnvPrefix      string `json:"env_prefix"`
+
+	// GoVersion is the go_version from .tracks.yaml. Projects that predate
+	// it use the go directive of their go.mod.
+	GoVersion string `json:"go_version,omitempty"`
+
+	// Features are the project's features from .tracks.yaml. Their CI is
+	// replaced by Provider.
+	Features interfaces.ProjectFeatures `json:"features"`
+
+	// Force replaces an existing pipeline file that differs from the
+	// template.
+	Force bool `json:"force"`
+}
+
+// DetailExists is the detail of a pipeline file kept because it differs
+// from the template.
+const DetailExists = "already exists, use --force to replace it"
+
+// ciTemplates maps each CI provider to its pipeline template.
+var ciTemplates = map[string]string{
+	CIGitHub: ".github/workflows/ci.yml.tmpl",
+	CIGitLab: ".gitlab-ci.yml.tmpl",
+}
+
+type ciGenerator struct {
+	renderer generatorinterfaces.TemplateRenderer
+}
+
+// NewCIGenerator creates a generator for CI pipelines in existing projects.
+func NewCIGenerator() interfaces.CIGenerator {
+	return &ciGenerator{}
+}
+
+func (g *ciGenerator) Validate(cfg any) error {
+	ciCfg, ok := cfg.(CIConfig)
+	if !ok {
+		return fmt.Errorf("invalid config type: expected CIConfig, got %T", cfg)
+	}
+
+	if _, ok := ciTemplates[ciCfg.Provider]; !ok {
+		return fmt.Errorf("unknown CI provider %q: must be one of %s, %s", ciCfg.Provider, CIGitHub, CIGitLab)
+	}
+	if ciCfg.ProjectName == "" || ciCfg.ModulePath == "" {
+		return errors.New(".tracks.yaml is missing the project name or module path")
+	}
+
+	switch ciCfg.DatabaseDriver {
+	case "go-libsql", "sqlite3", "postgres":
+	default:
+		return fmt.Errorf("unsupported database driver %q", ciCfg.DatabaseDriver)
+	}
+
+	return nil
+}
+
+// Generate writes the provider's pipeline, adds the ci target it calls to
+// the Makefile if missing, and records the provider in .tracks.yaml so
+// upgrades manage the new pipeline. The pipeline is recorded in the
+// generation manifest and base snapshots like a file from `tracks new`.
+//
+// A differing pipeline kept because Force is off is not Tracks' to upgrade,
+// so nothing else is changed and only the kept pipeline is returned.
+func (g *ciGenerator) Generate(ctx context.Context, cfg any) ([]interfaces.GeneratedFile, error) {
+	logger := zerolog.Ctx(ctx)
+
+	if err := g.Validate(cfg); err != nil {
+		return nil, err
+	}
+	ciCfg := cfg.(CIConfig)
+
+	features := orFull(ciCfg.Features)
+	features.CI = ciCfg.Provider
+	data := template.TemplateData{
+		ModuleName:  ciCfg.ModulePath,
+		ProjectName: ciCfg.ProjectName,
+		DBDriver:    ciCfg.DatabaseDriver,
+		GoVersion:   projectGoVersion(ciCfg.ProjectDir, ciCfg.GoVersion),
+		EnvPrefix:   ciCfg.EnvPrefix,
+		Features:    features,
+	}
+	renderer := rendererOr(g.renderer, ciCfg.ProjectDir)
+
+	templateName := ciTemplates[ciCfg.Provider]
+	outputFile := strings.TrimSuffix(templateName, ".tmpl")
+	rendered, err := renderer.Render(templateName, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render %s: %w", templateName, err)
+	}
+
+	pipeline, err := writePipeline(ciCfg.ProjectDir, outputFile, rendered, ciCfg.Force)
+	if err != nil {
+		return nil, err
+	}
+	files := []interfaces.GeneratedFile{pipeline}
+	if pipeline.Detail == DetailExists {
+		logger.Info().
+			Str("provider", ciCfg.Provider).
+			Str("pipeline", outputFile).
+			Msg("existing CI pipeline kept")
+		return files, nil
+	}
+
+	if err := recordPipeline(ciCfg.ProjectDir, templateName, outputFile, rendered); err != nil {
+		return files, err
+	}
+
+	makefile, err := renderer.Render("Makefile.tmpl", data)
+	if err != nil {
+		return files, fmt.Errorf("failed to render Makefile.tmpl: %w", err)
+	}
+	updated, err := addCITarget(ciCfg.ProjectDir, makefile)
+	if err != nil {
+		return files, err
+	}
+	if updated {
+		files = append(files, interfaces.GeneratedFile{Path: "Makefile", Action: interfaces.FileActionUpdate})
+	}
+
+	updated, err = recordCIProvider(ciCfg.ProjectDir, features)
+	if err != nil {
+		return files, err
+	}
+	if updated {
+		files = append(files, interfaces.GeneratedFile{Path: tracksConfigFile, Action: interfaces.FileActionUpdate})
+	}
+
+	logger.Info().
+		Str("provider", ciCfg.Provider).
+		Str("pipeline", outputFile).
+		Str("action", pipeline.Action).
+		Msg("CI pipeline generated")
+
+	return files, nil
+}
+
+// writePipeline writes the rendered pipeline to outputFile unless a
+// different one exists and force is off.
+func writePipeline(projectDir, outputFile, rendered string, force bool) (interfaces.GeneratedFile, error) {
+	file := interfaces.GeneratedFile{Path: outputFile, Action: interfaces.FileActionCreate}
+
+	current, exists, err := readOptional(filepath.Join(projectDir, filepath.FromSlash(outputFile)))
+	if err != nil {
+		return file, err
+	}
+	switch {
+	case exists && current == rendered:
+		file.Action, file.Detail = interfaces.FileActionSkip, DetailUpToDate
+		return file, nil
+	case exists && !force:
+		file.Action, file.Detail = interfaces.FileActionSkip, DetailExists
+		return file, nil
+	case exists:
+		file.Action = interfaces.FileActionUpdate
+	}
+
+	return file, writeProjectFile(filepath.Join(projectDir, filepath.FromSlash(outputFile)), rendered)
+}
+
+// recordPipeline stores the rendered pipeline as its base snapshot and in
+// the generation manifest.
+func recordPipeline(projectDir, templateName, outputFile, rendered string) error {
+	if err := writeProjectFile(baseSnapshotPath(projectDir, outputFile), rendered); err != nil {
+		return err
+	}
+
+	manifest, err := ReadManifest(projectDir)
+	if errors.Is(err, ErrNoManifest) {
+		manifest = newManifest()
+	} else if err != nil {
+		return err
+	}
+	if err := manifest.set(templateFS(projectDir), templateName, outputFile, rendered); err != nil {
+		return err
+	}
+	return manifest.write(projectDir, time.Now())
+}
+
+// addCITarget appends the ci target of the rendered Makefile template to
+// the project's Makefile, and declares it phony, unless the Makefile
+// already has one. It reports whether the Makefile changed.
+func addCITarget(projectDir, renderedMakefile string) (bool, error) {
+	path := filepath.Join(projectDir, "Makefile")
+	content, exists, err := readOptional(path)
+	if err != nil {
+		return false, err
+	}
+	if !exists {
+		return false, errors.New("the project has no Makefile to add the ci target to")
+	}
+
+	missing := missingMakeTargets(content, renderedMakefile, "ci")
+	if len(missing) == 0 {
+		return false, nil
+	}
+	return true, writeProjectFile(path, appendMakeTargets(content, renderedMakefile, missing))
+}
+
+// ciValuePattern matches the separator and single-line scalar after the
+// ci key: double-quoted, single-quoted, plain or empty. A trailing comment
+// is not part of the match.
+var ciValuePattern = regexp.MustCompile(`^[ \t]*(?:"(?:[^"\\]|\\.)*"|'(?:[^']|'')*'|[^\s#](?:[^#\n]*[^\s#])?)?`)
+
+// ciKeyPattern matches the ci key and its colon at the start of its line.
+var ciKeyPattern = regexp.MustCompile(`^(?:ci|"ci"|'ci')[ \t]*:`)
+
+// recordCIProvider sets the ci feature in .tracks.yaml. The ci entry of the
+// features mapping is edited in place so comments and formatting are
+// preserved, and added if the mapping has none. Projects without a features
+// block get one listing features. It reports whether .tracks.yaml changed.
+func recordCIProvider(projectDir string, features interfaces.ProjectFeatures) (bool, error) {
+	path := filepath.Join(projectDir, tracksConfigFile)
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return false, fmt.Errorf("failed to read %s: %w", tracksConfigFile, err)
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(content, &doc); err != nil {
+		return false, fmt.Errorf("failed to parse %s: %w", tracksConfigFile, err)
+	}
+	var root *yaml.Node
+	if len(doc.Content) > 0 {
+		root = doc.Content[0]
+	}
+
+	lines := strings.SplitAfter(string(content), "\n")
+	featuresKey, featuresValue := mappingEntry(root, "features")
+	ciKey, ciValue := mappingEntry(featuresValue, "ci")
+	if ciValue != nil && ciValue.Kind == yaml.ScalarNode && ciValue.Value == features.CI {
+		return false, nil
+	}
+
+	switch {
+	case featuresKey == nil:
+		block := fmt.Sprintf("\n# Upgrades only touch the templates of the features turned on here.\n"+
+			"features:\n  ui: %t\n  node: %t\n  docker: %t\n  ci: %q\n  examples: %t\n",
+			features.UI, features.Node, features.Docker, features.CI, features.Examples)
+		lines = append([]string{strings.TrimRight(string(content), "\n") + "\n"}, block)
+
+	case featuresValue.Kind == yaml.MappingNode && featuresValue.Style&yaml.FlowStyle == 0 && len(featuresValue.Content) > 0:
+		if ciKey == nil {
+			indent := strings.Repeat(" ", featuresValue.Content[0].Column-1)
+			entry := fmt.Sprintf("%sci: %q\n", indent, features.CI)
+			lines = slices.Insert(lines, featuresKey.Line, entry)
+			break
+		}
+		line := lines[ciKey.Line-1]
+		key := ciKeyPattern.FindString(line[ciKey.Column-1:])
+		if ciValue.Kind != yaml.ScalarNode || ciValue.Line != ciKey.Line || key == "" {
+			return false, fmt.Errorf("%s:%d: ci must be a single-line value; set it to %q by hand", tracksConfigFile, ciKey.Line, features.CI)
+		}
+		valueStart := ciKey.Column - 1 + len(key)
+		rest := ciValuePattern.ReplaceAllLiteralString(line[valueStart:], fmt.Sprintf(" %q", features.CI))
+		lines[ciKey.Line-1] = line[:valueStart] + rest
+
+	default:
+		return false, fmt.Errorf("%s:%d: features must be a block mapping; set ci: %q by hand", tracksConfigFile, featuresKey.Line, features.CI)
+	}
+
+	updated := []byte(strings.Join(lines, ""))
+	if bytes.Equal(updated, content) {
+		return false, nil
+	}
+	if err := os.WriteFile(path, updated, 0644); err != nil {
+		return false, fmt.Errorf("failed to write %s: %w", tracksConfigFile, err)
+	}
+	return true, nil
+}
+
+// mappingEntry returns the key and value nodes of key in mapping, or nils if
+// mapping is not a mapping or has no such key.
+func mappingEntry(mapping *yaml.Node, key string) (*yaml.Node, *yaml.Node) {
+	if mapping == nil || mapping.Kind != yaml.MappingNode {
+		return nil, nil
+	}
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return mapping.Content[i], mapping.Content[i+1]
+		}
+	}
+	return nil, nil
+}
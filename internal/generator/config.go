@@ -1,5 +1,16 @@
 package generator
 
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	"github.com/anomalousventures/tracks/internal/generator/steps"
+	"gopkg.in/yaml.v3"
+)
+
 // ProjectConfig holds all configuration for generating a new project.
 type ProjectConfig struct {
 	ProjectName    string `json:"project_name" validate:"required,project_name"`
@@ -8,4 +19,72 @@ type ProjectConfig struct {
 	EnvPrefix      string `json:"env_prefix" validate:"required,env_prefix"`
 	InitGit        bool   `json:"init_git"`
 	OutputPath     string `json:"output_path" validate:"required"`
+
+	// GoVersion is the go directive of the generated go.mod. Empty means
+	// DefaultGoVersion.
+	GoVersion string `json:"go_version,omitempty" validate:"omitempty,go_version"`
+
+	// Profile selects the optional features: full (the default), api or
+	// minimal. The No* fields turn features of the profile off, and CI
+	// replaces its CI provider when set. See Features.
+	Profile    string `json:"profile,omitempty"`
+	NoUI       bool   `json:"no_ui,omitempty"`
+	NoNode     bool   `json:"no_node,omitempty"`
+	NoDocker   bool   `json:"no_docker,omitempty"`
+	NoExamples bool   `json:"no_examples,omitempty"`
+	CI         string `json:"ci,omitempty"`
+
+	// KeepOnFailure leaves the partially generated project in its staging
+	// directory when generation fails, for debugging.
+	KeepOnFailure bool `json:"keep_on_failure"`
+
+	// NoCache disables the cache of external step outputs shared between
+	// generations.
+	NoCache bool `json:"no_cache"`
+
+	// Starter is a starter kit directory or archive rendered on top of the
+	// built-in templates, and StarterVars the values of its variables.
+	Starter     string            `json:"starter,omitempty"`
+	StarterVars map[string]string `json:"starter_vars,omitempty"`
+
+	// OnStep, if set, is called as each external generation step finishes,
+	// with its timing.
+	OnStep func(steps.Result) `json:"-"`
+
+	// NewProgress, if set, creates the Progress that generation reports its
+	// phases to (templates, dependencies, templUI, codegen, assets and git),
+	// usually Renderer.Progress.
+	NewProgress func(interfaces.ProgressSpec) interfaces.Progress `json:"-"`
+}
+
+// LoadProjectConfig reads an answers file, a YAML document with the json
+// field names of ProjectConfig, into cfg. Fields missing from the file keep
+// their value in cfg, so callers can fill cfg with defaults first. Unknown
+// fields are an error, to catch typos.
+func LoadProjectConfig(path string, cfg *ProjectConfig) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read answers file: %w", err)
+	}
+
+	// Go through JSON so the file uses the json tags, the same names as
+	// --json output.
+	var answers map[string]any
+	if err := yaml.Unmarshal(content, &answers); err != nil {
+		return fmt.Errorf("failed to parse answers file %s: %w", path, err)
+	}
+	if answers == nil {
+		return nil
+	}
+	data, err := json.Marshal(answers)
+	if err != nil {
+		return fmt.Errorf("failed to parse answers file %s: %w", path, err)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(cfg); err != nil {
+		return fmt.Errorf("invalid answers file %s: %w", path, err)
+	}
+	return nil
 }
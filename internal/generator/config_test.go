@@ -1,6 +1,12 @@
 package generator
 
-import "testing"
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
 
 func TestProjectConfig_Fields(t *testing.T) {
 	cfg := ProjectConfig{
@@ -47,3 +53,73 @@ func TestProjectConfig_ZeroValue(t *testing.T) {
 		t.Error("zero value InitGit = true, want false")
 	}
 }
+
+func TestLoadProjectConfig(t *testing.T) {
+	write := func(t *testing.T, content string) string {
+		t.Helper()
+		path := filepath.Join(t.TempDir(), "answers.yaml")
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	t.Run("reads answers over defaults", func(t *testing.T) {
+		path := write(t, `
+project_name: myapp
+database_driver: postgres
+init_git: false
+go_version: "1.24"
+starter_vars:
+  Plan: pro
+`)
+		cfg := ProjectConfig{DatabaseDriver: "go-libsql", EnvPrefix: "APP", InitGit: true, OutputPath: "."}
+		if err := LoadProjectConfig(path, &cfg); err != nil {
+			t.Fatalf("LoadProjectConfig() error = %v", err)
+		}
+
+		want := ProjectConfig{
+			ProjectName:    "myapp",
+			DatabaseDriver: "postgres",
+			EnvPrefix:      "APP",
+			OutputPath:     ".",
+			GoVersion:      "1.24",
+			StarterVars:    map[string]string{"Plan": "pro"},
+		}
+		if !reflect.DeepEqual(cfg, want) {
+			t.Errorf("LoadProjectConfig() = %+v, want %+v", cfg, want)
+		}
+	})
+
+	t.Run("empty file keeps defaults", func(t *testing.T) {
+		cfg := ProjectConfig{EnvPrefix: "APP"}
+		if err := LoadProjectConfig(write(t, ""), &cfg); err != nil {
+			t.Fatalf("LoadProjectConfig() error = %v", err)
+		}
+		if cfg.EnvPrefix != "APP" {
+			t.Errorf("EnvPrefix = %q, want APP", cfg.EnvPrefix)
+		}
+	})
+
+	t.Run("rejects unknown fields", func(t *testing.T) {
+		var cfg ProjectConfig
+		err := LoadProjectConfig(write(t, "project_nam: myapp\n"), &cfg)
+		if err == nil || !strings.Contains(err.Error(), "project_nam") {
+			t.Errorf("expected unknown field error, got %v", err)
+		}
+	})
+
+	t.Run("rejects wrong types", func(t *testing.T) {
+		var cfg ProjectConfig
+		if err := LoadProjectConfig(write(t, "init_git: maybe\n"), &cfg); err == nil {
+			t.Error("expected an error for a non-boolean init_git")
+		}
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		var cfg ProjectConfig
+		if err := LoadProjectConfig(filepath.Join(t.TempDir(), "missing.yaml"), &cfg); err == nil {
+			t.Error("expected an error for a missing file")
+		}
+	})
+}
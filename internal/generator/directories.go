@@ -4,42 +4,20 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
 )
 
 func CreateProjectDirectories(config ProjectConfig) error {
 	projectRoot := filepath.Join(config.OutputPath, config.ProjectName)
 
-	directories := []string{
-		filepath.Join(projectRoot, ".github", "workflows"),
-		filepath.Join(projectRoot, "cmd", "server"),
-		filepath.Join(projectRoot, "cmd", "migrate"),
-		filepath.Join(projectRoot, "internal", "interfaces"),
-		filepath.Join(projectRoot, "internal", "domain", "health"),
-		filepath.Join(projectRoot, "internal", "http", "handlers"),
-		filepath.Join(projectRoot, "internal", "http", "helpers"),
-		filepath.Join(projectRoot, "internal", "http", "routes"),
-		filepath.Join(projectRoot, "internal", "http", "views", "layouts"),
-		filepath.Join(projectRoot, "internal", "http", "views", "pages"),
-		filepath.Join(projectRoot, "internal", "http", "views", "components"),
-		filepath.Join(projectRoot, "internal", "http", "views", "components", "ui"),
-		filepath.Join(projectRoot, "internal", "http", "views", "components", "utils"),
-		filepath.Join(projectRoot, "internal", "db", "migrations", "sqlite"),
-		filepath.Join(projectRoot, "internal", "db", "migrations", "postgres"),
-		filepath.Join(projectRoot, "internal", "db", "queries"),
-		filepath.Join(projectRoot, "internal", "db", "generated"),
-		filepath.Join(projectRoot, "tests", "mocks"),
-		filepath.Join(projectRoot, "tests", "integration"),
-		filepath.Join(projectRoot, "internal", "pkg", "identifier"),
-		filepath.Join(projectRoot, "internal", "pkg", "slug"),
-		filepath.Join(projectRoot, "internal", "assets", "web", "css"),
-		filepath.Join(projectRoot, "internal", "assets", "web", "js"),
-		filepath.Join(projectRoot, "internal", "assets", "web", "images"),
-		filepath.Join(projectRoot, "internal", "assets", "dist", "css"),
-		filepath.Join(projectRoot, "internal", "assets", "dist", "js"),
-		filepath.Join(projectRoot, "internal", "assets", "dist", "images"),
+	features, err := config.Features()
+	if err != nil {
+		return err
 	}
 
-	for _, dir := range directories {
+	for _, dir := range projectDirectories(features) {
+		dir = filepath.Join(projectRoot, filepath.FromSlash(dir))
 		if err := os.MkdirAll(dir, 0755); err != nil {
 			return fmt.Errorf("failed to create directory %s: %w", dir, err)
 		}
@@ -47,3 +25,45 @@ func CreateProjectDirectories(config ProjectConfig) error {
 
 	return nil
 }
+
+// projectDirectories returns the directories of a project with features,
+// relative to the project root with forward slashes.
+func projectDirectories(features interfaces.ProjectFeatures) []string {
+	directories := []string{
+		"cmd/server",
+		"cmd/migrate",
+		"internal/interfaces",
+		"internal/domain/health",
+		"internal/http/handlers",
+		"internal/http/helpers",
+		"internal/http/routes",
+		"internal/http/views/layouts",
+		"internal/http/views/pages",
+		"internal/http/views/components",
+		"internal/db/migrations/sqlite",
+		"internal/db/migrations/postgres",
+		"internal/db/queries",
+		"internal/db/generated",
+		"tests/mocks",
+		"tests/integration",
+		"internal/pkg/identifier",
+		"internal/pkg/slug",
+		"internal/assets/web/css",
+		"internal/assets/web/js",
+		"internal/assets/web/images",
+		"internal/assets/dist/css",
+		"internal/assets/dist/js",
+		"internal/assets/dist/images",
+	}
+	if features.UI {
+		directories = append(directories,
+			"internal/http/views/components/ui",
+			"internal/http/views/components/utils",
+		)
+	}
+	if features.CI == CIGitHub {
+		directories = append(directories, ".github/workflows")
+	}
+
+	return directories
+}
@@ -0,0 +1,132 @@
+package generator
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+)
+
+// Profiles are presets of project features for 'tracks new --profile'.
+const (
+	// ProfileFull has every feature.
+	ProfileFull = "full"
+
+	// ProfileAPI drops the frontend tooling and the demo: no templUI, no
+	// Node.js and no examples.
+	ProfileAPI = "api"
+
+	// ProfileMinimal is the Go server alone, without CI or Docker either.
+	ProfileMinimal = "minimal"
+)
+
+// CI providers a pipeline can be generated for.
+const (
+	CIGitHub = "github"
+	CIGitLab = "gitlab"
+	CINone   = "none"
+)
+
+// Profiles lists the profile names, fullest first.
+var Profiles = []string{ProfileFull, ProfileAPI, ProfileMinimal}
+
+// CIProviders lists the CI providers, including none.
+var CIProviders = []string{CIGitHub, CIGitLab, CINone}
+
+var profileFeatures = map[string]interfaces.ProjectFeatures{
+	ProfileFull:    {UI: true, Node: true, Docker: true, CI: CIGitHub, Examples: true},
+	ProfileAPI:     {Docker: true, CI: CIGitHub},
+	ProfileMinimal: {CI: CINone},
+}
+
+// Features resolves the project's features: those of its profile, full if
+// unset, minus the ones turned off and with CI replaced if set.
+func (c ProjectConfig) Features() (interfaces.ProjectFeatures, error) {
+	profile := c.Profile
+	if profile == "" {
+		profile = ProfileFull
+	}
+	features, ok := profileFeatures[profile]
+	if !ok {
+		return features, fmt.Errorf("unknown profile %q: must be one of %s", c.Profile, strings.Join(Profiles, ", "))
+	}
+
+	features.UI = features.UI && !c.NoUI
+	features.Node = features.Node && !c.NoNode
+	features.Docker = features.Docker && !c.NoDocker
+	features.Examples = features.Examples && !c.NoExamples
+	if c.CI != "" {
+		if !slices.Contains(CIProviders, c.CI) {
+			return features, fmt.Errorf("unknown CI provider %q: must be one of %s", c.CI, strings.Join(CIProviders, ", "))
+		}
+		features.CI = c.CI
+	}
+	return features, nil
+}
+
+// orFull returns features, or every feature for the zero value that
+// projects generated before features were recorded have.
+func orFull(features interfaces.ProjectFeatures) interfaces.ProjectFeatures {
+	if features.CI == "" {
+		return profileFeatures[ProfileFull]
+	}
+	return features
+}
+
+// featureOutputs maps the output files that belong to a feature to a
+// report of whether the feature is on. Other outputs are always generated.
+var featureOutputs = map[string]func(interfaces.ProjectFeatures) bool{
+	".templui.json": func(f interfaces.ProjectFeatures) bool { return f.UI },
+
+	"package.json":                       func(f interfaces.ProjectFeatures) bool { return f.Node },
+	"internal/assets/web/css/app.css":    func(f interfaces.ProjectFeatures) bool { return f.Node },
+	"internal/assets/web/js/app.js":      func(f interfaces.ProjectFeatures) bool { return f.Node },
+	"internal/assets/web/js/lib/htmx.js": func(f interfaces.ProjectFeatures) bool { return f.Node },
+
+	"Dockerfile":         func(f interfaces.ProjectFeatures) bool { return f.Docker },
+	".dockerignore":      func(f interfaces.ProjectFeatures) bool { return f.Docker },
+	"docker-compose.yml": func(f interfaces.ProjectFeatures) bool { return f.Docker },
+
+	".github/workflows/ci.yml": func(f interfaces.ProjectFeatures) bool { return f.CI == CIGitHub },
+	".gitlab-ci.yml":           func(f interfaces.ProjectFeatures) bool { return f.CI == CIGitLab },
+
+	"internal/http/views/components/counter.templ":   func(f interfaces.ProjectFeatures) bool { return f.Examples },
+	"internal/http/views/components/counter_test.go": func(f interfaces.ProjectFeatures) bool { return f.Examples },
+	"internal/http/handlers/counter.go":              func(f interfaces.ProjectFeatures) bool { return f.Examples },
+}
+
+// hasOutput reports whether a project with features gets outputFile.
+func hasOutput(outputFile string, features interfaces.ProjectFeatures) bool {
+	enabled, ok := featureOutputs[outputFile]
+	return !ok || enabled(features)
+}
+
+// withFeatures returns the templates of set whose output a project with
+// features gets.
+func withFeatures(set map[string]string, features interfaces.ProjectFeatures) map[string]string {
+	result := make(map[string]string, len(set))
+	for templateName, outputFile := range set {
+		if hasOutput(outputFile, features) {
+			result[templateName] = outputFile
+		}
+	}
+	return result
+}
+
+// withFeatures returns the template sets of a project with features.
+func (s templateSets) withFeatures(features interfaces.ProjectFeatures) templateSets {
+	return templateSets{
+		pre:  withFeatures(s.pre, features),
+		post: withFeatures(s.post, features),
+		test: withFeatures(s.test, features),
+	}
+}
+
+// withFeatures returns a copy of g that only renders the templates of a
+// project with features.
+func (g *projectGenerator) withFeatures(features interfaces.ProjectFeatures) *projectGenerator {
+	featured := *g
+	featured.sets = g.sets.withFeatures(features)
+	return &featured
+}
@@ -0,0 +1,210 @@
+package generator
+
+import (
+	"context"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	"github.com/anomalousventures/tracks/internal/process"
+	"github.com/anomalousventures/tracks/internal/process/processtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectConfig_Features(t *testing.T) {
+	full := interfaces.ProjectFeatures{UI: true, Node: true, Docker: true, CI: CIGitHub, Examples: true}
+
+	tests := []struct {
+		name    string
+		cfg     ProjectConfig
+		want    interfaces.ProjectFeatures
+		wantErr string
+	}{
+		{name: "default is full", want: full},
+		{name: "full profile", cfg: ProjectConfig{Profile: ProfileFull}, want: full},
+		{name: "api profile", cfg: ProjectConfig{Profile: ProfileAPI}, want: interfaces.ProjectFeatures{Docker: true, CI: CIGitHub}},
+		{name: "minimal profile", cfg: ProjectConfig{Profile: ProfileMinimal}, want: interfaces.ProjectFeatures{CI: CINone}},
+		{name: "no ui", cfg: ProjectConfig{NoUI: true}, want: interfaces.ProjectFeatures{Node: true, Docker: true, CI: CIGitHub, Examples: true}},
+		{name: "no node", cfg: ProjectConfig{NoNode: true}, want: interfaces.ProjectFeatures{UI: true, Docker: true, CI: CIGitHub, Examples: true}},
+		{name: "no docker", cfg: ProjectConfig{NoDocker: true}, want: interfaces.ProjectFeatures{UI: true, Node: true, CI: CIGitHub, Examples: true}},
+		{name: "no examples", cfg: ProjectConfig{NoExamples: true}, want: interfaces.ProjectFeatures{UI: true, Node: true, Docker: true, CI: CIGitHub}},
+		{name: "ci overrides profile", cfg: ProjectConfig{Profile: ProfileMinimal, CI: CIGitLab}, want: interfaces.ProjectFeatures{CI: CIGitLab}},
+		{name: "flags narrow a profile", cfg: ProjectConfig{Profile: ProfileAPI, NoDocker: true, CI: CINone}, want: interfaces.ProjectFeatures{CI: CINone}},
+		{name: "unknown profile", cfg: ProjectConfig{Profile: "huge"}, wantErr: `unknown profile "huge"`},
+		{name: "unknown ci", cfg: ProjectConfig{CI: "jenkins"}, wantErr: `unknown CI provider "jenkins"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.cfg.Features()
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestProjectGenerator_Generate_Features(t *testing.T) {
+	tests := []struct {
+		name        string
+		configure   func(*ProjectConfig)
+		wantFiles   []string
+		absentFiles []string
+		wantCmds    []string
+		absentCmds  []string
+	}{
+		{
+			name:        "full profile",
+			configure:   func(cfg *ProjectConfig) { cfg.Profile = ProfileFull },
+			wantFiles:   []string{".templui.json", "package.json", "Dockerfile", ".github/workflows/ci.yml", "internal/http/handlers/counter.go"},
+			absentFiles: []string{".gitlab-ci.yml"},
+			wantCmds:    []string{"go tool templui -f init", "npm install --prefer-offline --no-audit --no-fund", "make assets"},
+		},
+		{
+			name:        "api profile",
+			configure:   func(cfg *ProjectConfig) { cfg.Profile = ProfileAPI },
+			wantFiles:   []string{"Dockerfile", "docker-compose.yml", ".github/workflows/ci.yml"},
+			absentFiles: []string{".templui.json", "package.json", "internal/assets/web/js/app.js", "internal/http/handlers/counter.go", "internal/http/views/components/ui"},
+			absentCmds:  []string{"go tool templui -f init", "npm install --prefer-offline --no-audit --no-fund", "make assets"},
+		},
+		{
+			name:        "minimal profile",
+			configure:   func(cfg *ProjectConfig) { cfg.Profile = ProfileMinimal },
+			wantFiles:   []string{"internal/assets/dist/css/app.css", "internal/http/views/pages/home.templ"},
+			absentFiles: []string{".templui.json", "package.json", "Dockerfile", ".dockerignore", ".github", ".gitlab-ci.yml", "internal/http/views/components/counter.templ"},
+			absentCmds:  []string{"go tool templui -f init", "npm install --prefer-offline --no-audit --no-fund", "make assets"},
+		},
+		{
+			name:        "no ui",
+			configure:   func(cfg *ProjectConfig) { cfg.NoUI = true },
+			wantFiles:   []string{"package.json", "internal/http/views/components/counter.templ"},
+			absentFiles: []string{".templui.json", "internal/http/views/components/ui", "internal/http/views/components/utils"},
+			wantCmds:    []string{"make assets"},
+			absentCmds:  []string{"go tool templui -f init"},
+		},
+		{
+			name:        "no node",
+			configure:   func(cfg *ProjectConfig) { cfg.NoNode = true },
+			wantFiles:   []string{".templui.json", "internal/assets/dist/js/app.js"},
+			absentFiles: []string{"package.json", "internal/assets/web/css/app.css", "internal/assets/web/js/lib/htmx.js"},
+			wantCmds:    []string{"go tool templui -f init"},
+			absentCmds:  []string{"npm install --prefer-offline --no-audit --no-fund", "make assets"},
+		},
+		{
+			name:        "no docker",
+			configure:   func(cfg *ProjectConfig) { cfg.NoDocker = true },
+			wantFiles:   []string{".github/workflows/ci.yml"},
+			absentFiles: []string{"Dockerfile", ".dockerignore", "docker-compose.yml"},
+		},
+		{
+			name:        "no examples",
+			configure:   func(cfg *ProjectConfig) { cfg.NoExamples = true },
+			wantFiles:   []string{"internal/http/handlers/home.go"},
+			absentFiles: []string{"internal/http/handlers/counter.go", "internal/http/views/components/counter.templ", "internal/http/views/components/counter_test.go"},
+		},
+		{
+			name:        "gitlab ci",
+			configure:   func(cfg *ProjectConfig) { cfg.CI = CIGitLab },
+			wantFiles:   []string{".gitlab-ci.yml"},
+			absentFiles: []string{".github"},
+		},
+		{
+			name:        "no ci",
+			configure:   func(cfg *ProjectConfig) { cfg.CI = CINone },
+			absentFiles: []string{".github", ".gitlab-ci.yml"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := processtest.NewRunner()
+			cfg := fakeProjectConfig(t)
+			tt.configure(&cfg)
+
+			require.NoError(t, NewProjectGenerator(runner).Generate(context.Background(), cfg))
+
+			projectRoot := filepath.Join(cfg.OutputPath, cfg.ProjectName)
+			for _, f := range tt.wantFiles {
+				assert.FileExists(t, filepath.Join(projectRoot, f))
+			}
+			for _, f := range tt.absentFiles {
+				assert.NoFileExists(t, filepath.Join(projectRoot, f))
+				assert.NoDirExists(t, filepath.Join(projectRoot, f))
+			}
+
+			lines := runner.Lines()
+			assert.Contains(t, lines, "make generate")
+			for _, cmd := range tt.wantCmds {
+				assert.Contains(t, lines, cmd)
+			}
+			for _, cmd := range tt.absentCmds {
+				assert.NotContains(t, lines, cmd)
+			}
+
+			assertGoFilesParse(t, projectRoot)
+		})
+	}
+}
+
+// assertGoFilesParse fails if any Go file under root does not parse.
+func assertGoFilesParse(t *testing.T, root string) {
+	t.Helper()
+	fset := token.NewFileSet()
+	err := filepath.WalkDir(root, func(path string, d os.DirEntry, err error) error {
+		if err != nil || d.IsDir() || !strings.HasSuffix(path, ".go") {
+			return err
+		}
+		_, parseErr := parser.ParseFile(fset, path, nil, parser.AllErrors)
+		assert.NoError(t, parseErr)
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestProjectGenerator_Plan_FeatureCombinations(t *testing.T) {
+	for _, profile := range Profiles {
+		for _, ci := range CIProviders {
+			for mask := range 16 {
+				cfg := ProjectConfig{
+					ProjectName:    "testapp",
+					ModulePath:     "github.com/example/testapp",
+					DatabaseDriver: "postgres",
+					EnvPrefix:      "APP",
+					InitGit:        true,
+					OutputPath:     t.TempDir(),
+					Profile:        profile,
+					NoUI:           mask&1 != 0,
+					NoNode:         mask&2 != 0,
+					NoDocker:       mask&4 != 0,
+					NoExamples:     mask&8 != 0,
+					CI:             ci,
+				}
+				features, err := cfg.Features()
+				require.NoError(t, err)
+
+				plan, err := NewProjectGenerator(process.NewRunner()).Plan(context.Background(), cfg)
+				require.NoError(t, err, "%s profile with %+v", profile, features)
+
+				sets := builtinTemplateSets().withFeatures(features)
+				assert.Len(t, plan.Files, len(sets.pre)+len(sets.post)+len(sets.test)+len(initialMigrationTemplates))
+
+				steps := make(map[string]bool)
+				for _, step := range plan.Steps {
+					steps[step.Name] = true
+				}
+				assert.Equal(t, features.UI, steps[stepTemplUI], "templUI step with %+v", features)
+				assert.Equal(t, features.Node, steps[stepNPMInstall], "npm step with %+v", features)
+				assert.Equal(t, features.Node, steps[stepAssets], "assets step with %+v", features)
+				assert.True(t, steps[stepGenerate])
+			}
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anomalousventures/tracks/internal/generator/naming"
+	"github.com/anomalousventures/tracks/internal/generator/template"
+)
+
+// FieldType is a column type accepted by the generate commands.
+type FieldType string
+
+const (
+	FieldString FieldType = "string"
+	FieldText   FieldType = "text"
+	FieldInt    FieldType = "int"
+	FieldFloat  FieldType = "float"
+	FieldBool   FieldType = "bool"
+	FieldTime   FieldType = "time"
+)
+
+// fieldTypeAliases maps accepted spellings onto the canonical FieldType.
+var fieldTypeAliases = map[string]FieldType{
+	"string":    FieldString,
+	"text":      FieldText,
+	"int":       FieldInt,
+	"integer":   FieldInt,
+	"int64":     FieldInt,
+	"float":     FieldFloat,
+	"float64":   FieldFloat,
+	"decimal":   FieldFloat,
+	"bool":      FieldBool,
+	"boolean":   FieldBool,
+	"time":      FieldTime,
+	"datetime":  FieldTime,
+	"timestamp": FieldTime,
+}
+
+// reservedColumns are managed by the generated schema and cannot be declared.
+var reservedColumns = map[string]bool{
+	"id":         true,
+	"created_at": true,
+	"updated_at": true,
+}
+
+// Field describes a single column of a generated resource.
+type Field struct {
+	// Name is the snake_case column name (e.g., "published_at").
+	Name string `json:"name"`
+
+	// Type is the canonical field type (e.g., "time").
+	Type FieldType `json:"type"`
+}
+
+// ParseFields parses "name:type" arguments into Fields.
+//
+// Names are normalized to snake_case. The id, created_at and updated_at
+// columns are added by every generated table and may not be declared.
+func ParseFields(args []string) ([]Field, error) {
+	fields := make([]Field, 0, len(args))
+	seen := make(map[string]bool, len(args))
+
+	for _, arg := range args {
+		name, typ, ok := strings.Cut(arg, ":")
+		if !ok || name == "" || typ == "" {
+			return nil, fmt.Errorf("invalid field %q: expected name:type", arg)
+		}
+
+		column := naming.Snake(name)
+		if column == "" || !isIdentifier(column) {
+			return nil, fmt.Errorf("invalid field name %q: must start with a letter and contain only letters, digits, and underscores", name)
+		}
+		if reservedColumns[column] {
+			return nil, fmt.Errorf("invalid field name %q: %s is added automatically", name, column)
+		}
+		if seen[column] {
+			return nil, fmt.Errorf("duplicate field %q", column)
+		}
+
+		fieldType, ok := fieldTypeAliases[strings.ToLower(typ)]
+		if !ok {
+			return nil, fmt.Errorf("unknown type %q for field %q (supported: string, text, int, float, bool, time)", typ, name)
+		}
+
+		seen[column] = true
+		fields = append(fields, Field{Name: column, Type: fieldType})
+	}
+
+	return fields, nil
+}
+
+// GoName returns the exported Go identifier for the column, matching the
+// field names sqlc generates.
+func (f Field) GoName() string {
+	return naming.Pascal(f.Name)
+}
+
+// VarName returns the unexported Go identifier for the column.
+func (f Field) VarName() string {
+	return naming.Identifier(f.Name)
+}
+
+// Label returns a human-readable label for forms and tables.
+func (f Field) Label() string {
+	return naming.Humanize(f.Name)
+}
+
+// SQLType returns the column type for the given database driver.
+func (f Field) SQLType(driver string) string {
+	return template.SQLType(driver, string(f.Type))
+}
+
+// GoType returns the Go type sqlc generates for the column on the given
+// database driver.
+func (f Field) GoType(driver string) string {
+	return template.GoType(driver, string(f.Type))
+}
+
+// InputType returns the HTML input type used in generated forms.
+func (f Field) InputType() string {
+	switch f.Type {
+	case FieldInt, FieldFloat:
+		return "number"
+	case FieldBool:
+		return "checkbox"
+	case FieldTime:
+		return "datetime-local"
+	default:
+		return "text"
+	}
+}
+
+func isIdentifier(s string) bool {
+	for i, r := range s {
+		switch {
+		case r >= 'a' && r <= 'z':
+		case r == '_' && i > 0:
+		case r >= '0' && r <= '9' && i > 0:
+		default:
+			return false
+		}
+	}
+	return true
+}
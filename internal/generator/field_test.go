@@ -0,0 +1,93 @@
+package generator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFields(t *testing.T) {
+	fields, err := ParseFields([]string{"title:string", "publishedAt:datetime", "view_count:integer", "draft:boolean"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []Field{
+		{Name: "title", Type: FieldString},
+		{Name: "published_at", Type: FieldTime},
+		{Name: "view_count", Type: FieldInt},
+		{Name: "draft", Type: FieldBool},
+	}, fields)
+}
+
+func TestParseFields_Empty(t *testing.T) {
+	fields, err := ParseFields(nil)
+	require.NoError(t, err)
+	assert.Empty(t, fields)
+}
+
+func TestParseFields_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{"missing type", []string{"title"}, "expected name:type"},
+		{"empty name", []string{":string"}, "expected name:type"},
+		{"empty type", []string{"title:"}, "expected name:type"},
+		{"unknown type", []string{"title:blob"}, "unknown type"},
+		{"leading digit", []string{"1title:string"}, "invalid field name"},
+		{"reserved id", []string{"id:string"}, "added automatically"},
+		{"reserved timestamp", []string{"createdAt:time"}, "added automatically"},
+		{"duplicate", []string{"title:string", "Title:text"}, "duplicate field"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseFields(tt.args)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestField_Names(t *testing.T) {
+	f := Field{Name: "author_id", Type: FieldString}
+
+	assert.Equal(t, "AuthorID", f.GoName())
+	assert.Equal(t, "authorID", f.VarName())
+	assert.Equal(t, "Author ID", f.Label())
+
+	keyword := Field{Name: "type", Type: FieldString}
+	assert.Equal(t, "typeValue", keyword.VarName())
+}
+
+func TestField_Types(t *testing.T) {
+	tests := []struct {
+		fieldType FieldType
+		pgSQL     string
+		sqliteSQL string
+		pgGo      string
+		sqliteGo  string
+		inputType string
+	}{
+		{FieldString, "TEXT", "TEXT", "string", "string", "text"},
+		{FieldText, "TEXT", "TEXT", "string", "string", "text"},
+		{FieldInt, "BIGINT", "INTEGER", "int64", "int64", "number"},
+		{FieldFloat, "DOUBLE PRECISION", "REAL", "float64", "float64", "number"},
+		{FieldBool, "BOOLEAN", "BOOLEAN", "bool", "bool", "checkbox"},
+		{FieldTime, "TIMESTAMPTZ", "TEXT", "time.Time", "string", "datetime-local"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.fieldType), func(t *testing.T) {
+			f := Field{Name: "value", Type: tt.fieldType}
+
+			assert.Equal(t, tt.pgSQL, f.SQLType("postgres"))
+			assert.Equal(t, tt.sqliteSQL, f.SQLType("go-libsql"))
+			assert.Equal(t, tt.sqliteSQL, f.SQLType("sqlite3"))
+			assert.Equal(t, tt.pgGo, f.GoType("postgres"))
+			assert.Equal(t, tt.sqliteGo, f.GoType("go-libsql"))
+			assert.Equal(t, tt.inputType, f.InputType())
+		})
+	}
+}
@@ -5,15 +5,15 @@ import (
 	"crypto/rand"
 	"encoding/base64"
 	"fmt"
+	"io/fs"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"time"
 
 	"github.com/anomalousventures/tracks/internal/cli/interfaces"
 	generatorinterfaces "github.com/anomalousventures/tracks/internal/generator/interfaces"
+	"github.com/anomalousventures/tracks/internal/generator/steps"
 	"github.com/anomalousventures/tracks/internal/generator/template"
-	"github.com/anomalousventures/tracks/internal/templates"
 	"github.com/rs/zerolog"
 )
 
@@ -26,16 +26,42 @@ var TemplUIComponents = []string{
 }
 
 type projectGenerator struct {
+	// runner runs go, make, npm, templUI and git.
+	runner interfaces.CommandRunner
+
 	renderer generatorinterfaces.TemplateRenderer
+
+	// templates is the filesystem renderer reads from, used to record
+	// template versions in the manifest.
+	templates fs.FS
+
+	// sets are the templates rendered in each phase.
+	sets templateSets
+
+	// vars are the starter kit variables, exposed to templates as .Vars.
+	vars map[string]string
+
+	// starterTemplates are the starter kit's own templates, nil without a
+	// kit. The manifest marks the files rendered from them.
+	starterTemplates fs.FS
 }
 
-func NewProjectGenerator() interfaces.ProjectGenerator {
+// NewProjectGenerator creates a ProjectGenerator that runs external
+// commands through runner.
+func NewProjectGenerator(runner interfaces.CommandRunner) interfaces.ProjectGenerator {
 	return &projectGenerator{
-		renderer: template.NewRenderer(templates.FS),
+		runner:    runner,
+		renderer:  newTemplateRenderer(""),
+		templates: templateFS(""),
+		sets:      builtinTemplateSets(),
 	}
 }
 
-func (g *projectGenerator) Generate(ctx context.Context, cfg any) error {
+// Generate builds the project in a staging directory next to its final
+// location and renames it into place only once every step has succeeded, so
+// a failed or interrupted run never leaves a half-built project behind. On
+// failure the staging directory is removed unless KeepOnFailure is set.
+func (g *projectGenerator) Generate(ctx context.Context, cfg any) (err error) {
 	logger := zerolog.Ctx(ctx)
 
 	projectCfg, ok := cfg.(ProjectConfig)
@@ -53,26 +79,93 @@ func (g *projectGenerator) Generate(ctx context.Context, cfg any) error {
 		Str("path", projectRoot).
 		Msg("starting project generation")
 
-	secretKey, err := generateSecretKey()
+	if err := os.MkdirAll(projectCfg.OutputPath, 0755); err != nil {
+		return fmt.Errorf("failed to create output directory: %w", err)
+	}
+	stagingDir, err := os.MkdirTemp(projectCfg.OutputPath, stagingDirPrefix+projectCfg.ProjectName+"-")
 	if err != nil {
+		return fmt.Errorf("failed to create staging directory: %w", err)
+	}
+
+	stagedCfg := projectCfg
+	stagedCfg.OutputPath = stagingDir
+	stagedRoot := filepath.Join(stagingDir, projectCfg.ProjectName)
+
+	defer func() {
+		if err != nil && projectCfg.KeepOnFailure {
+			logger.Warn().
+				Str("path", stagedRoot).
+				Msg("keeping partial project for debugging")
+			err = fmt.Errorf("%w (partial project kept at %s)", err, stagedRoot)
+			return
+		}
+		if rmErr := os.RemoveAll(stagingDir); rmErr != nil {
+			logger.Warn().
+				Err(rmErr).
+				Str("path", stagingDir).
+				Msg("failed to remove staging directory")
+		}
+	}()
+
+	if err := g.generate(ctx, stagedCfg); err != nil {
+		return err
+	}
+
+	if err := canceled(ctx); err != nil {
+		return err
+	}
+	if err := os.Rename(stagedRoot, projectRoot); err != nil {
 		logger.Error().
 			Err(err).
-			Msg("failed to generate secret key")
-		return fmt.Errorf("failed to generate secret key: %w", err)
+			Str("from", stagedRoot).
+			Str("to", projectRoot).
+			Msg("failed to move project into place")
+		return fmt.Errorf("failed to move project into place: %w", err)
 	}
 
-	migrationTimestamp := time.Now().Format("20060102150405")
+	logger.Info().
+		Str("project", projectCfg.ProjectName).
+		Str("path", projectRoot).
+		Msg("project generation complete")
 
-	data := template.TemplateData{
-		ModuleName:         projectCfg.ModulePath,
-		ProjectName:        projectCfg.ProjectName,
-		DBDriver:           projectCfg.DatabaseDriver,
-		GoVersion:          "1.25",
-		Year:               time.Now().Year(),
-		EnvPrefix:          projectCfg.EnvPrefix,
-		SecretKey:          secretKey,
-		MigrationTimestamp: migrationTimestamp,
+	return nil
+}
+
+// generate runs every generation step inside the project root derived from
+// projectCfg, which Generate points at the staging directory.
+func (g *projectGenerator) generate(ctx context.Context, projectCfg ProjectConfig) error {
+	logger := zerolog.Ctx(ctx)
+
+	projectRoot := filepath.Join(projectCfg.OutputPath, projectCfg.ProjectName)
+
+	if projectCfg.Starter != "" {
+		starter, cleanup, err := g.withStarter(projectCfg.Starter, projectCfg.StarterVars)
+		if err != nil {
+			return err
+		}
+		defer cleanup()
+		g = starter
+	}
+
+	data, err := newTemplateData(projectCfg, time.Now())
+	if err != nil {
+		logger.Error().
+			Err(err).
+			Msg("failed to prepare template data")
+		return err
 	}
+	data.Vars = g.vars
+	g = g.withFeatures(data.Features)
+
+	// rendered maps each output file to its template for the manifest.
+	rendered := make(map[string]string)
+
+	cache := newStepCache(projectCfg.NoCache)
+	plan := g.externalSteps(projectRoot, data, rendered, cache)
+
+	progress := newPhaseProgress(projectCfg, plan)
+	defer progress.done()
+	progress.start(phaseTemplates)
 
 	logger.Info().
 		Str("project", projectCfg.ProjectName).
@@ -87,138 +180,19 @@ func (g *projectGenerator) Generate(ctx context.Context, cfg any) error {
 		return fmt.Errorf("failed to create project directories: %w", err)
 	}
 
-	preGenerateTemplates := map[string]string{
-		".env.example.tmpl":                        ".env.example",
-		".env.tmpl":                                ".env",
-		".gitignore.tmpl":                          ".gitignore",
-		".golangci.yml.tmpl":                       ".golangci.yml",
-		".mockery.yaml.tmpl":                       ".mockery.yaml",
-		".tracks.yaml.tmpl":                        ".tracks.yaml",
-		".air.toml.tmpl":                           ".air.toml",
-		".dockerignore.tmpl":                       ".dockerignore",
-		".github/workflows/ci.yml.tmpl":            ".github/workflows/ci.yml",
-		"go.mod.tmpl":                              "go.mod",
-		"Makefile.tmpl":                            "Makefile",
-		"README.md.tmpl":                           "README.md",
-		"Dockerfile.tmpl":                          "Dockerfile",
-		"sqlc.yaml.tmpl":                           "sqlc.yaml",
-		"docker-compose.yml.tmpl":                  "docker-compose.yml",
-		"package.json.tmpl":                        "package.json",
-		"internal/config/config.go.tmpl":           "internal/config/config.go",
-		"internal/interfaces/health.go.tmpl":       "internal/interfaces/health.go",
-		"internal/interfaces/logger.go.tmpl":       "internal/interfaces/logger.go",
-		"internal/logging/logger.go.tmpl":          "internal/logging/logger.go",
-		"internal/assets/embed.go.tmpl":            "internal/assets/embed.go",
-		"internal/domain/health/service.go.tmpl":   "internal/domain/health/service.go",
-		"internal/http/routes/routes.go.tmpl":      "internal/http/routes/routes.go",
-		"internal/http/routes/health.go.tmpl":      "internal/http/routes/health.go",
-		"internal/http/routes/web.go.tmpl":         "internal/http/routes/web.go",
-		// "internal/http/routes/users.go.tmpl":       "internal/http/routes/users.go", // Example: HYPERMEDIA routes with helpers (not generated by default)
-		"internal/http/handlers/health.go.tmpl":        "internal/http/handlers/health.go",
-		"internal/http/helpers/context.go.tmpl":        "internal/http/helpers/context.go",
-		"internal/http/views/helpers.go.tmpl":          "internal/http/views/helpers.go",
-		"internal/http/views/layouts/base.templ.tmpl":        "internal/http/views/layouts/base.templ",
-		"internal/http/views/components/nav.templ.tmpl":      "internal/http/views/components/nav.templ",
-		"internal/http/views/components/footer.templ.tmpl":   "internal/http/views/components/footer.templ",
-		"internal/http/views/components/meta.templ.tmpl":     "internal/http/views/components/meta.templ",
-		"internal/http/views/components/counter.templ.tmpl":     "internal/http/views/components/counter.templ",
-		"internal/http/views/components/htmx_config.templ.tmpl": "internal/http/views/components/htmx_config.templ",
-		"internal/http/views/pages/home.templ.tmpl":           "internal/http/views/pages/home.templ",
-		"internal/http/views/pages/about.templ.tmpl":          "internal/http/views/pages/about.templ",
-		"internal/http/views/pages/error.templ.tmpl":          "internal/http/views/pages/error.templ",
-		"internal/http/middleware/logging.go.tmpl":          "internal/http/middleware/logging.go",
-		"internal/http/middleware/security.go.tmpl":         "internal/http/middleware/security.go",
-		"internal/http/middleware/compress.go.tmpl":         "internal/http/middleware/compress.go",
-		"internal/http/middleware/cache.go.tmpl":            "internal/http/middleware/cache.go",
-		"internal/http/middleware/timeout.go.tmpl":          "internal/http/middleware/timeout.go",
-		"internal/http/middleware/throttle.go.tmpl":         "internal/http/middleware/throttle.go",
-		"internal/http/middleware/cors.go.tmpl":             "internal/http/middleware/cors.go",
-		"internal/http/middleware/security_headers.go.tmpl": "internal/http/middleware/security_headers.go",
-		"internal/http/middleware/middleware.go.tmpl":       "internal/http/middleware/middleware.go",
-		"internal/db/db.go.tmpl":             "internal/db/db.go",
-		"internal/db/migrate.go.tmpl":        "internal/db/migrate.go",
-		"cmd/migrate/main.go.tmpl":           "cmd/migrate/main.go",
-		"internal/db/queries/.gitkeep.tmpl":  "internal/db/queries/.gitkeep",
-		"internal/db/queries/health.sql.tmpl":           "internal/db/queries/health.sql",
-		"internal/assets/web/images/.gitkeep.tmpl":      "internal/assets/web/images/.gitkeep",
-		"internal/assets/web/css/app.css.tmpl":          "internal/assets/web/css/app.css",
-		"internal/assets/web/js/lib/htmx.js.tmpl":       "internal/assets/web/js/lib/htmx.js",
-		"internal/assets/web/js/app.js.tmpl":            "internal/assets/web/js/app.js",
-		"internal/assets/dist/.gitkeep.tmpl":            "internal/assets/dist/.gitkeep",
-		"internal/assets/dist/images/.gitkeep.tmpl":     "internal/assets/dist/images/.gitkeep",
-		"internal/assets/dist/css/app.css.tmpl":         "internal/assets/dist/css/app.css",
-		"internal/assets/dist/js/app.js.tmpl":           "internal/assets/dist/js/app.js",
-		".templui.json.tmpl":                              ".templui.json",
-		"internal/pkg/identifier/uuid.go.tmpl":            "internal/pkg/identifier/uuid.go",
-		"internal/pkg/slug/slug.go.tmpl":                  "internal/pkg/slug/slug.go",
-	}
-
-	postGenerateTemplates := map[string]string{
-		"cmd/server/main.go.tmpl":                       "cmd/server/main.go",
-		"internal/domain/health/repository.go.tmpl":    "internal/domain/health/repository.go",
-		"internal/http/server.go.tmpl":                 "internal/http/server.go",
-		"internal/http/routes.go.tmpl":                 "internal/http/routes.go",
-		"internal/http/helpers/render.go.tmpl":         "internal/http/helpers/render.go",
-		"internal/http/handlers/home.go.tmpl":          "internal/http/handlers/home.go",
-		"internal/http/handlers/about.go.tmpl":         "internal/http/handlers/about.go",
-		"internal/http/handlers/error.go.tmpl":         "internal/http/handlers/error.go",
-		"internal/http/handlers/counter.go.tmpl":       "internal/http/handlers/counter.go",
-	}
-
-	testTemplates := map[string]string{
-		"internal/config/config_test.go.tmpl":                   "internal/config/config_test.go",
-		"internal/logging/logger_test.go.tmpl":                  "internal/logging/logger_test.go",
-		"internal/assets/embed_test.go.tmpl":                    "internal/assets/embed_test.go",
-		"internal/domain/health/service_test.go.tmpl":           "internal/domain/health/service_test.go",
-		"internal/http/server_test.go.tmpl":                     "internal/http/server_test.go",
-		"internal/http/handlers/health_test.go.tmpl":            "internal/http/handlers/health_test.go",
-		"internal/http/views/components/nav_test.go.tmpl":       "internal/http/views/components/nav_test.go",
-		"internal/http/views/components/footer_test.go.tmpl":    "internal/http/views/components/footer_test.go",
-		"internal/http/views/components/meta_test.go.tmpl":      "internal/http/views/components/meta_test.go",
-		"internal/http/views/components/counter_test.go.tmpl":   "internal/http/views/components/counter_test.go",
-		"tests/integration/pages_test.go.tmpl":                  "tests/integration/pages_test.go",
-		"tests/integration/error_test.go.tmpl":                  "tests/integration/error_test.go",
-		"internal/http/middleware/cache_test.go.tmpl":           "internal/http/middleware/cache_test.go",
-		"internal/pkg/identifier/uuid_test.go.tmpl":             "internal/pkg/identifier/uuid_test.go",
-		"internal/pkg/slug/slug_test.go.tmpl":                   "internal/pkg/slug/slug_test.go",
-		// "internal/http/routes/users_test.go.tmpl":     "internal/http/routes/users_test.go", // Example: HYPERMEDIA route tests (not generated by default)
-	}
-
 	logger.Info().
-		Int("template_count", len(preGenerateTemplates)).
+		Int("template_count", len(g.sets.pre)).
 		Msg("rendering pre-generate templates")
 
-	for templateName, outputFile := range preGenerateTemplates {
-		outputPath := filepath.Join(projectRoot, outputFile)
-
-		logger.Debug().
-			Str("template", templateName).
-			Str("output", outputPath).
-			Msg("rendering template")
-
-		if err := g.renderer.RenderToFile(templateName, data, outputPath); err != nil {
-			logger.Error().
-				Err(err).
-				Str("template", templateName).
-				Str("output", outputPath).
-				Msg("template rendering failed")
-			return fmt.Errorf("failed to render %s: %w", templateName, err)
-		}
+	if err := g.renderTemplateSet(ctx, g.sets.pre, data, projectRoot, rendered); err != nil {
+		return err
 	}
 
 	logger.Info().Msg("pre-generate templates rendered successfully")
 
 	logger.Info().Msg("rendering initial migration templates")
-	migrationTemplates := []struct {
-		template string
-		dir      string
-	}{
-		{"internal/db/migrations/sqlite/initial_schema.sql.tmpl", "internal/db/migrations/sqlite"},
-		{"internal/db/migrations/postgres/initial_schema.sql.tmpl", "internal/db/migrations/postgres"},
-	}
-
-	for _, m := range migrationTemplates {
-		outputFile := fmt.Sprintf("%s/%s_initial_schema.sql", m.dir, migrationTimestamp)
+	for _, m := range initialMigrationTemplates {
+		outputFile := initialMigrationFile(m.dir, data.MigrationTimestamp)
 		outputPath := filepath.Join(projectRoot, outputFile)
 
 		logger.Debug().
@@ -234,151 +208,205 @@ func (g *projectGenerator) Generate(ctx context.Context, cfg any) error {
 				Msg("migration template rendering failed")
 			return fmt.Errorf("failed to render %s: %w", m.template, err)
 		}
+		rendered[outputFile] = m.template
 	}
 	logger.Info().Msg("initial migration templates rendered successfully")
+	progress.finish(phaseTemplates)
 
-	logger.Info().Msg("tidying dependencies")
-	tidyCmd := exec.CommandContext(ctx, "go", "mod", "tidy")
-	tidyCmd.Dir = projectRoot
-	if output, err := tidyCmd.CombinedOutput(); err != nil {
-		logger.Warn().
-			Err(err).
-			Str("output", string(output)).
-			Msg("go mod tidy failed - continuing anyway")
-	} else {
-		logger.Info().Msg("dependencies tidied")
-	}
+	plan = progress.track(plan)
 
-	logger.Info().Msg("downloading all dependencies including tools")
-	downloadCmd := exec.CommandContext(ctx, "go", "mod", "download", "all")
-	downloadCmd.Dir = projectRoot
-	if output, err := downloadCmd.CombinedOutput(); err != nil {
-		logger.Warn().
-			Err(err).
-			Str("output", string(output)).
-			Msg("go mod download all failed - continuing anyway")
-	} else {
-		logger.Info().Msg("all dependencies downloaded and go.sum populated")
+	logger.Info().
+		Int("step_count", len(plan)).
+		Msg("running generation steps")
+
+	_, err = steps.Run(ctx, plan, func(result steps.Result) {
+		logStepResult(logger, result)
+		progress.stepDone(result)
+		if projectCfg.OnStep != nil {
+			projectCfg.OnStep(result)
+		}
+	})
+	if err != nil {
+		if ctx.Err() != nil {
+			return canceled(ctx)
+		}
+		return err
 	}
 
-	logger.Info().Msg("initializing templUI and installing utils")
-	initCmd := exec.CommandContext(ctx, "go", "tool", "templui", "-f", "init")
-	initCmd.Dir = projectRoot
-	if output, err := initCmd.CombinedOutput(); err != nil {
-		logger.Warn().
-			Err(err).
-			Str("output", string(output)).
-			Msg("templui init failed - components may not work without utils")
-	} else {
-		logger.Info().Msg("templUI initialized and utils installed")
+	if err := canceled(ctx); err != nil {
+		return err
 	}
 
 	logger.Info().
-		Int("component_count", len(TemplUIComponents)).
-		Msg("installing templUI components")
-	templuiArgs := append([]string{"tool", "templui", "add"}, TemplUIComponents...)
-	templuiCmd := exec.CommandContext(ctx, "go", templuiArgs...)
-	templuiCmd.Dir = projectRoot
-	if output, err := templuiCmd.CombinedOutput(); err != nil {
-		logger.Warn().
+		Int("file_count", len(rendered)).
+		Msg("recording generation manifest")
+	if err := writeGenerationManifest(g.templates, g.starterTemplates, projectRoot, rendered, time.Now()); err != nil {
+		logger.Error().
 			Err(err).
-			Str("output", string(output)).
-			Msg("templui add failed - project will work but without UI components")
-	} else {
-		logger.Info().Msg("templUI components installed")
+			Msg("failed to write generation manifest")
+		return fmt.Errorf("failed to write generation manifest: %w", err)
 	}
 
-	logger.Info().Msg("formatting templUI files")
-	componentsDir := filepath.Join(projectRoot, "internal", "http", "views", "components")
-	gofmtCmd := exec.CommandContext(ctx, "gofmt", "-w", componentsDir)
-	gofmtCmd.Dir = projectRoot
-	if output, err := gofmtCmd.CombinedOutput(); err != nil {
-		logger.Warn().
-			Err(err).
-			Str("output", string(output)).
-			Msg("gofmt failed - some files may have formatting issues")
-	} else {
-		logger.Info().Msg("templUI files formatted")
-	}
+	if projectCfg.InitGit {
+		progress.start(phaseGit)
+		defer progress.finish(phaseGit)
 
-	logger.Info().Msg("generating mocks and SQL code")
-	generateCmd := exec.CommandContext(ctx, "make", "generate")
-	generateCmd.Dir = projectRoot
-	if output, err := generateCmd.CombinedOutput(); err != nil {
-		logger.Error().
-			Err(err).
-			Str("output", string(output)).
-			Msg("make generate failed")
-		return fmt.Errorf("failed to generate mocks and SQL code: %w", err)
+		logger.Info().
+			Str("path", projectRoot).
+			Msg("initializing git repository")
+
+		if err := InitializeGit(ctx, g.runner, projectRoot, false); err != nil {
+			logger.Warn().
+				Err(err).
+				Str("path", projectRoot).
+				Msg("git initialization failed - continuing without git")
+		} else {
+			logger.Info().Msg("git repository initialized")
+		}
 	} else {
-		logger.Info().Msg("mocks and SQL code generated successfully")
+		logger.Debug().Msg("skipping git initialization (--no-git)")
 	}
 
-	logger.Info().Msg("installing npm dependencies")
-	npmInstallCmd := exec.CommandContext(ctx, "npm", "install")
-	npmInstallCmd.Dir = projectRoot
-	if output, err := npmInstallCmd.CombinedOutput(); err != nil {
-		logger.Warn().
-			Err(err).
-			Str("output", string(output)).
-			Msg("npm install failed - assets may not be built")
-	} else {
-		logger.Info().Msg("npm dependencies installed")
+	return nil
+}
+
+// Names of the external generation steps.
+const (
+	stepTidy          = "go mod tidy"
+	stepDownload      = "go mod download"
+	stepTemplUI       = "templui"
+	stepFormat        = "gofmt components"
+	stepGenerate      = "make generate"
+	stepNPMInstall    = "npm install"
+	stepAssets        = "make assets"
+	stepPostTemplates = "post-generate templates"
+	stepPostTidy      = "go mod tidy (post-generate)"
+	stepTestTemplates = "test templates"
+	stepTestTidy      = "go mod tidy (tests)"
+)
+
+// externalSteps returns the generation steps that follow the initial
+// templates. npm install only needs package.json, so it runs alongside the
+// Go module, templUI and code generation chain; make assets waits for both
+// so Tailwind sees every rendered source file.
+// Only make generate and the template sets are required, as before.
+// Projects without templUI skip its steps, and projects without Node.js the
+// npm and assets steps.
+func (g *projectGenerator) externalSteps(projectRoot string, data template.TemplateData, rendered map[string]string, cache stepCache) []steps.Step {
+	command := func(step, name string, args ...string) func(context.Context) error {
+		return func(ctx context.Context) error {
+			return g.runCommand(ctx, step, projectRoot, name, args...)
+		}
+	}
+	renderSet := func(set map[string]string) func(context.Context) error {
+		return func(ctx context.Context) error {
+			return g.renderTemplateSet(ctx, set, data, projectRoot, rendered)
+		}
 	}
+	componentsDir := filepath.Join(projectRoot, "internal", "http", "views", "components")
+	features := data.Features
 
-	logger.Info().Msg("building assets")
-	assetsCmd := exec.CommandContext(ctx, "make", "assets")
-	assetsCmd.Dir = projectRoot
-	if output, err := assetsCmd.CombinedOutput(); err != nil {
-		logger.Warn().
-			Err(err).
-			Str("output", string(output)).
-			Msg("make assets failed - assets may not be built")
-	} else {
-		logger.Info().Msg("assets built successfully")
+	plan := []steps.Step{
+		{Name: stepTidy, Optional: true, Run: command(stepTidy, "go", "mod", "tidy")},
+		{Name: stepDownload, Optional: true, DependsOn: []string{stepTidy}, Run: command(stepDownload, "go", "mod", "download", "all")},
+	}
+	generateAfter := stepDownload
+	if features.UI {
+		plan = append(plan,
+			steps.Step{Name: stepTemplUI, Optional: true, DependsOn: []string{stepDownload}, Run: func(ctx context.Context) error {
+				return g.installTemplUI(ctx, projectRoot, cache)
+			}},
+			steps.Step{Name: stepFormat, Optional: true, DependsOn: []string{stepTemplUI}, Run: command(stepFormat, "gofmt", "-w", componentsDir)},
+		)
+		generateAfter = stepFormat
 	}
+	plan = append(plan, steps.Step{Name: stepGenerate, DependsOn: []string{generateAfter}, Run: command(stepGenerate, "make", "generate")})
+	if features.Node {
+		plan = append(plan,
+			steps.Step{Name: stepNPMInstall, Optional: true, Run: command(stepNPMInstall, "npm", "install", "--prefer-offline", "--no-audit", "--no-fund")},
+			steps.Step{Name: stepAssets, Optional: true, DependsOn: []string{stepNPMInstall, stepPostTemplates}, Run: command(stepAssets, "make", "assets")},
+		)
+	}
+	return append(plan,
+		steps.Step{Name: stepPostTemplates, DependsOn: []string{stepGenerate}, Run: renderSet(g.sets.post)},
+		steps.Step{Name: stepPostTidy, Optional: true, DependsOn: []string{stepPostTemplates}, Run: command(stepPostTidy, "go", "mod", "tidy")},
+		steps.Step{Name: stepTestTemplates, DependsOn: []string{stepPostTidy}, Run: renderSet(g.sets.test)},
+		steps.Step{Name: stepTestTidy, Optional: true, DependsOn: []string{stepTestTemplates}, Run: command(stepTestTidy, "go", "mod", "tidy")},
+	)
+}
 
-	logger.Info().
-		Int("template_count", len(postGenerateTemplates)).
-		Msg("rendering post-generate templates")
+// templUIPaths are the files templUI writes, relative to the project root,
+// as configured in .templui.json.
+var templUIPaths = []string{
+	".templui.json",
+	"go.mod",
+	"go.sum",
+	"internal/http/views/components/ui",
+	"internal/http/views/components/utils",
+	"internal/assets/web/js",
+}
 
-	for templateName, outputFile := range postGenerateTemplates {
-		outputPath := filepath.Join(projectRoot, outputFile)
+// installTemplUI initializes templUI and adds TemplUIComponents, restoring
+// the files from cache when an earlier generation, of this or any other
+// project, used the same templUI version, configuration and components.
+// templUI downloads every component on each run, which makes it one of the
+// slowest steps.
+func (g *projectGenerator) installTemplUI(ctx context.Context, projectRoot string, cache stepCache) error {
+	logger := zerolog.Ctx(ctx)
 
-		logger.Debug().
-			Str("template", templateName).
-			Str("output", outputPath).
-			Msg("rendering template")
+	codec, err := newTemplUICodec(projectRoot)
+	if err != nil {
+		return err
+	}
+	key, err := codec.key(TemplUIComponents)
+	if err != nil {
+		return err
+	}
+	if key == "" {
+		// Without a templUI version the output can't be keyed.
+		cache = stepCache{}
+	}
 
-		if err := g.renderer.RenderToFile(templateName, data, outputPath); err != nil {
-			logger.Error().
-				Err(err).
-				Str("template", templateName).
-				Str("output", outputPath).
-				Msg("template rendering failed")
-			return fmt.Errorf("failed to render %s: %w", templateName, err)
-		}
+	if hit, err := cache.restore(key, projectRoot, codec); err != nil {
+		logger.Warn().
+			Err(err).
+			Msg("templUI cache restore failed - installing components")
+	} else if hit {
+		logger.Info().Msg("templUI components restored from cache")
+		return nil
+	}
+
+	before, err := snapshotFiles(projectRoot, templUIPaths)
+	if err != nil {
+		return err
 	}
 
-	logger.Info().Msg("post-generate templates rendered successfully")
+	if err := g.runCommand(ctx, stepTemplUI, projectRoot, "go", "tool", "templui", "-f", "init"); err != nil {
+		return fmt.Errorf("templui init failed: %w", err)
+	}
+	addArgs := append([]string{"tool", "templui", "add"}, TemplUIComponents...)
+	if err := g.runCommand(ctx, stepTemplUI, projectRoot, "go", addArgs...); err != nil {
+		return fmt.Errorf("templui add failed: %w", err)
+	}
 
-	logger.Info().Msg("tidying dependencies after post-generate templates")
-	tidyCmd = exec.CommandContext(ctx, "go", "mod", "tidy")
-	tidyCmd.Dir = projectRoot
-	if output, err := tidyCmd.CombinedOutput(); err != nil {
+	after, err := snapshotFiles(projectRoot, templUIPaths)
+	if err != nil {
+		return err
+	}
+	if err := cache.save(key, projectRoot, changedFiles(before, after), codec); err != nil {
 		logger.Warn().
 			Err(err).
-			Str("output", string(output)).
-			Msg("go mod tidy (after post-generate) failed - continuing anyway")
-	} else {
-		logger.Info().Msg("dependencies tidied")
+			Msg("failed to cache templUI components")
 	}
+	return nil
+}
 
-	logger.Info().
-		Int("template_count", len(testTemplates)).
-		Msg("rendering test templates")
+// renderTemplateSet renders every template in set into projectRoot and
+// records each output file in rendered.
+func (g *projectGenerator) renderTemplateSet(ctx context.Context, set map[string]string, data template.TemplateData, projectRoot string, rendered map[string]string) error {
+	logger := zerolog.Ctx(ctx)
 
-	for templateName, outputFile := range testTemplates {
+	for templateName, outputFile := range set {
 		outputPath := filepath.Join(projectRoot, outputFile)
 
 		logger.Debug().
@@ -386,7 +414,7 @@ func (g *projectGenerator) Generate(ctx context.Context, cfg any) error {
 			Str("output", outputPath).
 			Msg("rendering template")
 
-		if err := g.renderer.RenderToFile(templateName, data, outputPath); err != nil {
+		if err := g.renderProjectFile(templateName, data, projectRoot, outputFile); err != nil {
 			logger.Error().
 				Err(err).
 				Str("template", templateName).
@@ -394,42 +422,65 @@ func (g *projectGenerator) Generate(ctx context.Context, cfg any) error {
 				Msg("template rendering failed")
 			return fmt.Errorf("failed to render %s: %w", templateName, err)
 		}
+		rendered[outputFile] = templateName
 	}
 
-	logger.Info().Msg("test templates rendered successfully")
+	return nil
+}
 
-	logger.Info().Msg("running go mod tidy to pick up test dependencies")
-	tidyCmd = exec.CommandContext(ctx, "go", "mod", "tidy")
-	tidyCmd.Dir = projectRoot
-	if output, err := tidyCmd.CombinedOutput(); err != nil {
-		logger.Warn().
+// runCommand runs an external command in dir as part of step, streaming
+// its output and logging it if the command fails.
+func (g *projectGenerator) runCommand(ctx context.Context, step, dir, name string, args ...string) error {
+	result, err := g.runner.Run(ctx, interfaces.Command{Name: name, Args: args, Dir: dir, Step: step})
+	if err != nil {
+		zerolog.Ctx(ctx).Warn().
 			Err(err).
-			Str("output", string(output)).
-			Msg("go mod tidy (after test templates) failed - continuing anyway")
+			Str("step", step).
+			Str("output", string(result.Output)).
+			Msg("command failed")
+		return err
 	}
+	return nil
+}
 
-	if projectCfg.InitGit {
+// logStepResult logs a finished generation step with its duration.
+func logStepResult(logger *zerolog.Logger, result steps.Result) {
+	switch {
+	case result.Skipped:
+		logger.Debug().
+			Str("step", result.Name).
+			Msg("step skipped")
+	case result.Err != nil && result.Optional:
+		logger.Warn().
+			Err(result.Err).
+			Str("step", result.Name).
+			Dur("duration", result.Duration).
+			Msg("step failed - continuing anyway")
+	case result.Err != nil:
+		logger.Error().
+			Err(result.Err).
+			Str("step", result.Name).
+			Dur("duration", result.Duration).
+			Msg("step failed")
+	default:
 		logger.Info().
-			Str("path", projectRoot).
-			Msg("initializing git repository")
-
-		if err := InitializeGit(ctx, projectRoot, false); err != nil {
-			logger.Warn().
-				Err(err).
-				Str("path", projectRoot).
-				Msg("git initialization failed - continuing without git")
-		} else {
-			logger.Info().Msg("git repository initialized")
-		}
-	} else {
-		logger.Debug().Msg("skipping git initialization (--no-git)")
+			Str("step", result.Name).
+			Dur("duration", result.Duration).
+			Msg("step complete")
 	}
+}
 
-	logger.Info().
-		Str("project", projectCfg.ProjectName).
-		Str("path", projectRoot).
-		Msg("project generation complete")
+// stagingDirPrefix names the hidden directory a project is built in before
+// it is moved into place.
+const stagingDirPrefix = ".tracks-new-"
 
+// canceled returns an error once ctx is done. External steps that are allowed
+// to fail only log a warning, so it is checked between steps to stop an
+// interrupted generation promptly.
+func canceled(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("project generation canceled: %w", err)
+	}
 	return nil
 }
 
@@ -439,6 +490,10 @@ func (g *projectGenerator) Validate(cfg any) error {
 		return fmt.Errorf("invalid config type: expected ProjectConfig, got %T", cfg)
 	}
 
+	if _, err := projectCfg.Features(); err != nil {
+		return err
+	}
+
 	projectRoot := filepath.Join(projectCfg.OutputPath, projectCfg.ProjectName)
 
 	if _, err := os.Stat(projectRoot); err == nil {
@@ -450,6 +505,37 @@ func (g *projectGenerator) Validate(cfg any) error {
 	return nil
 }
 
+// newTemplateData returns the data every project template is rendered with,
+// including a fresh secret key.
+func newTemplateData(projectCfg ProjectConfig, now time.Time) (template.TemplateData, error) {
+	secretKey, err := generateSecretKey()
+	if err != nil {
+		return template.TemplateData{}, err
+	}
+
+	features, err := projectCfg.Features()
+	if err != nil {
+		return template.TemplateData{}, err
+	}
+
+	goVersion := projectCfg.GoVersion
+	if goVersion == "" {
+		goVersion = DefaultGoVersion
+	}
+
+	return template.TemplateData{
+		ModuleName:         projectCfg.ModulePath,
+		ProjectName:        projectCfg.ProjectName,
+		DBDriver:           projectCfg.DatabaseDriver,
+		GoVersion:          goVersion,
+		Year:               now.Year(),
+		EnvPrefix:          projectCfg.EnvPrefix,
+		SecretKey:          secretKey,
+		MigrationTimestamp: now.Format("20060102150405"),
+		Features:           features,
+	}, nil
+}
+
 func generateSecretKey() (string, error) {
 	b := make([]byte, 32)
 	if _, err := rand.Read(b); err != nil {
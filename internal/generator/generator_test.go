@@ -3,6 +3,7 @@ package generator
 import (
 	"context"
 	"encoding/base64"
+	"errors"
 	"go/parser"
 	"go/token"
 	"os"
@@ -10,14 +11,18 @@ import (
 	"testing"
 	"time"
 
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	generatorinterfaces "github.com/anomalousventures/tracks/internal/generator/interfaces"
 	"github.com/anomalousventures/tracks/internal/generator/template"
+	"github.com/anomalousventures/tracks/internal/process"
+	"github.com/anomalousventures/tracks/internal/process/processtest"
 	"github.com/anomalousventures/tracks/internal/templates"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
 func TestNewProjectGenerator(t *testing.T) {
-	gen := NewProjectGenerator()
+	gen := NewProjectGenerator(process.NewRunner())
 	assert.NotNil(t, gen)
 }
 
@@ -33,7 +38,7 @@ func TestProjectGenerator_Generate_Success(t *testing.T) {
 		OutputPath:     tmpDir,
 	}
 
-	gen := NewProjectGenerator()
+	gen := NewProjectGenerator(process.NewRunner())
 	ctx := context.Background()
 
 	err := gen.Generate(ctx, cfg)
@@ -48,6 +53,7 @@ func TestProjectGenerator_Generate_Success(t *testing.T) {
 		".golangci.yml",
 		".mockery.yaml",
 		".tracks.yaml",
+		".tracks/manifest.json",
 		".templui.json",
 		".env.example",
 		"Makefile",
@@ -91,7 +97,7 @@ func TestProjectGenerator_Generate_WithGit(t *testing.T) {
 		OutputPath:     tmpDir,
 	}
 
-	gen := NewProjectGenerator()
+	gen := NewProjectGenerator(process.NewRunner())
 	ctx := context.Background()
 
 	err := gen.Generate(ctx, cfg)
@@ -104,7 +110,7 @@ func TestProjectGenerator_Generate_WithGit(t *testing.T) {
 }
 
 func TestProjectGenerator_Generate_InvalidConfig(t *testing.T) {
-	gen := NewProjectGenerator()
+	gen := NewProjectGenerator(process.NewRunner())
 	ctx := context.Background()
 
 	err := gen.Generate(ctx, "not a ProjectConfig")
@@ -124,7 +130,7 @@ func TestProjectGenerator_Generate_TemplateData(t *testing.T) {
 		OutputPath:     tmpDir,
 	}
 
-	gen := NewProjectGenerator()
+	gen := NewProjectGenerator(process.NewRunner())
 	ctx := context.Background()
 
 	err := gen.Generate(ctx, cfg)
@@ -140,6 +146,65 @@ func TestProjectGenerator_Generate_TemplateData(t *testing.T) {
 	assert.Contains(t, string(content), "go 1.25")
 }
 
+func TestNewTemplateData_GoVersion(t *testing.T) {
+	data, err := newTemplateData(ProjectConfig{ProjectName: "myapp"}, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, DefaultGoVersion, data.GoVersion)
+
+	data, err = newTemplateData(ProjectConfig{ProjectName: "myapp", GoVersion: "1.24.3"}, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, "1.24.3", data.GoVersion)
+}
+
+func TestProjectGenerator_Generate_CanceledCleansUp(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	cfg := ProjectConfig{
+		ProjectName:    "testapp",
+		ModulePath:     "github.com/test/testapp",
+		DatabaseDriver: "go-libsql",
+		EnvPrefix:      "APP",
+		OutputPath:     tmpDir,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := NewProjectGenerator(process.NewRunner()).Generate(ctx, cfg)
+	require.ErrorIs(t, err, context.Canceled)
+
+	entries, readErr := os.ReadDir(tmpDir)
+	require.NoError(t, readErr)
+	assert.Empty(t, entries, "no project or staging directory should be left behind")
+
+	assert.NoError(t, NewProjectGenerator(process.NewRunner()).Validate(cfg), "a failed run should not block the next one")
+}
+
+func TestProjectGenerator_Generate_KeepOnFailure(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	cfg := ProjectConfig{
+		ProjectName:    "testapp",
+		ModulePath:     "github.com/test/testapp",
+		DatabaseDriver: "go-libsql",
+		EnvPrefix:      "APP",
+		OutputPath:     tmpDir,
+		KeepOnFailure:  true,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := NewProjectGenerator(process.NewRunner()).Generate(ctx, cfg)
+	require.ErrorIs(t, err, context.Canceled)
+	assert.ErrorContains(t, err, "partial project kept at")
+
+	assert.NoDirExists(t, filepath.Join(tmpDir, "testapp"))
+	matches, globErr := filepath.Glob(filepath.Join(tmpDir, stagingDirPrefix+"testapp-*", "testapp", "go.mod"))
+	require.NoError(t, globErr)
+	assert.Len(t, matches, 1, "partial project should be kept in the staging directory")
+}
+
 func TestProjectGenerator_Validate_Success(t *testing.T) {
 	tmpDir := t.TempDir()
 
@@ -152,7 +217,7 @@ func TestProjectGenerator_Validate_Success(t *testing.T) {
 		OutputPath:     tmpDir,
 	}
 
-	gen := NewProjectGenerator()
+	gen := NewProjectGenerator(process.NewRunner())
 
 	err := gen.Validate(cfg)
 	assert.NoError(t, err)
@@ -174,7 +239,7 @@ func TestProjectGenerator_Validate_DirectoryExists(t *testing.T) {
 		OutputPath:     tmpDir,
 	}
 
-	gen := NewProjectGenerator()
+	gen := NewProjectGenerator(process.NewRunner())
 
 	err = gen.Validate(cfg)
 	assert.Error(t, err)
@@ -182,7 +247,7 @@ func TestProjectGenerator_Validate_DirectoryExists(t *testing.T) {
 }
 
 func TestProjectGenerator_Validate_InvalidConfig(t *testing.T) {
-	gen := NewProjectGenerator()
+	gen := NewProjectGenerator(process.NewRunner())
 
 	err := gen.Validate("not a ProjectConfig")
 	assert.Error(t, err)
@@ -209,7 +274,7 @@ func TestProjectGenerator_Generate_AllDatabaseDrivers(t *testing.T) {
 				OutputPath:     tmpDir,
 			}
 
-			gen := NewProjectGenerator()
+			gen := NewProjectGenerator(process.NewRunner())
 			ctx := context.Background()
 
 			err := gen.Generate(ctx, cfg)
@@ -419,7 +484,7 @@ func TestProjectGenerator_Generate_TempluiConfig(t *testing.T) {
 		OutputPath:     tmpDir,
 	}
 
-	gen := NewProjectGenerator()
+	gen := NewProjectGenerator(process.NewRunner())
 	ctx := context.Background()
 
 	err := gen.Generate(ctx, cfg)
@@ -452,7 +517,7 @@ func TestProjectGenerator_ExampleTemplatesNotGenerated(t *testing.T) {
 		OutputPath:     tmpDir,
 	}
 
-	gen := NewProjectGenerator()
+	gen := NewProjectGenerator(process.NewRunner())
 	ctx := context.Background()
 
 	err := gen.Generate(ctx, cfg)
@@ -477,3 +542,224 @@ func TestProjectGenerator_ExampleTemplatesNotGenerated(t *testing.T) {
 	assert.Error(t, err, "users_test.go should NOT be generated (example template only)")
 	assert.True(t, os.IsNotExist(err), "users_test.go should not exist")
 }
+
+// fakeProjectConfig returns a config for a project in a temp directory. The
+// step cache is disabled so fake runs never restore or save templUI files.
+func fakeProjectConfig(t *testing.T) ProjectConfig {
+	t.Helper()
+	return ProjectConfig{
+		ProjectName:    "testapp",
+		ModulePath:     "github.com/test/testapp",
+		DatabaseDriver: "go-libsql",
+		EnvPrefix:      "APP",
+		OutputPath:     t.TempDir(),
+		NoCache:        true,
+	}
+}
+
+// failingRenderer fails to render one template and renders the rest.
+type failingRenderer struct {
+	generatorinterfaces.TemplateRenderer
+	template string
+}
+
+func (r failingRenderer) RenderToFile(name string, data any, outputPath string) error {
+	if name == r.template {
+		return errors.New("template exploded")
+	}
+	return r.TemplateRenderer.RenderToFile(name, data, outputPath)
+}
+
+func TestProjectGenerator_Generate_FakeRunner(t *testing.T) {
+	runner := processtest.NewRunner()
+	cfg := fakeProjectConfig(t)
+	cfg.InitGit = true
+
+	err := NewProjectGenerator(runner).Generate(context.Background(), cfg)
+	require.NoError(t, err)
+
+	lines := runner.Lines()
+	for _, want := range []string{
+		"go mod tidy",
+		"go mod download all",
+		"go tool templui -f init",
+		"make generate",
+		"npm install --prefer-offline --no-audit --no-fund",
+		"make assets",
+		"git init",
+		"git commit -m Initial commit from Tracks",
+	} {
+		assert.Contains(t, lines, want)
+	}
+	for _, cmd := range runner.Calls() {
+		assert.Equal(t, filepath.Base(cmd.Dir), "testapp", "%s should run in the project root", cmd.Name)
+	}
+	assert.FileExists(t, filepath.Join(cfg.OutputPath, "testapp", "cmd/server/main.go"))
+}
+
+func TestProjectGenerator_Generate_RequiredStepFails(t *testing.T) {
+	runner := processtest.NewRunner()
+	runner.Respond("make generate", processtest.Response{
+		Stderr: "internal/http/views/home.templ: syntax error\n",
+		Err:    errors.New("exit status 2"),
+	})
+	cfg := fakeProjectConfig(t)
+
+	err := NewProjectGenerator(runner).Generate(context.Background(), cfg)
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "make generate")
+	assert.ErrorContains(t, err, "home.templ: syntax error")
+
+	var procErr *process.Error
+	assert.ErrorAs(t, err, &procErr)
+	assert.NotContains(t, runner.Lines(), "make assets", "steps after make generate should be skipped")
+
+	entries, readErr := os.ReadDir(cfg.OutputPath)
+	require.NoError(t, readErr)
+	assert.Empty(t, entries, "no project or staging directory should be left behind")
+}
+
+func TestProjectGenerator_Generate_RequiredStepFailsKeepOnFailure(t *testing.T) {
+	runner := processtest.NewRunner()
+	runner.Respond("make generate", processtest.Response{Err: errors.New("exit status 2")})
+	cfg := fakeProjectConfig(t)
+	cfg.KeepOnFailure = true
+
+	err := NewProjectGenerator(runner).Generate(context.Background(), cfg)
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "partial project kept at")
+	assert.NoDirExists(t, filepath.Join(cfg.OutputPath, "testapp"))
+}
+
+func TestProjectGenerator_Generate_OptionalStepFails(t *testing.T) {
+	tests := []struct {
+		name    string
+		command string
+		skipped string
+	}{
+		{name: "go mod tidy", command: "go mod tidy"},
+		{name: "go mod download", command: "go mod download"},
+		{name: "templui init", command: "go tool templui -f init", skipped: "go tool templui add"},
+		{name: "templui add", command: "go tool templui add"},
+		{name: "gofmt", command: "gofmt"},
+		{name: "npm install", command: "npm install"},
+		{name: "make assets", command: "make assets"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := processtest.NewRunner()
+			runner.Respond(tt.command, processtest.Response{Err: errors.New("exit status 1")})
+			cfg := fakeProjectConfig(t)
+
+			err := NewProjectGenerator(runner).Generate(context.Background(), cfg)
+			require.NoError(t, err, "an optional step failing should not fail generation")
+			assert.DirExists(t, filepath.Join(cfg.OutputPath, "testapp"))
+			assert.Contains(t, runner.Lines(), "make generate")
+
+			if tt.skipped != "" {
+				for _, line := range runner.Lines() {
+					assert.NotContains(t, line, tt.skipped)
+				}
+			}
+		})
+	}
+}
+
+func TestProjectGenerator_Generate_GitFails(t *testing.T) {
+	runner := processtest.NewRunner()
+	runner.Respond("git init", processtest.Response{
+		Stderr: "fatal: cannot create directory\n",
+		Err:    errors.New("exit status 128"),
+	})
+	cfg := fakeProjectConfig(t)
+	cfg.InitGit = true
+
+	err := NewProjectGenerator(runner).Generate(context.Background(), cfg)
+	require.NoError(t, err, "git failures should not fail generation")
+	assert.DirExists(t, filepath.Join(cfg.OutputPath, "testapp"))
+	assert.NotContains(t, runner.Lines(), "git add .")
+}
+
+func TestProjectGenerator_Generate_OutputPathIsFile(t *testing.T) {
+	cfg := fakeProjectConfig(t)
+	cfg.OutputPath = filepath.Join(cfg.OutputPath, "file")
+	require.NoError(t, os.WriteFile(cfg.OutputPath, nil, 0644))
+
+	err := NewProjectGenerator(processtest.NewRunner()).Generate(context.Background(), cfg)
+	assert.ErrorContains(t, err, "failed to create output directory")
+}
+
+func TestProjectGenerator_Generate_StarterFails(t *testing.T) {
+	runner := processtest.NewRunner()
+	cfg := fakeProjectConfig(t)
+	cfg.Starter = filepath.Join(t.TempDir(), "missing")
+
+	err := NewProjectGenerator(runner).Generate(context.Background(), cfg)
+	require.Error(t, err)
+	assert.Empty(t, runner.Calls(), "no command should run")
+
+	entries, readErr := os.ReadDir(cfg.OutputPath)
+	require.NoError(t, readErr)
+	assert.Empty(t, entries)
+}
+
+func TestProjectGenerator_Generate_RenderFails(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		ran      string
+	}{
+		{name: "pre-generate template", template: ".env.example.tmpl"},
+		{name: "initial migration", template: initialMigrationTemplates[0].template},
+		{name: "post-generate template", template: "cmd/server/main.go.tmpl", ran: "make generate"},
+		{name: "test template", template: "internal/config/config_test.go.tmpl", ran: "make generate"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := processtest.NewRunner()
+			gen := NewProjectGenerator(runner).(*projectGenerator)
+			gen.renderer = failingRenderer{TemplateRenderer: gen.renderer, template: tt.template}
+			cfg := fakeProjectConfig(t)
+
+			err := gen.Generate(context.Background(), cfg)
+			require.Error(t, err)
+			assert.ErrorContains(t, err, "failed to render "+tt.template)
+			assert.ErrorContains(t, err, "template exploded")
+
+			if tt.ran == "" {
+				assert.Empty(t, runner.Calls(), "no command should run before the initial templates")
+			} else {
+				assert.Contains(t, runner.Lines(), tt.ran)
+			}
+			assert.NoDirExists(t, filepath.Join(cfg.OutputPath, "testapp"))
+		})
+	}
+}
+
+func TestProjectGenerator_Generate_TargetExists(t *testing.T) {
+	cfg := fakeProjectConfig(t)
+	projectRoot := filepath.Join(cfg.OutputPath, "testapp")
+	require.NoError(t, os.MkdirAll(projectRoot, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(projectRoot, "keep.txt"), []byte("mine"), 0644))
+
+	err := NewProjectGenerator(processtest.NewRunner()).Generate(context.Background(), cfg)
+	assert.ErrorContains(t, err, "failed to move project into place")
+	assert.FileExists(t, filepath.Join(projectRoot, "keep.txt"), "existing files should be untouched")
+}
+
+func TestProjectGenerator_Generate_CanceledDuringStep(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	runner := processtest.NewRunner()
+	runner.Respond("make generate", processtest.Response{
+		Do: func(interfaces.Command) { cancel() },
+	})
+	cfg := fakeProjectConfig(t)
+
+	err := NewProjectGenerator(runner).Generate(ctx, cfg)
+	require.ErrorIs(t, err, context.Canceled)
+	assert.NoDirExists(t, filepath.Join(cfg.OutputPath, "testapp"))
+}
@@ -3,51 +3,51 @@ package generator
 import (
 	"context"
 	"fmt"
-	"os/exec"
 	"strings"
 
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
 	"github.com/rs/zerolog"
 )
 
-func InitializeGit(ctx context.Context, projectPath string, skipGit bool) error {
+// InitializeGit creates a git repository in projectPath with an initial commit
+// of every file, running git through runner. It does nothing if skipGit is set.
+func InitializeGit(ctx context.Context, runner interfaces.CommandRunner, projectPath string, skipGit bool) error {
 	if skipGit {
 		return nil
 	}
 
-	if err := runGitCommand(ctx, projectPath, "init"); err != nil {
+	if err := runGitCommand(ctx, runner, projectPath, "init"); err != nil {
 		return fmt.Errorf("failed to initialize git repository: %w", err)
 	}
 
-	if err := runGitCommand(ctx, projectPath, "config", "--local", "user.name", "Tracks"); err != nil {
+	if err := runGitCommand(ctx, runner, projectPath, "config", "--local", "user.name", "Tracks"); err != nil {
 		return fmt.Errorf("failed to configure git user: %w", err)
 	}
 
-	if err := runGitCommand(ctx, projectPath, "config", "--local", "user.email", "info@anomalous.ventures"); err != nil {
+	if err := runGitCommand(ctx, runner, projectPath, "config", "--local", "user.email", "info@anomalous.ventures"); err != nil {
 		return fmt.Errorf("failed to configure git user: %w", err)
 	}
 
-	if err := runGitCommand(ctx, projectPath, "add", "."); err != nil {
+	if err := runGitCommand(ctx, runner, projectPath, "add", "."); err != nil {
 		return fmt.Errorf("failed to stage files: %w", err)
 	}
 
-	if err := runGitCommand(ctx, projectPath, "commit", "-m", "Initial commit from Tracks"); err != nil {
+	if err := runGitCommand(ctx, runner, projectPath, "commit", "-m", "Initial commit from Tracks"); err != nil {
 		return fmt.Errorf("failed to create initial commit: %w", err)
 	}
 
 	return nil
 }
 
-func runGitCommand(ctx context.Context, projectPath string, args ...string) error {
+func runGitCommand(ctx context.Context, runner interfaces.CommandRunner, projectPath string, args ...string) error {
 	logger := zerolog.Ctx(ctx)
-	cmd := exec.Command("git", args...)
-	cmd.Dir = projectPath
 
-	output, err := cmd.CombinedOutput()
+	result, err := runner.Run(ctx, interfaces.Command{Name: "git", Args: args, Dir: projectPath, Step: "git"})
 	if err != nil {
 		logger.Error().
 			Err(err).
 			Str("command", fmt.Sprintf("git %s", strings.Join(args, " "))).
-			Str("output", string(output)).
+			Str("output", string(result.Output)).
 			Str("dir", projectPath).
 			Msg("git command failed")
 		return err
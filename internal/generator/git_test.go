@@ -2,11 +2,15 @@ package generator
 
 import (
 	"context"
+	"errors"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/anomalousventures/tracks/internal/process"
+	"github.com/anomalousventures/tracks/internal/process/processtest"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -15,7 +19,7 @@ func TestInitializeGit_SkipGit(t *testing.T) {
 	tmpDir := t.TempDir()
 	ctx := context.Background()
 
-	err := InitializeGit(ctx, tmpDir, true)
+	err := InitializeGit(ctx, process.NewRunner(), tmpDir, true)
 	require.NoError(t, err)
 
 	gitDir := filepath.Join(tmpDir, ".git")
@@ -35,7 +39,7 @@ func TestInitializeGit_Success(t *testing.T) {
 	err := os.WriteFile(testFile, []byte("test content"), 0644)
 	require.NoError(t, err)
 
-	err = InitializeGit(ctx, tmpDir, false)
+	err = InitializeGit(ctx, process.NewRunner(), tmpDir, false)
 	require.NoError(t, err)
 
 	gitDir := filepath.Join(tmpDir, ".git")
@@ -62,7 +66,7 @@ func TestInitializeGit_GitNotFound(t *testing.T) {
 	err := os.WriteFile(testFile, []byte("test content"), 0644)
 	require.NoError(t, err)
 
-	err = InitializeGit(ctx, tmpDir, false)
+	err = InitializeGit(ctx, process.NewRunner(), tmpDir, false)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to initialize git repository")
 }
@@ -75,7 +79,7 @@ func TestInitializeGit_InitFails(t *testing.T) {
 	invalidPath := "/this/path/definitely/does/not/exist/and/cannot/be/created"
 	ctx := context.Background()
 
-	err := InitializeGit(ctx, invalidPath, false)
+	err := InitializeGit(ctx, process.NewRunner(), invalidPath, false)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to initialize git repository")
 }
@@ -88,7 +92,7 @@ func TestInitializeGit_EmptyDirectory(t *testing.T) {
 	tmpDir := t.TempDir()
 	ctx := context.Background()
 
-	err := InitializeGit(ctx, tmpDir, false)
+	err := InitializeGit(ctx, process.NewRunner(), tmpDir, false)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to create initial commit")
 }
@@ -105,7 +109,7 @@ func TestInitializeGit_ConfiguresUser(t *testing.T) {
 	err := os.WriteFile(testFile, []byte("test content"), 0644)
 	require.NoError(t, err)
 
-	err = InitializeGit(ctx, tmpDir, false)
+	err = InitializeGit(ctx, process.NewRunner(), tmpDir, false)
 	require.NoError(t, err)
 
 	cmd := exec.Command("git", "config", "--local", "user.name")
@@ -120,3 +124,34 @@ func TestInitializeGit_ConfiguresUser(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, "info@anomalous.ventures\n", string(output))
 }
+
+func TestInitializeGit_FailingCommands(t *testing.T) {
+	tests := []struct {
+		command string
+		wantErr string
+	}{
+		{command: "git init", wantErr: "failed to initialize git repository"},
+		{command: "git config --local user.name", wantErr: "failed to configure git user"},
+		{command: "git config --local user.email", wantErr: "failed to configure git user"},
+		{command: "git add", wantErr: "failed to stage files"},
+		{command: "git commit", wantErr: "failed to create initial commit"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.command, func(t *testing.T) {
+			runner := processtest.NewRunner()
+			runner.Respond(tt.command, processtest.Response{
+				Stderr: "fatal: something went wrong\n",
+				Err:    errors.New("exit status 128"),
+			})
+
+			err := InitializeGit(context.Background(), runner, t.TempDir(), false)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+			assert.Contains(t, err.Error(), "fatal: something went wrong")
+
+			lines := runner.Lines()
+			assert.True(t, strings.HasPrefix(lines[len(lines)-1], tt.command), "no git command should run after %q fails", tt.command)
+		})
+	}
+}
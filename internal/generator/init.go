@@ -0,0 +1,415 @@
+package generator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	generatorinterfaces "github.com/anomalousventures/tracks/internal/generator/interfaces"
+	"github.com/anomalousventures/tracks/internal/generator/template"
+	"github.com/rs/zerolog"
+	"golang.org/x/mod/modfile"
+)
+
+// ErrNoGoModule is returned by Inspect when no go.mod is found.
+var ErrNoGoModule = errors.New("no go.mod found")
+
+// InitConfig holds the inputs for `tracks init`.
+type InitConfig struct {
+	// ProjectDir is the root of the Go module being adopted.
+	ProjectDir string `json:"project_dir"`
+
+	// ProjectName, ModulePath, DatabaseDriver and EnvPrefix are recorded in
+	// .tracks.yaml.
+	ProjectName    string `json:"project_name"`
+	ModulePath     string `json:"module_path"`
+	DatabaseDriver string `json:"database_driver"`
+	EnvPrefix      string `json:"env_prefix"`
+
+	// Features are recorded in .tracks.yaml and select the Makefile targets
+	// and directories added.
+	Features interfaces.ProjectFeatures `json:"features"`
+
+	// Makefile adds the Tracks Makefile targets the module lacks, or the
+	// whole Makefile if it has none.
+	Makefile bool `json:"makefile"`
+
+	// Directories creates the Tracks directories the module lacks.
+	Directories bool `json:"directories"`
+
+	// Keep lists existing files, relative to ProjectDir, that must not be
+	// changed, typically because the user declined the change.
+	Keep []string `json:"keep,omitempty"`
+}
+
+// DetailNotConfirmed is the detail of an existing file kept because its
+// change was not confirmed.
+const DetailNotConfirmed = "kept, change not confirmed"
+
+// driverImports maps import path prefixes to the database driver they
+// indicate.
+var driverImports = map[string]string{
+	"github.com/tursodatabase/libsql-client-go": "go-libsql",
+	"github.com/tursodatabase/go-libsql":        "go-libsql",
+	"github.com/mattn/go-sqlite3":               "sqlite3",
+	"modernc.org/sqlite":                        "sqlite3",
+	"github.com/lib/pq":                         "postgres",
+	"github.com/jackc/pgx":                      "postgres",
+}
+
+// initChange is a file or directory Init writes. Content is nil for
+// directories and files left as they are.
+type initChange struct {
+	file    interfaces.GeneratedFile
+	content *string
+}
+
+type projectInitializer struct {
+	renderer generatorinterfaces.TemplateRenderer
+}
+
+// NewProjectInitializer creates an initializer that adopts existing Go
+// modules as Tracks projects.
+func NewProjectInitializer() interfaces.ProjectInitializer {
+	return &projectInitializer{}
+}
+
+// Inspect searches upward from dir for go.mod, then reads the module path
+// from it, the database driver from the imports of the module's Go files,
+// and its features from the files it has.
+func (i *projectInitializer) Inspect(ctx context.Context, dir string) (*interfaces.ModuleInfo, error) {
+	logger := zerolog.Ctx(ctx)
+
+	moduleDir, err := findModuleDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := os.ReadFile(filepath.Join(moduleDir, "go.mod"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read go.mod: %w", err)
+	}
+	modulePath := modfile.ModulePath(content)
+	if modulePath == "" {
+		return nil, errors.New("go.mod has no module directive")
+	}
+
+	drivers, err := importedDrivers(moduleDir)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &interfaces.ModuleInfo{
+		Dir:        moduleDir,
+		ModulePath: modulePath,
+		Drivers:    drivers,
+		Features:   existingFeatures(moduleDir),
+	}
+	if len(drivers) == 1 {
+		info.DBDriver = drivers[0]
+	}
+
+	logger.Debug().
+		Str("dir", moduleDir).
+		Str("module", modulePath).
+		Strs("drivers", drivers).
+		Msg("inspected Go module")
+
+	return info, nil
+}
+
+// findModuleDir returns the closest directory at or above dir containing
+// go.mod.
+func findModuleDir(dir string) (string, error) {
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve path: %w", err)
+	}
+
+	for {
+		if _, err := os.Stat(filepath.Join(absDir, "go.mod")); err == nil {
+			return absDir, nil
+		}
+		parent := filepath.Dir(absDir)
+		if parent == absDir {
+			return "", ErrNoGoModule
+		}
+		absDir = parent
+	}
+}
+
+// importedDrivers returns the database drivers imported by the Go files of
+// the module in moduleDir, sorted. Hidden directories, vendor, testdata and
+// node_modules are skipped, as are nested modules.
+func importedDrivers(moduleDir string) ([]string, error) {
+	fset := token.NewFileSet()
+	var drivers []string
+
+	err := filepath.WalkDir(moduleDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if path == moduleDir {
+				return nil
+			}
+			name := d.Name()
+			if strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_") || name == "vendor" || name == "testdata" || name == "node_modules" {
+				return filepath.SkipDir
+			}
+			if _, err := os.Stat(filepath.Join(path, "go.mod")); err == nil {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(path, ".go") {
+			return nil
+		}
+
+		file, err := parser.ParseFile(fset, path, nil, parser.ImportsOnly)
+		if err != nil {
+			// Files that do not parse cannot tell which driver is used.
+			return nil
+		}
+		for _, spec := range file.Imports {
+			importPath, _ := strconv.Unquote(spec.Path.Value)
+			for prefix, driver := range driverImports {
+				if (importPath == prefix || strings.HasPrefix(importPath, prefix+"/")) && !slices.Contains(drivers, driver) {
+					drivers = append(drivers, driver)
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan Go files: %w", err)
+	}
+
+	slices.Sort(drivers)
+	return drivers, nil
+}
+
+// existingFeatures returns the features of the module in moduleDir judged
+// by the files it has. The HTMX examples are never assumed.
+func existingFeatures(moduleDir string) interfaces.ProjectFeatures {
+	exists := func(name string) bool {
+		_, err := os.Stat(filepath.Join(moduleDir, filepath.FromSlash(name)))
+		return err == nil
+	}
+
+	features := interfaces.ProjectFeatures{
+		UI:     exists(".templui.json"),
+		Node:   exists("package.json"),
+		Docker: exists("Dockerfile"),
+		CI:     CINone,
+	}
+	switch {
+	case exists(".github/workflows"):
+		features.CI = CIGitHub
+	case exists(".gitlab-ci.yml"):
+		features.CI = CIGitLab
+	}
+	return features
+}
+
+func (i *projectInitializer) Plan(ctx context.Context, cfg any) ([]interfaces.GeneratedFile, error) {
+	changes, err := i.changes(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]interfaces.GeneratedFile, 0, len(changes))
+	for _, change := range changes {
+		files = append(files, change.file)
+	}
+	return files, nil
+}
+
+func (i *projectInitializer) Init(ctx context.Context, cfg any) ([]interfaces.GeneratedFile, error) {
+	logger := zerolog.Ctx(ctx)
+
+	changes, err := i.changes(cfg)
+	if err != nil {
+		return nil, err
+	}
+	initCfg := cfg.(InitConfig)
+
+	files := make([]interfaces.GeneratedFile, 0, len(changes))
+	for _, change := range changes {
+		file := change.file
+		path := filepath.Join(initCfg.ProjectDir, filepath.FromSlash(strings.TrimSuffix(file.Path, "/")))
+
+		switch {
+		case file.Action == interfaces.FileActionUpdate && slices.Contains(initCfg.Keep, file.Path):
+			file.Action, file.Detail = interfaces.FileActionSkip, DetailNotConfirmed
+		case file.Action == interfaces.FileActionSkip:
+		case strings.HasSuffix(file.Path, "/"):
+			if err := os.MkdirAll(path, 0755); err != nil {
+				return files, fmt.Errorf("failed to create directory %s: %w", file.Path, err)
+			}
+		default:
+			if err := writeProjectFile(path, *change.content); err != nil {
+				return files, err
+			}
+		}
+		files = append(files, file)
+
+		logger.Debug().
+			Str("file", file.Path).
+			Str("action", file.Action).
+			Msg("initialized file")
+	}
+
+	logger.Info().
+		Str("project", initCfg.ProjectName).
+		Int("file_count", len(files)).
+		Msg("project initialized")
+
+	return files, nil
+}
+
+// changes works out what Init writes for cfg.
+func (i *projectInitializer) changes(cfg any) ([]initChange, error) {
+	initCfg, ok := cfg.(InitConfig)
+	if !ok {
+		return nil, fmt.Errorf("invalid config type: expected InitConfig, got %T", cfg)
+	}
+	if initCfg.ProjectName == "" || initCfg.ModulePath == "" {
+		return nil, errors.New("project name and module path are required")
+	}
+	switch initCfg.DatabaseDriver {
+	case "go-libsql", "sqlite3", "postgres":
+	default:
+		return nil, fmt.Errorf("unsupported database driver %q", initCfg.DatabaseDriver)
+	}
+
+	features := orFull(initCfg.Features)
+	data := template.TemplateData{
+		ModuleName:  initCfg.ModulePath,
+		ProjectName: initCfg.ProjectName,
+		DBDriver:    initCfg.DatabaseDriver,
+		GoVersion:   projectGoVersion(initCfg.ProjectDir, ""),
+		EnvPrefix:   initCfg.EnvPrefix,
+		Features:    features,
+	}
+	renderer := rendererOr(i.renderer, initCfg.ProjectDir)
+
+	tracksYAML, err := renderer.Render(".tracks.yaml.tmpl", data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render .tracks.yaml.tmpl: %w", err)
+	}
+	change, err := replaceChange(initCfg.ProjectDir, tracksConfigFile, tracksYAML)
+	if err != nil {
+		return nil, err
+	}
+	changes := []initChange{change}
+
+	change, err = adoptedManifestChange(initCfg.ProjectDir, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if change.content != nil {
+		changes = append(changes, change)
+	}
+
+	if initCfg.Makefile {
+		makefile, err := renderer.Render("Makefile.tmpl", data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to render Makefile.tmpl: %w", err)
+		}
+		change, err := makefileChange(initCfg.ProjectDir, makefile)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, change)
+	}
+
+	if initCfg.Directories {
+		for _, dir := range projectDirectories(features) {
+			info, err := os.Stat(filepath.Join(initCfg.ProjectDir, filepath.FromSlash(dir)))
+			if err == nil && info.IsDir() {
+				continue
+			}
+			changes = append(changes, initChange{file: interfaces.GeneratedFile{Path: dir + "/", Action: interfaces.FileActionCreate}})
+		}
+	}
+
+	return changes, nil
+}
+
+// replaceChange writes content to outputFile, replacing the file if it
+// exists.
+func replaceChange(projectDir, outputFile, content string) (initChange, error) {
+	change := initChange{file: interfaces.GeneratedFile{Path: outputFile}}
+
+	current, exists, err := readOptional(filepath.Join(projectDir, filepath.FromSlash(outputFile)))
+	if err != nil {
+		return change, err
+	}
+	switch {
+	case !exists:
+		change.file.Action, change.content = interfaces.FileActionCreate, &content
+	case current == content:
+		change.file.Action, change.file.Detail = interfaces.FileActionSkip, DetailUpToDate
+	default:
+		change.file.Action, change.file.Detail, change.content = interfaces.FileActionUpdate, "replaces the existing file", &content
+	}
+	return change, nil
+}
+
+// adoptedManifestChange writes an empty manifest marking the project as
+// adopted, so upgrades leave the module's own files alone. Projects that
+// already have a manifest keep it; the change then has no content.
+func adoptedManifestChange(projectDir string, now time.Time) (initChange, error) {
+	change := initChange{file: interfaces.GeneratedFile{Path: manifestFile, Action: interfaces.FileActionCreate}}
+
+	_, exists, err := readOptional(filepath.Join(projectDir, filepath.FromSlash(manifestFile)))
+	if err != nil || exists {
+		return change, err
+	}
+
+	m := newManifest()
+	m.Adopted = true
+	content, err := m.encode(now)
+	if err != nil {
+		return change, err
+	}
+	change.content = &content
+	return change, nil
+}
+
+// makefileChange writes the rendered Makefile template if the project has
+// no Makefile, or appends the targets its Makefile lacks.
+func makefileChange(projectDir, rendered string) (initChange, error) {
+	change := initChange{file: interfaces.GeneratedFile{Path: "Makefile"}}
+
+	current, exists, err := readOptional(filepath.Join(projectDir, "Makefile"))
+	if err != nil {
+		return change, err
+	}
+	if !exists {
+		change.file.Action, change.content = interfaces.FileActionCreate, &rendered
+		return change, nil
+	}
+
+	missing := missingMakeTargets(current, rendered)
+	if len(missing) == 0 {
+		change.file.Action, change.file.Detail = interfaces.FileActionSkip, "has every Tracks target"
+		return change, nil
+	}
+
+	updated := appendMakeTargets(current, rendered, missing)
+	change.file.Action, change.content = interfaces.FileActionUpdate, &updated
+	change.file.Detail = "adds targets: " + strings.Join(missing, ", ")
+	return change, nil
+}
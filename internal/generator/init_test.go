@@ -0,0 +1,172 @@
+package generator
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testGoMod = "module github.com/example/legacy\n\ngo 1.25\n"
+
+func newTestInitConfig(projectDir string) InitConfig {
+	return InitConfig{
+		ProjectDir:     projectDir,
+		ProjectName:    "legacy",
+		ModulePath:     "github.com/example/legacy",
+		DatabaseDriver: "postgres",
+		EnvPrefix:      "APP",
+		Features:       interfaces.ProjectFeatures{CI: CINone},
+	}
+}
+
+func TestNewProjectInitializer(t *testing.T) {
+	assert.NotNil(t, NewProjectInitializer())
+}
+
+func TestProjectInitializer_Inspect(t *testing.T) {
+	projectDir := t.TempDir()
+	writeTestFile(t, projectDir, "go.mod", testGoMod)
+	writeTestFile(t, projectDir, "Dockerfile", "FROM scratch\n")
+	writeTestFile(t, projectDir, ".github/workflows/test.yml", "on: push\n")
+	writeTestFile(t, projectDir, "internal/store/store.go", "package store\n\nimport (\n\t\"database/sql\"\n\n\t_ \"github.com/jackc/pgx/v5/stdlib\"\n)\n\nvar _ sql.DB\n")
+	writeTestFile(t, projectDir, "vendor/github.com/mattn/go-sqlite3/x.go", "package sqlite3\n\nimport _ \"github.com/mattn/go-sqlite3\"\n")
+	writeTestFile(t, projectDir, "tools/go.mod", "module github.com/example/legacy/tools\n")
+	writeTestFile(t, projectDir, "tools/tools.go", "package tools\n\nimport _ \"github.com/lib/pq\"\n")
+	writeTestFile(t, projectDir, "broken.go", "package main\n\nimport (")
+
+	info, err := NewProjectInitializer().Inspect(context.Background(), filepath.Join(projectDir, "internal"))
+	require.NoError(t, err)
+
+	assert.Equal(t, projectDir, info.Dir)
+	assert.Equal(t, "github.com/example/legacy", info.ModulePath)
+	assert.Equal(t, "postgres", info.DBDriver)
+	assert.Equal(t, interfaces.ProjectFeatures{Docker: true, CI: CIGitHub}, info.Features)
+}
+
+func TestProjectInitializer_Inspect_Drivers(t *testing.T) {
+	tests := []struct {
+		name    string
+		imports []string
+		want    string
+		drivers []string
+	}{
+		{name: "libsql", imports: []string{"github.com/tursodatabase/libsql-client-go/libsql"}, want: "go-libsql", drivers: []string{"go-libsql"}},
+		{name: "sqlite3", imports: []string{"github.com/mattn/go-sqlite3"}, want: "sqlite3", drivers: []string{"sqlite3"}},
+		{name: "modernc sqlite", imports: []string{"modernc.org/sqlite"}, want: "sqlite3", drivers: []string{"sqlite3"}},
+		{name: "lib/pq", imports: []string{"github.com/lib/pq"}, want: "postgres", drivers: []string{"postgres"}},
+		{name: "none", imports: []string{"net/http"}},
+		{name: "ambiguous", imports: []string{"github.com/lib/pq", "github.com/mattn/go-sqlite3"}, drivers: []string{"postgres", "sqlite3"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			projectDir := t.TempDir()
+			writeTestFile(t, projectDir, "go.mod", testGoMod)
+			for i, imp := range tt.imports {
+				writeTestFile(t, projectDir, filepath.Join("db", string(rune('a'+i))+".go"), "package db\n\nimport _ \""+imp+"\"\n")
+			}
+
+			info, err := NewProjectInitializer().Inspect(context.Background(), projectDir)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, info.DBDriver)
+			assert.Equal(t, tt.drivers, info.Drivers)
+		})
+	}
+}
+
+func TestProjectInitializer_Inspect_NoModule(t *testing.T) {
+	_, err := NewProjectInitializer().Inspect(context.Background(), t.TempDir())
+	assert.ErrorIs(t, err, ErrNoGoModule)
+}
+
+func TestProjectInitializer_Init_EmptyModule(t *testing.T) {
+	projectDir := t.TempDir()
+	writeTestFile(t, projectDir, "go.mod", testGoMod)
+
+	cfg := newTestInitConfig(projectDir)
+	cfg.Makefile = true
+	cfg.Directories = true
+	files, err := NewProjectInitializer().Init(context.Background(), cfg)
+	require.NoError(t, err)
+
+	assert.Equal(t, interfaces.GeneratedFile{Path: tracksConfigFile, Action: interfaces.FileActionCreate}, files[0])
+	assert.Equal(t, interfaces.GeneratedFile{Path: manifestFile, Action: interfaces.FileActionCreate}, files[1])
+	assert.Equal(t, interfaces.GeneratedFile{Path: "Makefile", Action: interfaces.FileActionCreate}, files[2])
+	assert.Len(t, files, 3+len(projectDirectories(cfg.Features)))
+
+	tracksYAML := readTestFile(t, projectDir, tracksConfigFile)
+	assert.Contains(t, tracksYAML, `module_path: "github.com/example/legacy"`)
+	assert.Contains(t, tracksYAML, `database_driver: "postgres"`)
+	assert.Contains(t, tracksYAML, `ci: "none"`)
+
+	assert.Contains(t, readTestFile(t, projectDir, "Makefile"), "MIGRATE_DIR := internal/db/migrations/postgres")
+	assert.DirExists(t, filepath.Join(projectDir, "internal", "db", "queries"))
+
+	m, err := ReadManifest(projectDir)
+	require.NoError(t, err)
+	assert.True(t, m.Adopted)
+	assert.Empty(t, m.Files, "adopted files are not generated files")
+	assert.NoDirExists(t, filepath.Join(projectDir, baseSnapshotDir))
+}
+
+func TestProjectInitializer_ExistingFiles(t *testing.T) {
+	projectDir := t.TempDir()
+	writeTestFile(t, projectDir, "go.mod", testGoMod)
+	writeTestFile(t, projectDir, tracksConfigFile, "project:\n  name: \"old\"\n")
+	writeTestFile(t, projectDir, "Makefile", testMakefile)
+	require.NoError(t, os.MkdirAll(filepath.Join(projectDir, "cmd", "server"), 0755))
+
+	cfg := newTestInitConfig(projectDir)
+	cfg.Makefile = true
+	cfg.Directories = true
+	initializer := NewProjectInitializer()
+
+	plan, err := initializer.Plan(context.Background(), cfg)
+	require.NoError(t, err)
+
+	tracksYAML := findFile(t, plan, tracksConfigFile)
+	assert.Equal(t, interfaces.FileActionUpdate, tracksYAML.Action)
+	makefile := findFile(t, plan, "Makefile")
+	assert.Equal(t, interfaces.FileActionUpdate, makefile.Action)
+	assert.Contains(t, makefile.Detail, "adds targets: help, assets, ci, clean, dev, generate")
+	assert.NotContains(t, makefile.Detail, "build")
+	for _, f := range plan {
+		assert.NotEqual(t, "cmd/server/", f.Path)
+	}
+	assert.Equal(t, testMakefile, readTestFile(t, projectDir, "Makefile"), "Plan must not write")
+
+	cfg.Keep = []string{tracksConfigFile}
+	files, err := initializer.Init(context.Background(), cfg)
+	require.NoError(t, err)
+
+	kept := findFile(t, files, tracksConfigFile)
+	assert.Equal(t, interfaces.FileActionSkip, kept.Action)
+	assert.Equal(t, DetailNotConfirmed, kept.Detail)
+	assert.Equal(t, "project:\n  name: \"old\"\n", readTestFile(t, projectDir, tracksConfigFile))
+
+	updated := readTestFile(t, projectDir, "Makefile")
+	assert.Contains(t, updated, testMakefile[len(".PHONY: build test"):])
+	assert.Contains(t, updated, "\nMIGRATE_DIR := internal/db/migrations/postgres\n")
+	assert.Contains(t, updated, "\nmigrate-up: ## Apply all pending migrations\n")
+	assert.Equal(t, 1, strings.Count("\n"+updated, "\nbuild:"), "existing targets are not duplicated")
+
+	files, err = initializer.Init(context.Background(), newTestInitConfig(projectDir))
+	require.NoError(t, err)
+	assert.Len(t, files, 1)
+}
+
+func TestProjectInitializer_InvalidConfig(t *testing.T) {
+	cfg := newTestInitConfig(t.TempDir())
+	cfg.DatabaseDriver = "mysql"
+	_, err := NewProjectInitializer().Plan(context.Background(), cfg)
+	assert.ErrorContains(t, err, `unsupported database driver "mysql"`)
+
+	_, err = NewProjectInitializer().Init(context.Background(), CIConfig{})
+	assert.ErrorContains(t, err, "expected InitConfig")
+}
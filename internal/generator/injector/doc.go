@@ -0,0 +1,41 @@
+// Package injector edits the TRACKS marker regions of files in a generated
+// project.
+//
+// Generated files reserve regions for later generators with a pair of marker
+// comments, written in whatever comment syntax the file type uses:
+//
+//	// TRACKS:WEB_ROUTES:BEGIN
+//	// TRACKS:WEB_ROUTES:END
+//
+//	<!-- TRACKS:UI_SCRIPTS:BEGIN -->
+//	<!-- TRACKS:UI_SCRIPTS:END -->
+//
+//	-- TRACKS:SEED:BEGIN
+//	-- TRACKS:SEED:END
+//
+// Entries are inserted just before the END marker, indented to match it, so
+// they appear in the order they were added. Only the lines between the
+// markers are edited, apart from imports added with AddImport, and edits the
+// user made after generation are kept. A Go file that changes is then run
+// through gofmt as a whole, so formatting outside the markers may change
+// too; other files are preserved byte for byte outside the markers.
+//
+// All operations are idempotent. Entries are compared line by line with
+// whitespace collapsed, so an entry that gofmt has realigned still counts as
+// present and is not inserted twice, and duplicate copies of an entry are
+// removed when it is inserted again.
+//
+// Example:
+//
+//	in := injector.New(projectDir)
+//	files, conflicts, err := in.Apply([]injector.File{{
+//	    Path: "internal/http/routes.go",
+//	    Edits: []injector.Edit{{
+//	        Marker: "WEB_ROUTES",
+//	        Lines:  []string{"s.router.Get(routes.PostIndex, postHandler.Index)"},
+//	    }},
+//	}})
+//	if len(conflicts) > 0 {
+//	    injector.ReportConflicts(r, conflicts)
+//	}
+package injector
@@ -0,0 +1,182 @@
+package injector
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Op is the operation an Edit performs on a marker region.
+type Op int
+
+const (
+	// Insert adds the entry before the END marker unless it is already in the
+	// region. Extra copies of the entry are removed.
+	Insert Op = iota
+
+	// Remove deletes every copy of the entry from the region.
+	Remove
+)
+
+// Edit describes a change to one marker region.
+type Edit struct {
+	// Marker is the region name, e.g. "WEB_ROUTES".
+	Marker string
+
+	// Op defaults to Insert.
+	Op Op
+
+	// Lines is the entry. Leading tabs are kept relative to the marker's
+	// indentation.
+	Lines []string
+
+	// Separate puts a blank line between existing region content and an
+	// inserted entry.
+	Separate bool
+}
+
+// ApplyEdits applies edits to the marker regions of content. It returns the
+// updated content and whether anything changed. If any edit targets a
+// missing or malformed region, content is returned unchanged along with the
+// conflicts, and no edit is applied.
+func ApplyEdits(content string, syntax Syntax, edits []Edit) (string, bool, []Conflict) {
+	regions, conflicts := FindRegions(content, syntax)
+
+	var blocking []Conflict
+	for _, edit := range edits {
+		if _, ok := regions[edit.Marker]; ok {
+			continue
+		}
+		blocking = append(blocking, conflictFor(edit.Marker, conflicts))
+	}
+	if len(blocking) > 0 {
+		return content, false, dedupeConflicts(blocking)
+	}
+
+	lines := strings.Split(content, "\n")
+	for _, edit := range edits {
+		// Regions are re-located after every edit because earlier edits
+		// shift line numbers.
+		regions, _ = FindRegions(strings.Join(lines, "\n"), syntax)
+		lines = applyEdit(lines, regions[edit.Marker], edit)
+	}
+
+	updated := strings.Join(lines, "\n")
+	return updated, updated != content, nil
+}
+
+func applyEdit(lines []string, region Region, edit Edit) []string {
+	if len(edit.Lines) == 0 {
+		return lines
+	}
+
+	matches := findEntry(lines, region, edit.Lines)
+
+	keep := 0
+	if edit.Op == Insert {
+		keep = 1
+	}
+
+	// Remove surplus copies back to front so earlier indexes stay valid.
+	for i := len(matches) - 1; i >= keep; i-- {
+		lines = removeEntry(lines, matches[i], len(edit.Lines), region)
+	}
+
+	if edit.Op != Insert || len(matches) > 0 {
+		return lines
+	}
+
+	var block []string
+	if edit.Separate {
+		prev := strings.TrimSpace(lines[region.End-1])
+		if region.End-1 > region.Begin && prev != "" {
+			block = append(block, "")
+		}
+	}
+	for _, l := range edit.Lines {
+		if l == "" {
+			block = append(block, "")
+			continue
+		}
+		block = append(block, region.Indent+l)
+	}
+
+	out := make([]string, 0, len(lines)+len(block))
+	out = append(out, lines[:region.End]...)
+	out = append(out, block...)
+	return append(out, lines[region.End:]...)
+}
+
+// findEntry returns the starting line index of every copy of entry inside
+// the region.
+func findEntry(lines []string, region Region, entry []string) []int {
+	want := make([]string, len(entry))
+	for i, l := range entry {
+		want[i] = collapseSpace(l)
+	}
+
+	var matches []int
+	for start := region.Begin + 1; start+len(entry) <= region.End; start++ {
+		match := true
+		for j := range want {
+			if collapseSpace(lines[start+j]) != want[j] {
+				match = false
+				break
+			}
+		}
+		if match {
+			matches = append(matches, start)
+			start += len(entry) - 1
+		}
+	}
+	return matches
+}
+
+// removeEntry deletes n lines at start, along with a blank separator line
+// before them so that repeated insert/remove cycles do not accumulate blank
+// lines.
+func removeEntry(lines []string, start, n int, region Region) []string {
+	end := start + n
+	if start-1 > region.Begin && strings.TrimSpace(lines[start-1]) == "" {
+		start--
+	} else if end < region.End && strings.TrimSpace(lines[end]) == "" && start-1 == region.Begin {
+		end++
+	}
+	return append(lines[:start:start], lines[end:]...)
+}
+
+func conflictFor(marker string, conflicts []Conflict) Conflict {
+	for _, c := range conflicts {
+		if c.Marker == marker {
+			return c
+		}
+	}
+	return Conflict{Marker: marker, Reason: "marker region not found"}
+}
+
+func dedupeConflicts(conflicts []Conflict) []Conflict {
+	seen := make(map[Conflict]bool, len(conflicts))
+	out := conflicts[:0]
+	for _, c := range conflicts {
+		if !seen[c] {
+			seen[c] = true
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+func collapseSpace(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// String returns the operation name.
+func (o Op) String() string {
+	switch o {
+	case Insert:
+		return "insert"
+	case Remove:
+		return "remove"
+	default:
+		return fmt.Sprintf("Op(%d)", int(o))
+	}
+}
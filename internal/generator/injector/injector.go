@@ -0,0 +1,160 @@
+package injector
+
+import (
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+)
+
+// File lists the edits applied to one project file.
+type File struct {
+	// Path is relative to the project root, using forward slashes.
+	Path string
+
+	// Imports are added to a Go file's import block. Ignored for other files.
+	Imports []string
+
+	// Edits are applied in order.
+	Edits []Edit
+}
+
+// Injector applies marker edits to the files of a project.
+type Injector struct {
+	root string
+}
+
+// New creates an Injector for the project rooted at projectDir.
+func New(projectDir string) *Injector {
+	return &Injector{root: projectDir}
+}
+
+// Apply edits every file or none. Generators usually wire one feature into
+// several files, and a half-wired feature does not compile, so if any file is
+// missing or has a conflicting marker nothing is written, every file is
+// reported as skipped, and the conflicts are returned for display.
+//
+// Go files are formatted with go/format after editing. Every file is edited
+// and formatted in memory before the first one is written, and if a write
+// fails the files already written are restored. The error return is
+// reserved for I/O and formatting failures.
+func (in *Injector) Apply(files []File) ([]interfaces.GeneratedFile, []Conflict, error) {
+	originals := make([]string, len(files))
+	updates := make([]string, len(files))
+	changed := make([]bool, len(files))
+
+	var conflicts []Conflict
+	for i, f := range files {
+		content, err := os.ReadFile(in.path(f.Path))
+		if err != nil {
+			conflicts = append(conflicts, Conflict{Path: f.Path, Reason: "file not found"})
+			continue
+		}
+		originals[i] = string(content)
+
+		updated, fileChanged, fileConflicts := ApplyEdits(originals[i], SyntaxFor(f.Path), f.Edits)
+		for _, c := range fileConflicts {
+			c.Path = f.Path
+			conflicts = append(conflicts, c)
+		}
+
+		if filepath.Ext(f.Path) == ".go" {
+			for _, imp := range f.Imports {
+				var added bool
+				updated, added = AddImport(updated, imp)
+				fileChanged = fileChanged || added
+			}
+		}
+
+		updates[i] = updated
+		changed[i] = fileChanged
+	}
+
+	results := make([]interfaces.GeneratedFile, 0, len(files))
+
+	if len(conflicts) > 0 {
+		detail := conflictDetail(conflicts)
+		for _, f := range files {
+			results = append(results, interfaces.GeneratedFile{Path: f.Path, Action: interfaces.FileActionSkip, Detail: detail})
+		}
+		return results, conflicts, nil
+	}
+
+	for i, f := range files {
+		if !changed[i] || filepath.Ext(f.Path) != ".go" {
+			continue
+		}
+		formatted, err := format.Source([]byte(updates[i]))
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to format %s: %w", f.Path, err)
+		}
+		updates[i] = string(formatted)
+	}
+
+	for i, f := range files {
+		if !changed[i] {
+			results = append(results, interfaces.GeneratedFile{Path: f.Path, Action: interfaces.FileActionSkip, Detail: DetailUnchanged})
+			continue
+		}
+
+		if err := os.WriteFile(in.path(f.Path), []byte(updates[i]), 0644); err != nil {
+			in.restore(files[:i], originals, changed)
+			return nil, nil, fmt.Errorf("failed to write %s: %w", f.Path, err)
+		}
+		results = append(results, interfaces.GeneratedFile{Path: f.Path, Action: interfaces.FileActionUpdate})
+	}
+
+	return results, nil, nil
+}
+
+// restore writes back the original content of the changed files, undoing a
+// partly applied edit. It is best effort: the write error that caused it is
+// the one reported.
+func (in *Injector) restore(files []File, originals []string, changed []bool) {
+	for i, f := range files {
+		if changed[i] {
+			_ = os.WriteFile(in.path(f.Path), []byte(originals[i]), 0644)
+		}
+	}
+}
+
+// DetailUnchanged is the GeneratedFile detail for files whose regions already
+// contained every entry.
+const DetailUnchanged = "already up to date"
+
+func (in *Injector) path(rel string) string {
+	return filepath.Join(in.root, filepath.FromSlash(rel))
+}
+
+func conflictDetail(conflicts []Conflict) string {
+	parts := make([]string, len(conflicts))
+	for i, c := range conflicts {
+		parts[i] = c.Error()
+	}
+	return strings.Join(parts, "; ")
+}
+
+// AddImport adds importPath to a Go file's parenthesized import block and
+// reports whether it was added. Callers run go/format afterwards, which
+// sorts the block.
+func AddImport(content, importPath string) (string, bool) {
+	quoted := `"` + importPath + `"`
+	if strings.Contains(content, quoted) {
+		return content, false
+	}
+
+	start := strings.Index(content, "import (")
+	if start < 0 {
+		return content, false
+	}
+	end := strings.Index(content[start:], "\n)")
+	if end < 0 {
+		return content, false
+	}
+	end += start
+
+	return content[:end] + "\n\t" + quoted + content[end:], true
+}
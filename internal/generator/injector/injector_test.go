@@ -0,0 +1,307 @@
+package injector
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	"github.com/anomalousventures/tracks/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const goSource = `package http
+
+import (
+	"net/http"
+)
+
+func (s *Server) routes() {
+	s.router.Get("/", home)
+
+	// TRACKS:WEB_ROUTES:BEGIN
+	// TRACKS:WEB_ROUTES:END
+
+	const marker = "// TRACKS:FAKE:BEGIN"
+}
+`
+
+func TestFindRegions(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		syntax  Syntax
+		want    []string
+	}{
+		{"go", goSource, GoSyntax, []string{"WEB_ROUTES"}},
+		{"templ", "<body>\n\t<!-- TRACKS:UI_SCRIPTS:BEGIN -->\n\t<!-- TRACKS:UI_SCRIPTS:END -->\n</body>", TemplSyntax, []string{"UI_SCRIPTS"}},
+		{"sql", "-- TRACKS:SEED:BEGIN\n-- TRACKS:SEED:END\n", SQLSyntax, []string{"SEED"}},
+		{"wrong syntax", "-- TRACKS:SEED:BEGIN\n-- TRACKS:SEED:END\n", GoSyntax, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			regions, conflicts := FindRegions(tt.content, tt.syntax)
+			assert.Empty(t, conflicts)
+
+			var got []string
+			for name := range regions {
+				got = append(got, name)
+			}
+			assert.ElementsMatch(t, tt.want, got)
+		})
+	}
+}
+
+func TestFindRegions_Indent(t *testing.T) {
+	regions, _ := FindRegions(goSource, GoSyntax)
+	region := regions["WEB_ROUTES"]
+
+	assert.Equal(t, "\t", region.Indent)
+	assert.Equal(t, region.Begin+1, region.End)
+}
+
+func TestFindRegions_Conflicts(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		reason  string
+	}{
+		{"missing end", "// TRACKS:A:BEGIN\n", "without a matching END"},
+		{"missing begin", "// TRACKS:A:END\n", "without a matching BEGIN"},
+		{"duplicate", "// TRACKS:A:BEGIN\n// TRACKS:A:END\n// TRACKS:A:BEGIN\n// TRACKS:A:END\n", "more than once"},
+		{"reversed", "// TRACKS:A:END\n// TRACKS:A:BEGIN\n", "without a matching"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			regions, conflicts := FindRegions(tt.content, GoSyntax)
+			assert.NotContains(t, regions, "A")
+			require.Len(t, conflicts, 1)
+			assert.Equal(t, "A", conflicts[0].Marker)
+			assert.Contains(t, conflicts[0].Reason, tt.reason)
+		})
+	}
+}
+
+func TestApplyEdits_Insert(t *testing.T) {
+	edit := Edit{Marker: "WEB_ROUTES", Lines: []string{
+		"postHandler := handlers.NewPostHandler()",
+		"s.router.Get(routes.PostIndex, postHandler.Index)",
+	}}
+
+	updated, changed, conflicts := ApplyEdits(goSource, GoSyntax, []Edit{edit})
+	require.Empty(t, conflicts)
+	assert.True(t, changed)
+	assert.Contains(t, updated, "\t// TRACKS:WEB_ROUTES:BEGIN\n\tpostHandler := handlers.NewPostHandler()\n\ts.router.Get(routes.PostIndex, postHandler.Index)\n\t// TRACKS:WEB_ROUTES:END\n")
+
+	again, changed, _ := ApplyEdits(updated, GoSyntax, []Edit{edit})
+	assert.False(t, changed, "inserting the same entry twice should be a no-op")
+	assert.Equal(t, updated, again)
+}
+
+func TestApplyEdits_PreservesContentOutsideRegion(t *testing.T) {
+	edit := Edit{Marker: "WEB_ROUTES", Lines: []string{"s.router.Get(routes.About, about)"}}
+
+	updated, _, _ := ApplyEdits(goSource, GoSyntax, []Edit{edit})
+
+	regions, _ := FindRegions(updated, GoSyntax)
+	lines := strings.Split(updated, "\n")
+	region := regions["WEB_ROUTES"]
+	outside := append(append([]string{}, lines[:region.Begin+1]...), lines[region.End:]...)
+
+	assert.Equal(t, goSource, strings.Join(outside, "\n"))
+}
+
+func TestApplyEdits_AppendsInOrder(t *testing.T) {
+	content := goSource
+	for _, route := range []string{"a", "b", "c"} {
+		content, _, _ = ApplyEdits(content, GoSyntax, []Edit{{Marker: "WEB_ROUTES", Lines: []string{route + "()"}}})
+	}
+
+	assert.Contains(t, content, "\ta()\n\tb()\n\tc()\n\t// TRACKS:WEB_ROUTES:END")
+}
+
+func TestApplyEdits_IgnoresWhitespaceDifferences(t *testing.T) {
+	content := "type Server struct {\n\t// TRACKS:FIELDS:BEGIN\n\tpostService    interfaces.PostService\n\t// TRACKS:FIELDS:END\n}\n"
+
+	_, changed, _ := ApplyEdits(content, GoSyntax, []Edit{{Marker: "FIELDS", Lines: []string{"postService interfaces.PostService"}}})
+	assert.False(t, changed, "entries realigned by gofmt should count as present")
+}
+
+func TestApplyEdits_Dedupes(t *testing.T) {
+	content := "-- TRACKS:SEED:BEGIN\nINSERT INTO a VALUES (1);\nINSERT INTO b VALUES (2);\nINSERT INTO a VALUES (1);\n-- TRACKS:SEED:END\n"
+
+	updated, changed, _ := ApplyEdits(content, SQLSyntax, []Edit{{Marker: "SEED", Lines: []string{"INSERT INTO a VALUES (1);"}}})
+	assert.True(t, changed)
+	assert.Equal(t, "-- TRACKS:SEED:BEGIN\nINSERT INTO a VALUES (1);\nINSERT INTO b VALUES (2);\n-- TRACKS:SEED:END\n", updated)
+}
+
+func TestApplyEdits_Remove(t *testing.T) {
+	entry := []string{"func (s *Server) WithPostService() *Server {", "\treturn s", "}"}
+	insert := Edit{Marker: "SETTERS", Separate: true, Lines: entry}
+	content := "package http\n\nfunc existing() {}\n\n// TRACKS:SETTERS:BEGIN\nfunc (s *Server) WithUserService() *Server {\n\treturn s\n}\n// TRACKS:SETTERS:END\n"
+
+	inserted, _, _ := ApplyEdits(content, GoSyntax, []Edit{insert})
+	assert.Contains(t, inserted, "}\n\nfunc (s *Server) WithPostService() *Server {\n\treturn s\n}\n// TRACKS:SETTERS:END")
+
+	removed, changed, _ := ApplyEdits(inserted, GoSyntax, []Edit{{Marker: "SETTERS", Op: Remove, Lines: entry}})
+	assert.True(t, changed)
+	assert.Equal(t, content, removed, "removing an inserted entry should restore the original")
+
+	_, changed, _ = ApplyEdits(removed, GoSyntax, []Edit{{Marker: "SETTERS", Op: Remove, Lines: entry}})
+	assert.False(t, changed, "removing an absent entry should be a no-op")
+}
+
+func TestApplyEdits_Separate(t *testing.T) {
+	empty := "// TRACKS:A:BEGIN\n// TRACKS:A:END\n"
+
+	updated, _, _ := ApplyEdits(empty, GoSyntax, []Edit{{Marker: "A", Separate: true, Lines: []string{"x()"}}})
+	assert.Equal(t, "// TRACKS:A:BEGIN\nx()\n// TRACKS:A:END\n", updated, "no separator directly after BEGIN")
+
+	updated, _, _ = ApplyEdits(updated, GoSyntax, []Edit{{Marker: "A", Separate: true, Lines: []string{"y()"}}})
+	assert.Equal(t, "// TRACKS:A:BEGIN\nx()\n\ny()\n// TRACKS:A:END\n", updated)
+}
+
+func TestApplyEdits_MissingMarkerChangesNothing(t *testing.T) {
+	edits := []Edit{
+		{Marker: "WEB_ROUTES", Lines: []string{"a()"}},
+		{Marker: "API_ROUTES", Lines: []string{"b()"}},
+	}
+
+	updated, changed, conflicts := ApplyEdits(goSource, GoSyntax, edits)
+	assert.False(t, changed)
+	assert.Equal(t, goSource, updated)
+	require.Len(t, conflicts, 1)
+	assert.Equal(t, "API_ROUTES", conflicts[0].Marker)
+	assert.Equal(t, "marker region not found", conflicts[0].Reason)
+}
+
+func TestInjector_Apply(t *testing.T) {
+	projectDir := t.TempDir()
+	writeFile(t, projectDir, "internal/http/routes.go", goSource)
+	writeFile(t, projectDir, "internal/http/views/layouts/base.templ", "<body>\n\t<!-- TRACKS:UI_SCRIPTS:BEGIN -->\n\t<!-- TRACKS:UI_SCRIPTS:END -->\n</body>\n")
+
+	files := []File{
+		{
+			Path:    "internal/http/routes.go",
+			Imports: []string{"github.com/test/app/internal/http/handlers"},
+			Edits:   []Edit{{Marker: "WEB_ROUTES", Lines: []string{"handlers.Register(s.router)"}}},
+		},
+		{
+			Path:  "internal/http/views/layouts/base.templ",
+			Edits: []Edit{{Marker: "UI_SCRIPTS", Lines: []string{"@ui.ToastScript()"}}},
+		},
+	}
+
+	in := New(projectDir)
+	results, conflicts, err := in.Apply(files)
+	require.NoError(t, err)
+	assert.Empty(t, conflicts)
+	assert.Equal(t, []interfaces.GeneratedFile{
+		{Path: "internal/http/routes.go", Action: interfaces.FileActionUpdate},
+		{Path: "internal/http/views/layouts/base.templ", Action: interfaces.FileActionUpdate},
+	}, results)
+
+	routes := readFile(t, projectDir, "internal/http/routes.go")
+	assert.Contains(t, routes, "import (\n\t\"github.com/test/app/internal/http/handlers\"\n\t\"net/http\"\n)", "go/format should sort the new import")
+	assert.Contains(t, routes, "\thandlers.Register(s.router)\n\t// TRACKS:WEB_ROUTES:END")
+
+	results, _, err = in.Apply(files)
+	require.NoError(t, err)
+	for _, r := range results {
+		assert.Equal(t, interfaces.FileActionSkip, r.Action)
+		assert.Equal(t, DetailUnchanged, r.Detail)
+	}
+}
+
+func TestInjector_Apply_AllOrNothing(t *testing.T) {
+	projectDir := t.TempDir()
+	writeFile(t, projectDir, "internal/http/routes.go", goSource)
+
+	files := []File{
+		{Path: "internal/http/routes.go", Edits: []Edit{{Marker: "WEB_ROUTES", Lines: []string{"a()"}}}},
+		{Path: "cmd/server/main.go", Edits: []Edit{{Marker: "SERVICES", Lines: []string{"b()"}}}},
+	}
+
+	results, conflicts, err := New(projectDir).Apply(files)
+	require.NoError(t, err)
+	require.Len(t, conflicts, 1)
+	assert.Equal(t, Conflict{Path: "cmd/server/main.go", Reason: "file not found"}, conflicts[0])
+
+	for _, r := range results {
+		assert.Equal(t, interfaces.FileActionSkip, r.Action)
+		assert.Contains(t, r.Detail, "cmd/server/main.go: file not found")
+	}
+	assert.Equal(t, goSource, readFile(t, projectDir, "internal/http/routes.go"))
+}
+
+func TestInjector_Apply_FormatFailureWritesNothing(t *testing.T) {
+	projectDir := t.TempDir()
+	writeFile(t, projectDir, "internal/http/routes.go", goSource)
+	writeFile(t, projectDir, "internal/http/admin.go", goSource)
+
+	files := []File{
+		{Path: "internal/http/routes.go", Edits: []Edit{{Marker: "WEB_ROUTES", Lines: []string{"a()"}}}},
+		{Path: "internal/http/admin.go", Edits: []Edit{{Marker: "WEB_ROUTES", Lines: []string{"b("}}}},
+	}
+
+	_, conflicts, err := New(projectDir).Apply(files)
+	require.ErrorContains(t, err, "failed to format internal/http/admin.go")
+	assert.Empty(t, conflicts)
+	assert.Equal(t, goSource, readFile(t, projectDir, "internal/http/routes.go"), "earlier files must not be written")
+	assert.Equal(t, goSource, readFile(t, projectDir, "internal/http/admin.go"))
+}
+
+func TestAddImport(t *testing.T) {
+	updated, added := AddImport(goSource, "github.com/test/app/internal/domain/posts")
+	assert.True(t, added)
+	assert.Contains(t, updated, "\"net/http\"\n\t\"github.com/test/app/internal/domain/posts\"\n)")
+
+	_, added = AddImport(updated, "github.com/test/app/internal/domain/posts")
+	assert.False(t, added)
+
+	_, added = AddImport("package main\n\nimport \"fmt\"\n", "os")
+	assert.False(t, added, "single-line imports are left alone")
+}
+
+func TestReportConflicts(t *testing.T) {
+	mockRenderer := mocks.NewMockRenderer(t)
+	mockRenderer.On("Section", mock.MatchedBy(func(s interfaces.Section) bool {
+		return s.Title == "Marker conflicts" &&
+			strings.Contains(s.Body, "internal/http/routes.go: TRACKS:WEB_ROUTES marker region not found") &&
+			strings.Contains(s.Body, "No files were changed")
+	})).Once()
+
+	ReportConflicts(mockRenderer, []Conflict{{Path: "internal/http/routes.go", Marker: "WEB_ROUTES", Reason: "marker region not found"}})
+}
+
+func TestReportConflicts_None(t *testing.T) {
+	mockRenderer := mocks.NewMockRenderer(t)
+
+	ReportConflicts(mockRenderer, nil)
+}
+
+func TestSyntaxFor(t *testing.T) {
+	assert.Equal(t, GoSyntax, SyntaxFor("cmd/server/main.go"))
+	assert.Equal(t, TemplSyntax, SyntaxFor("views/layouts/base.templ"))
+	assert.Equal(t, SQLSyntax, SyntaxFor("db/queries/posts.sql"))
+	assert.Equal(t, AnySyntax, SyntaxFor("Makefile"))
+}
+
+func writeFile(t *testing.T, dir, rel, content string) {
+	t.Helper()
+	path := filepath.Join(dir, filepath.FromSlash(rel))
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+}
+
+func readFile(t *testing.T, dir, rel string) string {
+	t.Helper()
+	content, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(rel)))
+	require.NoError(t, err)
+	return string(content)
+}
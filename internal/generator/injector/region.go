@@ -0,0 +1,145 @@
+package injector
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+var markerPattern = regexp.MustCompile(`TRACKS:([A-Z0-9_]+):(BEGIN|END)\b`)
+
+// Syntax lists the comment openers a marker line may start with.
+type Syntax []string
+
+// Marker comment syntaxes for the file types Tracks generates.
+var (
+	GoSyntax    = Syntax{"//"}
+	TemplSyntax = Syntax{"//", "<!--"}
+	SQLSyntax   = Syntax{"--"}
+	AnySyntax   = Syntax{"//", "<!--", "--", "#"}
+)
+
+// SyntaxFor returns the marker comment syntax for a file based on its
+// extension. Unknown extensions accept any supported comment style.
+func SyntaxFor(path string) Syntax {
+	switch filepath.Ext(path) {
+	case ".go":
+		return GoSyntax
+	case ".templ":
+		return TemplSyntax
+	case ".sql":
+		return SQLSyntax
+	default:
+		return AnySyntax
+	}
+}
+
+// Region is a named marker region. Begin and End are the line indexes of the
+// marker comments; the region's entries are the lines strictly between them.
+type Region struct {
+	Name   string
+	Begin  int
+	End    int
+	Indent string
+}
+
+// Conflict describes a marker problem that prevents a file from being edited.
+type Conflict struct {
+	// Path is the file the conflict was found in, relative to the project root.
+	Path string
+
+	// Marker is the region name without the TRACKS: prefix and BEGIN/END suffix.
+	Marker string
+
+	// Reason explains the conflict in a form suitable for display.
+	Reason string
+}
+
+func (c Conflict) Error() string {
+	if c.Marker == "" {
+		return fmt.Sprintf("%s: %s", c.Path, c.Reason)
+	}
+	return fmt.Sprintf("%s: TRACKS:%s %s", c.Path, c.Marker, c.Reason)
+}
+
+// FindRegions returns the well-formed marker regions in content, keyed by
+// name, along with conflicts for any malformed markers. Markers that are not
+// at the start of a comment line (for example inside a string literal) are
+// ignored.
+func FindRegions(content string, syntax Syntax) (map[string]Region, []Conflict) {
+	lines := strings.Split(content, "\n")
+	regions := make(map[string]Region)
+	open := make(map[string]int)
+	broken := make(map[string]bool)
+	var conflicts []Conflict
+
+	fail := func(name, reason string) {
+		if !broken[name] {
+			conflicts = append(conflicts, Conflict{Marker: name, Reason: reason})
+		}
+		broken[name] = true
+		delete(regions, name)
+	}
+
+	for i, line := range lines {
+		name, kind, ok := parseMarker(line, syntax)
+		if !ok {
+			continue
+		}
+
+		switch kind {
+		case "BEGIN":
+			if _, seen := open[name]; seen {
+				fail(name, "has a BEGIN marker without a matching END")
+				continue
+			}
+			if _, seen := regions[name]; seen || broken[name] {
+				fail(name, "appears more than once")
+				continue
+			}
+			open[name] = i
+		case "END":
+			begin, seen := open[name]
+			if !seen {
+				fail(name, "has an END marker without a matching BEGIN")
+				continue
+			}
+			delete(open, name)
+			if !broken[name] {
+				regions[name] = Region{Name: name, Begin: begin, End: i, Indent: leadingSpace(line)}
+			}
+		}
+	}
+
+	for name := range open {
+		fail(name, "has a BEGIN marker without a matching END")
+	}
+
+	return regions, conflicts
+}
+
+func parseMarker(line string, syntax Syntax) (name, kind string, ok bool) {
+	trimmed := strings.TrimSpace(line)
+
+	commented := false
+	for _, opener := range syntax {
+		if strings.HasPrefix(trimmed, opener) {
+			commented = true
+			break
+		}
+	}
+	if !commented {
+		return "", "", false
+	}
+
+	m := markerPattern.FindStringSubmatch(trimmed)
+	if m == nil {
+		return "", "", false
+	}
+	return m[1], m[2], true
+}
+
+func leadingSpace(line string) string {
+	return line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+}
@@ -0,0 +1,28 @@
+package injector
+
+import (
+	"strings"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+)
+
+// ReportConflicts renders conflicts as a section explaining that the listed
+// markers must be restored, or the code added by hand.
+func ReportConflicts(r interfaces.Renderer, conflicts []Conflict) {
+	if len(conflicts) == 0 {
+		return
+	}
+
+	var body strings.Builder
+	for _, c := range conflicts {
+		body.WriteString("  • ")
+		body.WriteString(c.Error())
+		body.WriteString("\n")
+	}
+	body.WriteString("\nNo files were changed. Restore the TRACKS markers or add the code manually.")
+
+	r.Section(interfaces.Section{
+		Title: "Marker conflicts",
+		Body:  body.String(),
+	})
+}
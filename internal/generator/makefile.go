@@ -0,0 +1,109 @@
+package generator
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+)
+
+var (
+	// makeTargetPattern matches a target of the rendered Makefile template
+	// with its recipe.
+	makeTargetPattern = regexp.MustCompile(`(?m)^([a-z][a-z0-9-]*):.*\n(?:\t.*\n)*`)
+
+	// makeVariablePattern matches a variable assignment of the rendered
+	// Makefile template.
+	makeVariablePattern = regexp.MustCompile(`(?m)^([A-Z][A-Z0-9_]*) :=.*\n`)
+
+	// phonyPattern matches the .PHONY line of a Makefile.
+	phonyPattern = regexp.MustCompile(`(?m)^\.PHONY:.*$`)
+)
+
+// makeTargets returns the targets of the rendered Makefile template in
+// order, keyed by name with the target and its recipe as value.
+func makeTargets(rendered string) ([]string, map[string]string) {
+	if !strings.HasSuffix(rendered, "\n") {
+		rendered += "\n"
+	}
+	var names []string
+	blocks := make(map[string]string)
+	for _, match := range makeTargetPattern.FindAllStringSubmatch(rendered, -1) {
+		names = append(names, match[1])
+		blocks[match[1]] = match[0]
+	}
+	return names, blocks
+}
+
+// hasMakeTarget reports whether makefile defines target.
+func hasMakeTarget(makefile, target string) bool {
+	return regexp.MustCompile(`(?m)^` + regexp.QuoteMeta(target) + `\s*:`).MatchString(makefile)
+}
+
+// hasMakeVariable reports whether makefile assigns variable.
+func hasMakeVariable(makefile, variable string) bool {
+	return regexp.MustCompile(`(?m)^` + regexp.QuoteMeta(variable) + `\s*[:?+]?=`).MatchString(makefile)
+}
+
+// missingMakeTargets returns the targets of the rendered Makefile template
+// that makefile does not define, limited to only if it is not empty.
+func missingMakeTargets(makefile, rendered string, only ...string) []string {
+	names, _ := makeTargets(rendered)
+	var missing []string
+	for _, name := range names {
+		if len(only) > 0 && !slices.Contains(only, name) {
+			continue
+		}
+		if !hasMakeTarget(makefile, name) {
+			missing = append(missing, name)
+		}
+	}
+	return missing
+}
+
+// appendMakeTargets returns makefile with the named targets of the rendered
+// Makefile template appended and declared phony. Variables of the template
+// that the appended recipes use and makefile lacks are appended first.
+func appendMakeTargets(makefile, rendered string, targets []string) string {
+	if len(targets) == 0 {
+		return makefile
+	}
+	_, blocks := makeTargets(rendered)
+
+	var appended strings.Builder
+	for _, match := range makeVariablePattern.FindAllStringSubmatch(rendered, -1) {
+		if hasMakeVariable(makefile, match[1]) {
+			continue
+		}
+		for _, target := range targets {
+			if strings.Contains(blocks[target], "$("+match[1]+")") {
+				appended.WriteString(match[0])
+				break
+			}
+		}
+	}
+	for _, target := range targets {
+		if appended.Len() > 0 {
+			appended.WriteString("\n")
+		}
+		appended.WriteString(blocks[target])
+	}
+
+	phony := phonyPattern.FindString(makefile)
+	if phony == "" {
+		makefile = ".PHONY: " + strings.Join(targets, " ") + "\n\n" + makefile
+	} else {
+		declared := strings.Fields(strings.TrimPrefix(phony, ".PHONY:"))
+		updated := phony
+		for _, target := range targets {
+			if !slices.Contains(declared, target) {
+				updated += " " + target
+			}
+		}
+		makefile = strings.Replace(makefile, phony, updated, 1)
+	}
+
+	if makefile != "" && !strings.HasSuffix(makefile, "\n") {
+		makefile += "\n"
+	}
+	return makefile + "\n" + appended.String()
+}
@@ -0,0 +1,190 @@
+package generator
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/anomalousventures/tracks/internal/generator/template"
+)
+
+// manifestFile is where, relative to the project root, the generation
+// manifest is stored.
+const manifestFile = ".tracks/manifest.json"
+
+// manifestSchemaVersion is bumped when the manifest format changes
+// incompatibly.
+const manifestSchemaVersion = 1
+
+// ErrNoManifest is returned by ReadManifest for projects generated before
+// manifests were recorded.
+var ErrNoManifest = errors.New("no generation manifest found")
+
+// Manifest records every file Tracks rendered into a project and the hash of
+// its content as generated, so later commands can tell which files the
+// developer has changed since.
+type Manifest struct {
+	SchemaVersion int            `json:"schema_version"`
+	UpdatedAt     time.Time      `json:"updated_at"`
+	Files         []ManifestFile `json:"files"`
+
+	// Adopted is set for modules adopted with `tracks init`. Their other
+	// files were not generated by Tracks, so upgrades only touch the files
+	// listed here.
+	Adopted bool `json:"adopted,omitempty"`
+}
+
+// ManifestFile is one rendered file in a Manifest.
+type ManifestFile struct {
+	// Path is relative to the project root, using forward slashes.
+	Path string `json:"path"`
+
+	// Template is the name of the template the file was rendered from.
+	Template string `json:"template"`
+
+	// TemplateVersion identifies the template source the file was rendered
+	// from. It is the SHA-256 of the template, so it changes exactly when
+	// the template does, independent of the CLI version.
+	TemplateVersion string `json:"template_version"`
+
+	// SHA256 is the hash of the file content as written by Tracks.
+	SHA256 string `json:"sha256"`
+
+	// Starter is set for files rendered from a starter kit template.
+	// Upgrades leave them to the kit rather than revert them to the
+	// built-in template.
+	Starter bool `json:"starter,omitempty"`
+}
+
+func newManifest() *Manifest {
+	return &Manifest{SchemaVersion: manifestSchemaVersion}
+}
+
+// ReadManifest loads the manifest of the project in projectDir. It returns
+// ErrNoManifest if the project has none.
+func ReadManifest(projectDir string) (*Manifest, error) {
+	content, err := os.ReadFile(filepath.Join(projectDir, filepath.FromSlash(manifestFile)))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNoManifest
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", manifestFile, err)
+	}
+
+	var m Manifest
+	if err := json.Unmarshal(content, &m); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", manifestFile, err)
+	}
+	if m.SchemaVersion != manifestSchemaVersion {
+		return nil, fmt.Errorf("%s has unsupported schema version %d", manifestFile, m.SchemaVersion)
+	}
+	return &m, nil
+}
+
+// set records content as the generated state of outputFile, replacing any
+// previous entry for the same path. The template is read from fsys.
+func (m *Manifest) set(fsys fs.FS, templateName, outputFile, content string) error {
+	version, err := templateVersion(fsys, templateName)
+	if err != nil {
+		return err
+	}
+
+	entry := ManifestFile{
+		Path:            outputFile,
+		Template:        templateName,
+		TemplateVersion: version,
+		SHA256:          contentHash([]byte(content)),
+	}
+	for i := range m.Files {
+		if m.Files[i].Path == outputFile {
+			m.Files[i] = entry
+			return nil
+		}
+	}
+	m.Files = append(m.Files, entry)
+	return nil
+}
+
+// record reads outputFile from projectRoot and records its current content.
+// Generation records files once every step has run, so the hashes include
+// changes made by go mod tidy and gofmt.
+func (m *Manifest) record(fsys fs.FS, projectRoot, templateName, outputFile string) error {
+	content, err := os.ReadFile(filepath.Join(projectRoot, filepath.FromSlash(outputFile)))
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", outputFile, err)
+	}
+	return m.set(fsys, templateName, outputFile, string(content))
+}
+
+// writeGenerationManifest records the files rendered by `tracks new` from
+// the templates in fsys, given as output path to template name. Files whose
+// template is in starter, the starter kit's templates, are marked as such;
+// starter is nil for projects generated without a kit.
+func writeGenerationManifest(fsys, starter fs.FS, projectRoot string, rendered map[string]string, now time.Time) error {
+	m := newManifest()
+	for outputFile, templateName := range rendered {
+		if err := m.record(fsys, projectRoot, templateName, outputFile); err != nil {
+			return err
+		}
+		if starter == nil {
+			continue
+		}
+		if _, err := fs.Stat(starter, template.ResolvePath(templateName)); err == nil {
+			m.file(outputFile).Starter = true
+		}
+	}
+	return m.write(projectRoot, now)
+}
+
+// file returns the entry for outputFile, or nil if there is none.
+func (m *Manifest) file(outputFile string) *ManifestFile {
+	for i := range m.Files {
+		if m.Files[i].Path == outputFile {
+			return &m.Files[i]
+		}
+	}
+	return nil
+}
+
+// write saves the manifest to the project, sorted by path.
+func (m *Manifest) write(projectRoot string, now time.Time) error {
+	content, err := m.encode(now)
+	if err != nil {
+		return err
+	}
+	return writeProjectFile(filepath.Join(projectRoot, filepath.FromSlash(manifestFile)), content)
+}
+
+// encode returns the manifest as saved by write.
+func (m *Manifest) encode(now time.Time) (string, error) {
+	sort.Slice(m.Files, func(i, j int) bool { return m.Files[i].Path < m.Files[j].Path })
+	m.UpdatedAt = now.UTC()
+
+	content, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to encode manifest: %w", err)
+	}
+	return string(content) + "\n", nil
+}
+
+// templateVersion returns the hash of a template's source in fsys, which
+// includes any override or starter kit template that replaced it.
+func templateVersion(fsys fs.FS, templateName string) (string, error) {
+	source, err := fs.ReadFile(fsys, template.ResolvePath(templateName))
+	if err != nil {
+		return "", fmt.Errorf("failed to read template %s: %w", templateName, err)
+	}
+	return contentHash(source), nil
+}
+
+func contentHash(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,94 @@
+package merge
+
+import "strings"
+
+// maxLCSCells bounds the dynamic programming table used by matchLines. Inputs
+// whose changed middle sections exceed it are treated as having no common
+// lines there, which turns the region into a single conflict instead of
+// allocating hundreds of megabytes.
+const maxLCSCells = 4_000_000
+
+// splitLines splits s into lines, keeping each line's trailing newline so
+// that joining the result reproduces s exactly.
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// matchLines computes a longest common subsequence of a and b and returns,
+// for each line of a, the index of the matching line in b or -1. Matches are
+// strictly increasing in both a and b.
+func matchLines(a, b []string) []int {
+	match := make([]int, len(a))
+	for i := range match {
+		match[i] = -1
+	}
+
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		match[prefix] = prefix
+		prefix++
+	}
+
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix &&
+		a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		match[len(a)-1-suffix] = len(b) - 1 - suffix
+		suffix++
+	}
+
+	midA := a[prefix : len(a)-suffix]
+	midB := b[prefix : len(b)-suffix]
+	if len(midA) == 0 || len(midB) == 0 || len(midA)*len(midB) > maxLCSCells {
+		return match
+	}
+
+	// lengths[i][j] is the LCS length of midA[i:] and midB[j:].
+	width := len(midB) + 1
+	lengths := make([]int32, (len(midA)+1)*width)
+	for i := len(midA) - 1; i >= 0; i-- {
+		for j := len(midB) - 1; j >= 0; j-- {
+			switch {
+			case midA[i] == midB[j]:
+				lengths[i*width+j] = lengths[(i+1)*width+j+1] + 1
+			case lengths[(i+1)*width+j] >= lengths[i*width+j+1]:
+				lengths[i*width+j] = lengths[(i+1)*width+j]
+			default:
+				lengths[i*width+j] = lengths[i*width+j+1]
+			}
+		}
+	}
+
+	for i, j := 0, 0; i < len(midA) && j < len(midB); {
+		switch {
+		case midA[i] == midB[j]:
+			match[prefix+i] = prefix + j
+			i++
+			j++
+		case lengths[(i+1)*width+j] >= lengths[i*width+j+1]:
+			i++
+		default:
+			j++
+		}
+	}
+
+	return match
+}
+
+// commonLines returns the lines of a that are part of a longest common
+// subsequence with b.
+func commonLines(a, b []string) []string {
+	var common []string
+	for i, j := range matchLines(a, b) {
+		if j >= 0 {
+			common = append(common, a[i])
+		}
+	}
+	return common
+}
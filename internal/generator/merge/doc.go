@@ -0,0 +1,30 @@
+// Package merge performs line-based three-way merges of generated files.
+//
+// A merge combines three versions of a file: the base that Tracks originally
+// generated, the user's current copy ("ours"), and the file rendered from the
+// templates of the running CLI ("theirs"). Regions changed on only one side
+// are taken from that side; regions changed identically on both sides are
+// taken once; regions changed differently on both sides become conflicts,
+// written with git-style markers so editors and `git diff` recognise them:
+//
+//	<<<<<<< yours
+//	user's lines
+//	=======
+//	template lines
+//	>>>>>>> tracks v1.2.0
+//
+// When no base is available, for projects generated before base snapshots
+// were recorded, Merge2 uses the lines common to both sides as the base, so
+// additions on either side are kept and only lines the two sides disagree on
+// are reported as conflicts.
+//
+// Example:
+//
+//	result := merge.Merge3(base, current, rendered, merge.Labels{
+//	    Ours:   "yours",
+//	    Theirs: "tracks " + version,
+//	})
+//	if result.Conflicts > 0 {
+//	    // result.Content contains conflict markers
+//	}
+package merge
@@ -0,0 +1,179 @@
+package merge
+
+import "strings"
+
+// Conflict marker lines, matching git's merge output.
+const (
+	MarkerOurs   = "<<<<<<<"
+	MarkerSep    = "======="
+	MarkerTheirs = ">>>>>>>"
+)
+
+// Labels name the two sides of a conflict in its markers.
+type Labels struct {
+	// Ours labels the user's current copy (e.g., "yours").
+	Ours string
+
+	// Theirs labels the freshly rendered template (e.g., "tracks v1.2.0").
+	Theirs string
+}
+
+// Result is the outcome of a merge.
+type Result struct {
+	// Content is the merged file, including conflict markers if any.
+	Content string
+
+	// Conflicts is the number of conflicting regions written to Content.
+	Conflicts int
+}
+
+// Merge3 merges the changes made between base and ours with those made
+// between base and theirs.
+func Merge3(base, ours, theirs string, labels Labels) Result {
+	switch {
+	case ours == theirs, base == theirs:
+		return Result{Content: ours}
+	case base == ours:
+		return Result{Content: theirs}
+	}
+
+	o := splitLines(base)
+	a := splitLines(ours)
+	b := splitLines(theirs)
+	matchA := matchLines(o, a)
+	matchB := matchLines(o, b)
+
+	m := merger{labels: labels}
+	i, j, k := 0, 0, 0
+	for {
+		// Copy the run of base lines that both sides kept in place.
+		n := 0
+		for i+n < len(o) && j+n < len(a) && k+n < len(b) &&
+			matchA[i+n] == j+n && matchB[i+n] == k+n {
+			n++
+		}
+		if n > 0 {
+			m.write(o[i : i+n])
+			i, j, k = i+n, j+n, k+n
+			continue
+		}
+
+		// Find the next base line both sides kept; everything before it
+		// on each side is a changed region.
+		next := i
+		for next < len(o) && (matchA[next] < 0 || matchB[next] < 0) {
+			next++
+		}
+		endA, endB := len(a), len(b)
+		if next < len(o) {
+			endA, endB = matchA[next], matchB[next]
+		}
+
+		m.resolve(o[i:next], a[j:endA], b[k:endB])
+		i, j, k = next, endA, endB
+		if next == len(o) {
+			break
+		}
+	}
+
+	return Result{Content: m.out.String(), Conflicts: m.conflicts}
+}
+
+// Merge2 merges ours and theirs without a common ancestor, treating the
+// lines they share as the base.
+func Merge2(ours, theirs string, labels Labels) Result {
+	if ours == theirs {
+		return Result{Content: ours}
+	}
+	base := strings.Join(commonLines(splitLines(ours), splitLines(theirs)), "")
+	return Merge3(base, ours, theirs, labels)
+}
+
+// HasConflictMarkers reports whether content contains an unresolved
+// conflict written by Merge3.
+func HasConflictMarkers(content string) bool {
+	for _, line := range splitLines(content) {
+		if strings.HasPrefix(line, MarkerOurs+" ") || strings.HasPrefix(line, MarkerTheirs+" ") {
+			return true
+		}
+	}
+	return false
+}
+
+type merger struct {
+	labels    Labels
+	out       strings.Builder
+	conflicts int
+}
+
+func (m *merger) write(lines []string) {
+	for _, line := range lines {
+		m.out.WriteString(line)
+	}
+}
+
+// resolve writes one changed region: whichever side changed it, or a
+// conflict when both did so differently.
+func (m *merger) resolve(base, ours, theirs []string) {
+	switch {
+	case equalLines(ours, base):
+		m.write(theirs)
+	case equalLines(theirs, base), equalLines(ours, theirs):
+		m.write(ours)
+	default:
+		m.conflict(ours, theirs)
+	}
+}
+
+// conflict writes a conflict region, keeping lines common to the start and
+// end of both sides outside the markers.
+func (m *merger) conflict(ours, theirs []string) {
+	prefix := 0
+	for prefix < len(ours) && prefix < len(theirs) && ours[prefix] == theirs[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(ours)-prefix && suffix < len(theirs)-prefix &&
+		ours[len(ours)-1-suffix] == theirs[len(theirs)-1-suffix] {
+		suffix++
+	}
+
+	m.write(ours[:prefix])
+	m.marker(MarkerOurs, m.labels.Ours)
+	m.writeTerminated(ours[prefix : len(ours)-suffix])
+	m.marker(MarkerSep, "")
+	m.writeTerminated(theirs[prefix : len(theirs)-suffix])
+	m.marker(MarkerTheirs, m.labels.Theirs)
+	m.write(ours[len(ours)-suffix:])
+	m.conflicts++
+}
+
+// writeTerminated writes lines, adding a newline after the last one if it
+// has none so the following marker starts on its own line.
+func (m *merger) writeTerminated(lines []string) {
+	m.write(lines)
+	if len(lines) > 0 && !strings.HasSuffix(lines[len(lines)-1], "\n") {
+		m.out.WriteString("\n")
+	}
+}
+
+func (m *merger) marker(marker, label string) {
+	m.out.WriteString(marker)
+	if label != "" {
+		m.out.WriteString(" ")
+		m.out.WriteString(label)
+	}
+	m.out.WriteString("\n")
+}
+
+func equalLines(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
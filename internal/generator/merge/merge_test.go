@@ -0,0 +1,148 @@
+package merge
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var labels = Labels{Ours: "yours", Theirs: "tracks v2"}
+
+func lines(ls ...string) string {
+	return strings.Join(ls, "\n") + "\n"
+}
+
+func TestMerge3(t *testing.T) {
+	base := lines("package main", "", "func a() {}", "", "func b() {}", "", "func c() {}")
+
+	tests := []struct {
+		name          string
+		ours          string
+		theirs        string
+		want          string
+		wantConflicts int
+	}{
+		{
+			name:   "unchanged",
+			ours:   base,
+			theirs: base,
+			want:   base,
+		},
+		{
+			name:   "only template changed",
+			ours:   base,
+			theirs: lines("package main", "", "func a() { return }", "", "func b() {}", "", "func c() {}"),
+			want:   lines("package main", "", "func a() { return }", "", "func b() {}", "", "func c() {}"),
+		},
+		{
+			name:   "only user changed",
+			ours:   lines("package main", "", "func a() {}", "", "func b() { custom() }", "", "func c() {}"),
+			theirs: base,
+			want:   lines("package main", "", "func a() {}", "", "func b() { custom() }", "", "func c() {}"),
+		},
+		{
+			name:   "both changed different regions",
+			ours:   lines("package main", "", "func a() {}", "", "func b() { custom() }", "", "func c() {}"),
+			theirs: lines("package main", "", "func a() { return }", "", "func b() {}", "", "func c() {}", "", "func d() {}"),
+			want:   lines("package main", "", "func a() { return }", "", "func b() { custom() }", "", "func c() {}", "", "func d() {}"),
+		},
+		{
+			name:   "both made the same change",
+			ours:   lines("package main", "", "func a() { return }", "", "func b() {}", "", "func c() {}"),
+			theirs: lines("package main", "", "func a() { return }", "", "func b() {}", "", "func c() {}"),
+			want:   lines("package main", "", "func a() { return }", "", "func b() {}", "", "func c() {}"),
+		},
+		{
+			name:   "both changed the same region",
+			ours:   lines("package main", "", "func a() {}", "", "func b() { mine() }", "", "func c() {}"),
+			theirs: lines("package main", "", "func a() {}", "", "func b() { theirs() }", "", "func c() {}"),
+			want: lines("package main", "", "func a() {}", "",
+				"<<<<<<< yours", "func b() { mine() }", "=======", "func b() { theirs() }", ">>>>>>> tracks v2",
+				"", "func c() {}"),
+			wantConflicts: 1,
+		},
+		{
+			name:   "user deleted a region the template changed",
+			ours:   lines("package main", "", "func a() {}", "", "func c() {}"),
+			theirs: lines("package main", "", "func a() {}", "", "func b() { changed() }", "", "func c() {}"),
+			want: lines("package main", "", "func a() {}", "",
+				"<<<<<<< yours", "=======", "func b() { changed() }", "", ">>>>>>> tracks v2",
+				"func c() {}"),
+			wantConflicts: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Merge3(base, tt.ours, tt.theirs, labels)
+
+			assert.Equal(t, tt.want, got.Content)
+			assert.Equal(t, tt.wantConflicts, got.Conflicts)
+			assert.Equal(t, tt.wantConflicts > 0, HasConflictMarkers(got.Content))
+		})
+	}
+}
+
+func TestMerge3_MissingTrailingNewline(t *testing.T) {
+	base := "a\nb"
+	ours := "a\nmine"
+	theirs := "a\ntheirs"
+
+	got := Merge3(base, ours, theirs, labels)
+
+	assert.Equal(t, "a\n<<<<<<< yours\nmine\n=======\ntheirs\n>>>>>>> tracks v2\n", got.Content)
+	assert.Equal(t, 1, got.Conflicts)
+}
+
+func TestMerge2(t *testing.T) {
+	tests := []struct {
+		name          string
+		ours          string
+		theirs        string
+		want          string
+		wantConflicts int
+	}{
+		{
+			name:   "identical",
+			ours:   lines("a", "b"),
+			theirs: lines("a", "b"),
+			want:   lines("a", "b"),
+		},
+		{
+			name:   "additions on both sides are kept",
+			ours:   lines("a", "mine", "b", "c"),
+			theirs: lines("a", "b", "c", "theirs"),
+			want:   lines("a", "mine", "b", "c", "theirs"),
+		},
+		{
+			name:          "differing lines conflict",
+			ours:          lines("a", "mine", "c"),
+			theirs:        lines("a", "theirs", "c"),
+			want:          lines("a", "<<<<<<< yours", "mine", "=======", "theirs", ">>>>>>> tracks v2", "c"),
+			wantConflicts: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Merge2(tt.ours, tt.theirs, labels)
+
+			assert.Equal(t, tt.want, got.Content)
+			assert.Equal(t, tt.wantConflicts, got.Conflicts)
+		})
+	}
+}
+
+func TestMatchLines(t *testing.T) {
+	a := splitLines(lines("x", "a", "b", "c", "y"))
+	b := splitLines(lines("a", "z", "c", "y"))
+
+	assert.Equal(t, []int{-1, 0, -1, 2, 3}, matchLines(a, b))
+}
+
+func TestSplitLines(t *testing.T) {
+	assert.Nil(t, splitLines(""))
+	assert.Equal(t, []string{"a\n", "b"}, splitLines("a\nb"))
+	assert.Equal(t, []string{"a\n", "b\n"}, splitLines("a\nb\n"))
+}
@@ -0,0 +1,140 @@
+package generator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	"github.com/anomalousventures/tracks/internal/database"
+	generatorinterfaces "github.com/anomalousventures/tracks/internal/generator/interfaces"
+	"github.com/anomalousventures/tracks/internal/generator/naming"
+	"github.com/anomalousventures/tracks/internal/generator/template"
+	"github.com/rs/zerolog"
+)
+
+// MigrationConfig holds the inputs for `tracks generate migration`.
+type MigrationConfig struct {
+	// Name describes the migration and becomes part of the file name
+	// (e.g., "add_slug_to_posts"). Any case is accepted.
+	Name string `json:"name"`
+
+	// Table, when set, pre-fills the migration with a CREATE TABLE statement
+	// following the project's id, timestamp and trigger conventions.
+	Table string `json:"table,omitempty"`
+
+	// Fields are the columns of Table, parsed with ParseFields.
+	Fields []Field `json:"fields,omitempty"`
+
+	// ProjectDir is the root of the Tracks project being extended.
+	ProjectDir string `json:"project_dir"`
+
+	// DatabaseDriver is the project's driver from .tracks.yaml.
+	DatabaseDriver string `json:"database_driver"`
+}
+
+type migrationGenerator struct {
+	renderer generatorinterfaces.TemplateRenderer
+	now      func() time.Time
+}
+
+// NewMigrationGenerator creates a generator for migrations in existing projects.
+func NewMigrationGenerator() interfaces.MigrationGenerator {
+	return &migrationGenerator{
+		now: time.Now,
+	}
+}
+
+func (g *migrationGenerator) Validate(cfg any) error {
+	migrationCfg, ok := cfg.(MigrationConfig)
+	if !ok {
+		return fmt.Errorf("invalid config type: expected MigrationConfig, got %T", cfg)
+	}
+
+	name := naming.Snake(migrationCfg.Name)
+	if name == "" || !isIdentifier(name) {
+		return fmt.Errorf("invalid migration name %q: must start with a letter and contain only letters, digits, and underscores", migrationCfg.Name)
+	}
+
+	if migrationCfg.Table != "" {
+		table := naming.Snake(migrationCfg.Table)
+		if !isIdentifier(table) {
+			return fmt.Errorf("invalid table name %q: must start with a letter and contain only letters, digits, and underscores", migrationCfg.Table)
+		}
+		if len(migrationCfg.Fields) == 0 {
+			return errors.New("create_table requires at least one field (e.g., title:string)")
+		}
+	} else if len(migrationCfg.Fields) > 0 {
+		return errors.New("fields are only supported with create_table")
+	}
+
+	switch migrationCfg.DatabaseDriver {
+	case "go-libsql", "sqlite3", "postgres":
+	default:
+		return fmt.Errorf("unsupported database driver %q", migrationCfg.DatabaseDriver)
+	}
+
+	dir := database.GetMigrationsDir(migrationCfg.ProjectDir, migrationCfg.DatabaseDriver)
+	matches, _ := filepath.Glob(filepath.Join(dir, "*_"+name+".sql"))
+	if len(matches) > 0 {
+		return fmt.Errorf("migration %s already exists: %s", name, filepath.Base(matches[0]))
+	}
+
+	return nil
+}
+
+func (g *migrationGenerator) Generate(ctx context.Context, cfg any) ([]interfaces.GeneratedFile, error) {
+	logger := zerolog.Ctx(ctx)
+
+	if err := g.Validate(cfg); err != nil {
+		return nil, err
+	}
+	migrationCfg := cfg.(MigrationConfig)
+
+	data := template.MigrationData{
+		Name:     naming.Snake(migrationCfg.Name),
+		DBDriver: migrationCfg.DatabaseDriver,
+		Table:    naming.Snake(migrationCfg.Table),
+		Fields:   newResourceFields(migrationCfg.Fields, migrationCfg.DatabaseDriver),
+	}
+
+	templateName := "migration/blank.sql.tmpl"
+	if data.Table != "" {
+		templateName = "migration/create_table.sql.tmpl"
+	}
+
+	content, err := rendererOr(g.renderer, migrationCfg.ProjectDir).Render(templateName, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render %s: %w", templateName, err)
+	}
+
+	dir := database.GetMigrationsDir(migrationCfg.ProjectDir, migrationCfg.DatabaseDriver)
+	fileName := fmt.Sprintf("%s_%s.sql", g.now().Format("20060102150405"), data.Name)
+	outputPath := filepath.Join(dir, fileName)
+
+	logger.Debug().
+		Str("template", templateName).
+		Str("output", outputPath).
+		Msg("rendering migration")
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create migrations directory: %w", err)
+	}
+	if err := os.WriteFile(outputPath, []byte(content), 0644); err != nil {
+		return nil, fmt.Errorf("failed to write migration: %w", err)
+	}
+
+	rel, err := filepath.Rel(migrationCfg.ProjectDir, outputPath)
+	if err != nil {
+		rel = outputPath
+	}
+
+	logger.Info().
+		Str("migration", fileName).
+		Msg("migration generated successfully")
+
+	return []interfaces.GeneratedFile{{Path: filepath.ToSlash(rel), Action: interfaces.FileActionCreate}}, nil
+}
@@ -0,0 +1,140 @@
+package generator
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	"github.com/anomalousventures/tracks/internal/database"
+	"github.com/anomalousventures/tracks/internal/generator/template"
+	"github.com/anomalousventures/tracks/internal/templates"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestMigrationGenerator() *migrationGenerator {
+	return &migrationGenerator{
+		renderer: template.NewRenderer(templates.FS),
+		now:      func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) },
+	}
+}
+
+func TestNewMigrationGenerator(t *testing.T) {
+	assert.NotNil(t, NewMigrationGenerator())
+}
+
+func TestMigrationGenerator_Generate_Blank(t *testing.T) {
+	projectDir := t.TempDir()
+	gen := newTestMigrationGenerator()
+
+	files, err := gen.Generate(context.Background(), MigrationConfig{
+		Name:           "AddSlugToPosts",
+		ProjectDir:     projectDir,
+		DatabaseDriver: "postgres",
+	})
+	require.NoError(t, err)
+
+	require.Len(t, files, 1)
+	assert.Equal(t, interfaces.FileActionCreate, files[0].Action)
+	assert.Equal(t, "internal/db/migrations/postgres/20250102030405_add_slug_to_posts.sql", files[0].Path)
+
+	content, err := os.ReadFile(filepath.Join(projectDir, filepath.FromSlash(files[0].Path)))
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "-- +goose Up")
+	assert.Contains(t, string(content), "-- +goose Down")
+	assert.NotContains(t, string(content), "CREATE TABLE")
+}
+
+func TestMigrationGenerator_Generate_CreateTable(t *testing.T) {
+	for _, driver := range []string{"go-libsql", "sqlite3", "postgres"} {
+		t.Run(driver, func(t *testing.T) {
+			projectDir := t.TempDir()
+			gen := newTestMigrationGenerator()
+
+			files, err := gen.Generate(context.Background(), MigrationConfig{
+				Name:           "create_tags",
+				Table:          "tags",
+				Fields:         []Field{{Name: "label", Type: FieldString}, {Name: "seen_at", Type: FieldTime}},
+				ProjectDir:     projectDir,
+				DatabaseDriver: driver,
+			})
+			require.NoError(t, err)
+			require.Len(t, files, 1)
+
+			path := filepath.Join(database.GetMigrationsDir(projectDir, driver), "20250102030405_create_tags.sql")
+			content, err := os.ReadFile(path)
+			require.NoError(t, err)
+
+			assert.Contains(t, string(content), "CREATE TABLE tags (")
+			assert.Contains(t, string(content), "label TEXT NOT NULL,")
+			assert.Contains(t, string(content), "CREATE TRIGGER trg_tags_updated_at")
+
+			if driver == "postgres" {
+				assert.Contains(t, string(content), "seen_at TIMESTAMPTZ NOT NULL,")
+				assert.Contains(t, string(content), "EXECUTE FUNCTION update_updated_at_column()")
+			} else {
+				assert.Contains(t, string(content), "seen_at TEXT NOT NULL,")
+				assert.Contains(t, string(content), "AFTER UPDATE ON tags")
+			}
+		})
+	}
+}
+
+func TestMigrationGenerator_Validate(t *testing.T) {
+	gen := newTestMigrationGenerator()
+	projectDir := t.TempDir()
+
+	valid := MigrationConfig{Name: "create_tags", Table: "tags", Fields: []Field{{Name: "label", Type: FieldString}}, ProjectDir: projectDir, DatabaseDriver: "postgres"}
+
+	tests := []struct {
+		name    string
+		modify  func(*MigrationConfig)
+		wantErr string
+	}{
+		{"valid", func(*MigrationConfig) {}, ""},
+		{"blank", func(c *MigrationConfig) { c.Table = ""; c.Fields = nil }, ""},
+		{"empty name", func(c *MigrationConfig) { c.Name = "" }, "invalid migration name"},
+		{"invalid name", func(c *MigrationConfig) { c.Name = "1st" }, "invalid migration name"},
+		{"invalid table", func(c *MigrationConfig) { c.Table = "9tags" }, "invalid table name"},
+		{"table without fields", func(c *MigrationConfig) { c.Fields = nil }, "at least one field"},
+		{"fields without table", func(c *MigrationConfig) { c.Table = "" }, "only supported with create_table"},
+		{"bad driver", func(c *MigrationConfig) { c.DatabaseDriver = "mysql" }, "unsupported database driver"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := valid
+			tt.modify(&cfg)
+
+			err := gen.Validate(cfg)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestMigrationGenerator_Validate_Existing(t *testing.T) {
+	projectDir := t.TempDir()
+	gen := newTestMigrationGenerator()
+	cfg := MigrationConfig{Name: "add_index", ProjectDir: projectDir, DatabaseDriver: "postgres"}
+
+	_, err := gen.Generate(context.Background(), cfg)
+	require.NoError(t, err)
+
+	err = gen.Validate(cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "already exists")
+}
+
+func TestMigrationGenerator_Validate_InvalidConfig(t *testing.T) {
+	err := newTestMigrationGenerator().Validate(ResourceConfig{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid config type")
+}
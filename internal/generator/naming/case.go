@@ -0,0 +1,181 @@
+package naming
+
+import (
+	"go/token"
+	"strings"
+	"unicode"
+)
+
+// initialisms lists the words that are upper-cased as a whole in Go
+// identifiers. It mirrors sqlc's default "initialisms" setting so that
+// generated code can reference sqlc model fields by name.
+var initialisms = map[string]string{
+	"id": "ID",
+}
+
+// Words splits s into lower-case words on underscores, hyphens, spaces,
+// dots and camelCase boundaries.
+//
+// Example:
+//
+//	Words("BlogPost")   // ["blog", "post"]
+//	Words("user_id")    // ["user", "id"]
+//	Words("HTTPServer") // ["http", "server"]
+func Words(s string) []string {
+	var words []string
+	var current []rune
+
+	flush := func() {
+		if len(current) > 0 {
+			words = append(words, strings.ToLower(string(current)))
+			current = current[:0]
+		}
+	}
+
+	runes := []rune(s)
+	for i, r := range runes {
+		switch {
+		case r == '_' || r == '-' || r == ' ' || r == '.' || r == '/':
+			flush()
+		case unicode.IsUpper(r):
+			if len(current) > 0 {
+				prev := runes[i-1]
+				nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+				if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextIsLower) {
+					flush()
+				}
+			}
+			current = append(current, r)
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			current = append(current, r)
+		default:
+			flush()
+		}
+	}
+	flush()
+
+	return words
+}
+
+// Snake converts s to snake_case.
+func Snake(s string) string {
+	return strings.Join(Words(s), "_")
+}
+
+// Kebab converts s to kebab-case, the form used for URL path segments.
+func Kebab(s string) string {
+	return strings.Join(Words(s), "-")
+}
+
+// Pascal converts s to PascalCase, upper-casing known initialisms.
+//
+// Example:
+//
+//	Pascal("blog_post") // "BlogPost"
+//	Pascal("user_id")   // "UserID"
+func Pascal(s string) string {
+	var b strings.Builder
+	for _, w := range Words(s) {
+		b.WriteString(titleWord(w))
+	}
+	return b.String()
+}
+
+// Camel converts s to camelCase, upper-casing known initialisms after the
+// first word.
+//
+// Example:
+//
+//	Camel("blog_post") // "blogPost"
+//	Camel("user_id")   // "userID"
+func Camel(s string) string {
+	words := Words(s)
+	if len(words) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString(words[0])
+	for _, w := range words[1:] {
+		b.WriteString(titleWord(w))
+	}
+	return b.String()
+}
+
+// Package converts s to a Go package name: all lower-case with no separators.
+func Package(s string) string {
+	return strings.Join(Words(s), "")
+}
+
+// Humanize converts s to a sentence-case label for display, upper-casing
+// known initialisms.
+//
+// Example:
+//
+//	Humanize("blog_post") // "Blog post"
+//	Humanize("author_id") // "Author ID"
+func Humanize(s string) string {
+	words := Words(s)
+	for i, w := range words {
+		switch {
+		case initialisms[w] != "":
+			words[i] = initialisms[w]
+		case i == 0:
+			words[i] = titleWord(w)
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// Identifier converts s to an unexported Go identifier. Characters that
+// cannot appear in an identifier are dropped, a leading digit is prefixed
+// with an underscore, and keywords get a "Value" suffix.
+//
+// Example:
+//
+//	Identifier("user_id") // "userID"
+//	Identifier("type")    // "typeValue"
+//	Identifier("2fa")     // "_2fa"
+func Identifier(s string) string {
+	name := Camel(s)
+	switch {
+	case name == "":
+		return "_"
+	case IsGoKeyword(name):
+		return name + "Value"
+	case unicode.IsDigit([]rune(name)[0]):
+		return "_" + name
+	}
+	return name
+}
+
+// ExportedIdentifier converts s to an exported Go identifier. Characters
+// that cannot appear in an identifier are dropped and a leading digit is
+// prefixed with an X.
+//
+// Example:
+//
+//	ExportedIdentifier("blog post") // "BlogPost"
+//	ExportedIdentifier("2fa")       // "X2fa"
+func ExportedIdentifier(s string) string {
+	name := Pascal(s)
+	if name == "" || unicode.IsDigit([]rune(name)[0]) {
+		return "X" + name
+	}
+	return name
+}
+
+// IsGoKeyword reports whether s is a reserved Go keyword and therefore cannot
+// be used as an identifier in generated code.
+func IsGoKeyword(s string) bool {
+	return token.IsKeyword(s)
+}
+
+func titleWord(w string) string {
+	if up, ok := initialisms[w]; ok {
+		return up
+	}
+	runes := []rune(w)
+	runes[0] = unicode.ToUpper(runes[0])
+	return string(runes)
+}
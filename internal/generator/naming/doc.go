@@ -0,0 +1,16 @@
+// Package naming provides the identifier conventions shared by Tracks code
+// generators.
+//
+// Generators derive many names from a single user-supplied resource name:
+// table names, Go types, package names, route paths and variable names. This
+// package keeps those derivations consistent with the tools the generated
+// project runs afterwards, most importantly sqlc, which singularizes table
+// names for model types and upper-cases the "id" initialism in field names.
+//
+// Example:
+//
+//	naming.Pluralize("blog_post") // "blog_posts"
+//	naming.Pascal("blog_post")    // "BlogPost"
+//	naming.Camel("user_id")       // "userID"
+//	naming.Kebab("BlogPost")      // "blog-post"
+package naming
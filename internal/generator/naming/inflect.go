@@ -0,0 +1,173 @@
+package naming
+
+import (
+	"regexp"
+	"strings"
+)
+
+// inflection is a single regular-expression rewrite rule.
+type inflection struct {
+	find    *regexp.Regexp
+	replace string
+}
+
+// The rule sets follow the Rails inflector (also used by jinzhu/inflection,
+// which sqlc relies on to name model structs). Rules are listed in priority
+// order: the first matching rule wins.
+var (
+	pluralRules = compileRules([][2]string{
+		{`(quiz)$`, `${1}zes`},
+		{`^(oxen)$`, `${1}`},
+		{`^(ox)$`, `${1}en`},
+		{`^(m|l)ice$`, `${1}ice`},
+		{`^(m|l)ouse$`, `${1}ice`},
+		{`(matr|vert|ind)(?:ix|ex)$`, `${1}ices`},
+		{`(x|ch|ss|sh)$`, `${1}es`},
+		{`([^aeiouy]|qu)y$`, `${1}ies`},
+		{`(hive)$`, `${1}s`},
+		{`([^f])fe$`, `${1}ves`},
+		{`([lr])f$`, `${1}ves`},
+		{`sis$`, `ses`},
+		{`([ti])a$`, `${1}a`},
+		{`([ti])um$`, `${1}a`},
+		{`(buffal|tomat)o$`, `${1}oes`},
+		{`(bu)s$`, `${1}ses`},
+		{`(alias|status)$`, `${1}es`},
+		{`(octop|vir)i$`, `${1}i`},
+		{`(octop|vir)us$`, `${1}i`},
+		{`^(ax|test)is$`, `${1}es`},
+		{`s$`, `s`},
+		{`$`, `s`},
+	})
+
+	singularRules = compileRules([][2]string{
+		{`(database)s$`, `${1}`},
+		{`(quiz)zes$`, `${1}`},
+		{`(matr)ices$`, `${1}ix`},
+		{`(vert|ind)ices$`, `${1}ex`},
+		{`^(ox)en`, `${1}`},
+		{`(alias|status)(es)?$`, `${1}`},
+		{`(octop|vir)(us|i)$`, `${1}us`},
+		{`^(a)x[ie]s$`, `${1}xis`},
+		{`(cris|test)(is|es)$`, `${1}is`},
+		{`(shoe)s$`, `${1}`},
+		{`(o)es$`, `${1}`},
+		{`(bus)(es)?$`, `${1}`},
+		{`^(m|l)ice$`, `${1}ouse`},
+		{`(x|ch|ss|sh)es$`, `${1}`},
+		{`(m)ovies$`, `${1}ovie`},
+		{`(s)eries$`, `${1}eries`},
+		{`([^aeiouy]|qu)ies$`, `${1}y`},
+		{`([lr])ves$`, `${1}f`},
+		{`(tive)s$`, `${1}`},
+		{`(hive)s$`, `${1}`},
+		{`([^f])ves$`, `${1}fe`},
+		{`(^analy)(sis|ses)$`, `${1}sis`},
+		{`((a)naly|(b)a|(d)iagno|(p)arenthe|(p)rogno|(s)ynop|(t)he)(sis|ses)$`, `${1}sis`},
+		{`([ti])a$`, `${1}um`},
+		{`(n)ews$`, `${1}ews`},
+		{`(ss)$`, `${1}`},
+		{`s$`, ``},
+	})
+
+	irregulars = map[string]string{
+		"person": "people",
+		"man":    "men",
+		"child":  "children",
+		"sex":    "sexes",
+		"move":   "moves",
+		"zombie": "zombies",
+	}
+
+	uncountables = map[string]bool{
+		"equipment":   true,
+		"information": true,
+		"rice":        true,
+		"money":       true,
+		"species":     true,
+		"series":      true,
+		"fish":        true,
+		"sheep":       true,
+		"jeans":       true,
+		"police":      true,
+	}
+)
+
+// Pluralize returns the plural form of word. Only the final word of a
+// snake_case or kebab-case name is inflected, so "blog_post" becomes
+// "blog_posts".
+func Pluralize(word string) string {
+	return inflectLast(word, func(w string) string {
+		if uncountables[w] {
+			return w
+		}
+		if plural, ok := irregulars[w]; ok {
+			return plural
+		}
+		for _, plural := range irregulars {
+			if plural == w {
+				return w
+			}
+		}
+		return applyRules(w, pluralRules)
+	})
+}
+
+// Singularize returns the singular form of word. Only the final word of a
+// snake_case or kebab-case name is inflected, so "blog_posts" becomes
+// "blog_post".
+func Singularize(word string) string {
+	return inflectLast(word, func(w string) string {
+		if uncountables[w] {
+			return w
+		}
+		for singular, plural := range irregulars {
+			if plural == w {
+				return singular
+			}
+		}
+		if _, ok := irregulars[w]; ok {
+			return w
+		}
+		return applyRules(w, singularRules)
+	})
+}
+
+func inflectLast(word string, inflect func(string) string) string {
+	if word == "" {
+		return word
+	}
+
+	idx := strings.LastIndexAny(word, "_-")
+	prefix, last := word[:idx+1], word[idx+1:]
+	if last == "" {
+		return word
+	}
+
+	lower := strings.ToLower(last)
+	inflected := inflect(lower)
+	if lower != last && len(last) > 0 && last[0] >= 'A' && last[0] <= 'Z' {
+		inflected = strings.ToUpper(inflected[:1]) + inflected[1:]
+	}
+	return prefix + inflected
+}
+
+func applyRules(word string, rules []inflection) string {
+	for _, rule := range rules {
+		if rule.find.MatchString(word) {
+			return rule.find.ReplaceAllString(word, rule.replace)
+		}
+	}
+	return word
+}
+
+func compileRules(rules [][2]string) []inflection {
+	compiled := make([]inflection, len(rules))
+	for i, r := range rules {
+		compiled[i] = inflection{
+			find:    regexp.MustCompile("(?i)" + r[0]),
+			replace: r[1],
+		}
+	}
+	return compiled
+}
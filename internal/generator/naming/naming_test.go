@@ -0,0 +1,149 @@
+package naming
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWords(t *testing.T) {
+	tests := []struct {
+		input string
+		want  []string
+	}{
+		{"post", []string{"post"}},
+		{"blog_post", []string{"blog", "post"}},
+		{"blog-post", []string{"blog", "post"}},
+		{"BlogPost", []string{"blog", "post"}},
+		{"blogPost", []string{"blog", "post"}},
+		{"HTTPServer", []string{"http", "server"}},
+		{"userID", []string{"user", "id"}},
+		{"address2_line", []string{"address2", "line"}},
+		{"", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			assert.Equal(t, tt.want, Words(tt.input))
+		})
+	}
+}
+
+func TestCaseConversions(t *testing.T) {
+	tests := []struct {
+		input  string
+		snake  string
+		kebab  string
+		pascal string
+		camel  string
+		pkg    string
+	}{
+		{"post", "post", "post", "Post", "post", "post"},
+		{"blog_post", "blog_post", "blog-post", "BlogPost", "blogPost", "blogpost"},
+		{"BlogPost", "blog_post", "blog-post", "BlogPost", "blogPost", "blogpost"},
+		{"user_id", "user_id", "user-id", "UserID", "userID", "userid"},
+		{"id", "id", "id", "ID", "id", "id"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			assert.Equal(t, tt.snake, Snake(tt.input), "Snake")
+			assert.Equal(t, tt.kebab, Kebab(tt.input), "Kebab")
+			assert.Equal(t, tt.pascal, Pascal(tt.input), "Pascal")
+			assert.Equal(t, tt.camel, Camel(tt.input), "Camel")
+			assert.Equal(t, tt.pkg, Package(tt.input), "Package")
+		})
+	}
+}
+
+func TestHumanize(t *testing.T) {
+	assert.Equal(t, "Post", Humanize("post"))
+	assert.Equal(t, "Blog post", Humanize("BlogPost"))
+	assert.Equal(t, "Author ID", Humanize("author_id"))
+	assert.Equal(t, "", Humanize(""))
+}
+
+func TestPluralize(t *testing.T) {
+	tests := map[string]string{
+		"post":      "posts",
+		"posts":     "posts",
+		"category":  "categories",
+		"address":   "addresses",
+		"status":    "statuses",
+		"bus":       "buses",
+		"box":       "boxes",
+		"match":     "matches",
+		"knife":     "knives",
+		"person":    "people",
+		"people":    "people",
+		"child":     "children",
+		"sheep":     "sheep",
+		"mouse":     "mice",
+		"matrix":    "matrices",
+		"blog_post": "blog_posts",
+		"day":       "days",
+		"quiz":      "quizzes",
+	}
+
+	for input, want := range tests {
+		t.Run(input, func(t *testing.T) {
+			assert.Equal(t, want, Pluralize(input))
+		})
+	}
+}
+
+func TestSingularize(t *testing.T) {
+	tests := map[string]string{
+		"posts":      "post",
+		"post":       "post",
+		"categories": "category",
+		"addresses":  "address",
+		"statuses":   "status",
+		"status":     "status",
+		"buses":      "bus",
+		"houses":     "house",
+		"boxes":      "box",
+		"knives":     "knife",
+		"people":     "person",
+		"children":   "child",
+		"sheep":      "sheep",
+		"news":       "news",
+		"movies":     "movie",
+		"blog_posts": "blog_post",
+		"databases":  "database",
+	}
+
+	for input, want := range tests {
+		t.Run(input, func(t *testing.T) {
+			assert.Equal(t, want, Singularize(input))
+		})
+	}
+}
+
+func TestIsGoKeyword(t *testing.T) {
+	assert.True(t, IsGoKeyword("type"))
+	assert.True(t, IsGoKeyword("func"))
+	assert.False(t, IsGoKeyword("post"))
+}
+
+func TestIdentifier(t *testing.T) {
+	tests := []struct {
+		input    string
+		want     string
+		exported string
+	}{
+		{"user_id", "userID", "UserID"},
+		{"blog post", "blogPost", "BlogPost"},
+		{"type", "typeValue", "Type"},
+		{"2fa", "_2fa", "X2fa"},
+		{"content-type!", "contentType", "ContentType"},
+		{"", "_", "X"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			assert.Equal(t, tt.want, Identifier(tt.input), "Identifier")
+			assert.Equal(t, tt.exported, ExportedIdentifier(tt.input), "ExportedIdentifier")
+		})
+	}
+}
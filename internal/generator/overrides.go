@@ -0,0 +1,156 @@
+package generator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	generatorinterfaces "github.com/anomalousventures/tracks/internal/generator/interfaces"
+	"github.com/anomalousventures/tracks/internal/generator/template"
+	"github.com/anomalousventures/tracks/internal/templates"
+	"github.com/rs/zerolog"
+)
+
+// projectTemplatesDir holds, relative to the project root, templates that
+// override the embedded ones for that project.
+const projectTemplatesDir = ".tracks/templates"
+
+// userTemplatesDir returns the directory of the user's template overrides
+// (e.g. ~/.config/tracks/templates), or "" if the user configuration
+// directory cannot be determined.
+func userTemplatesDir() string {
+	base, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(base, "tracks", "templates")
+}
+
+// overrideDir returns the template override directory of scope, or "" if
+// there is none: outside a project, projectDir is empty.
+func overrideDir(projectDir, scope string) string {
+	switch scope {
+	case interfaces.TemplateScopeProject:
+		if projectDir == "" {
+			return ""
+		}
+		return filepath.Join(projectDir, filepath.FromSlash(projectTemplatesDir))
+	case interfaces.TemplateScopeUser:
+		return userTemplatesDir()
+	}
+	return ""
+}
+
+// templateFS returns the embedded templates with the overrides for
+// projectDir layered over them: project overrides first, then user
+// overrides, then dirs.
+func templateFS(projectDir string, dirs ...string) fs.FS {
+	layers := append([]string{
+		overrideDir(projectDir, interfaces.TemplateScopeProject),
+		overrideDir(projectDir, interfaces.TemplateScopeUser),
+	}, dirs...)
+	return template.NewOverlayFS(templates.FS, layers...)
+}
+
+// newTemplateRenderer returns a renderer for templateFS(projectDir).
+func newTemplateRenderer(projectDir string) generatorinterfaces.TemplateRenderer {
+	return template.NewRenderer(templateFS(projectDir))
+}
+
+// rendererOr returns injected, a renderer a test set on a generator, or
+// else a renderer that layers the template overrides for projectDir over the
+// embedded templates.
+func rendererOr(injected generatorinterfaces.TemplateRenderer, projectDir string) generatorinterfaces.TemplateRenderer {
+	if injected != nil {
+		return injected
+	}
+	return newTemplateRenderer(projectDir)
+}
+
+type templateManager struct{}
+
+// NewTemplateManager creates a manager for template override directories.
+func NewTemplateManager() interfaces.TemplateManager {
+	return &templateManager{}
+}
+
+func (m *templateManager) Overrides(ctx context.Context, projectDir string) ([]interfaces.TemplateOverride, error) {
+	logger := zerolog.Ctx(ctx)
+
+	var overrides []interfaces.TemplateOverride
+	shadowed := make(map[string]bool)
+	for _, scope := range []string{interfaces.TemplateScopeProject, interfaces.TemplateScopeUser} {
+		dir := overrideDir(projectDir, scope)
+		if dir == "" {
+			continue
+		}
+		err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+			if errors.Is(err, fs.ErrNotExist) {
+				return filepath.SkipDir
+			}
+			if err != nil || d.IsDir() {
+				return err
+			}
+			rel, err := filepath.Rel(dir, path)
+			if err != nil {
+				return err
+			}
+			name := filepath.ToSlash(rel)
+			_, statErr := fs.Stat(templates.FS, name)
+			overrides = append(overrides, interfaces.TemplateOverride{
+				Template: name,
+				Scope:    scope,
+				Path:     path,
+				Active:   statErr == nil && !shadowed[name],
+			})
+			shadowed[name] = true
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to read template overrides in %s: %w", dir, err)
+		}
+	}
+
+	sort.SliceStable(overrides, func(i, j int) bool { return overrides[i].Template < overrides[j].Template })
+
+	logger.Debug().
+		Int("override_count", len(overrides)).
+		Msg("listed template overrides")
+
+	return overrides, nil
+}
+
+func (m *templateManager) Eject(ctx context.Context, projectDir, name, scope string, force bool) (string, error) {
+	logger := zerolog.Ctx(ctx)
+
+	name = template.ResolvePath(name)
+	content, err := fs.ReadFile(templates.FS, name)
+	if err != nil {
+		return "", fmt.Errorf("unknown template %q: %w", name, err)
+	}
+
+	dir := overrideDir(projectDir, scope)
+	if dir == "" {
+		return "", fmt.Errorf("no %s template directory available", scope)
+	}
+	path := filepath.Join(dir, filepath.FromSlash(name))
+
+	if _, err := os.Stat(path); err == nil && !force {
+		return "", fmt.Errorf("%s already exists (use --force to overwrite)", path)
+	}
+	if err := writeProjectFile(path, string(content)); err != nil {
+		return "", fmt.Errorf("failed to eject %s: %w", name, err)
+	}
+
+	logger.Info().
+		Str("template", name).
+		Str("path", path).
+		Msg("template ejected")
+
+	return path, nil
+}
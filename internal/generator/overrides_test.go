@@ -0,0 +1,111 @@
+package generator
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setUserConfigDir points the user template directory at a temporary
+// directory and returns it.
+func setUserConfigDir(t *testing.T) string {
+	t.Helper()
+	configDir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", configDir)
+	t.Setenv("HOME", configDir)
+	return filepath.Join(configDir, "tracks", "templates")
+}
+
+func TestNewTemplateRenderer_Precedence(t *testing.T) {
+	userDir := setUserConfigDir(t)
+	projectDir := t.TempDir()
+	data := map[string]string{}
+
+	renderer := newTemplateRenderer(projectDir)
+	embedded, err := renderer.Render("Dockerfile.tmpl", data)
+	require.NoError(t, err)
+	assert.NotEqual(t, "user\n", embedded)
+
+	writeTestFile(t, userDir, "project/Dockerfile.tmpl", "user\n")
+	got, err := renderer.Render("Dockerfile.tmpl", data)
+	require.NoError(t, err)
+	assert.Equal(t, "user\n", got)
+
+	writeTestFile(t, projectDir, ".tracks/templates/project/Dockerfile.tmpl", "project {{.}}\n")
+	got, err = renderer.Render("Dockerfile.tmpl", "x")
+	require.NoError(t, err)
+	assert.Equal(t, "project x\n", got)
+
+	got, err = newTemplateRenderer("").Render("Dockerfile.tmpl", data)
+	require.NoError(t, err)
+	assert.Equal(t, "user\n", got, "without a project only user overrides apply")
+}
+
+func TestTemplateManager_EjectAndOverrides(t *testing.T) {
+	userDir := setUserConfigDir(t)
+	projectDir := t.TempDir()
+	manager := NewTemplateManager()
+	ctx := context.Background()
+
+	path, err := manager.Eject(ctx, projectDir, "Dockerfile.tmpl", interfaces.TemplateScopeProject, false)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(projectDir, ".tracks", "templates", "project", "Dockerfile.tmpl"), path)
+
+	embedded, err := newTemplateRenderer("").Render("Dockerfile.tmpl", map[string]string{})
+	require.NoError(t, err)
+	ejected, err := newTemplateRenderer(projectDir).Render("Dockerfile.tmpl", map[string]string{})
+	require.NoError(t, err)
+	assert.Equal(t, embedded, ejected, "an ejected template renders like the embedded one")
+
+	_, err = manager.Eject(ctx, projectDir, "Dockerfile.tmpl", interfaces.TemplateScopeProject, false)
+	assert.ErrorContains(t, err, "already exists")
+	_, err = manager.Eject(ctx, projectDir, "Dockerfile.tmpl", interfaces.TemplateScopeProject, true)
+	assert.NoError(t, err)
+
+	_, err = manager.Eject(ctx, "", "resource/handler.go.tmpl", interfaces.TemplateScopeUser, false)
+	require.NoError(t, err)
+	_, err = manager.Eject(ctx, "", "project/Dockerfile.tmpl", interfaces.TemplateScopeUser, false)
+	require.NoError(t, err)
+	writeTestFile(t, userDir, "project/Dockerfle.tmpl", "typo\n")
+
+	overrides, err := manager.Overrides(ctx, projectDir)
+	require.NoError(t, err)
+	assert.Equal(t, []interfaces.TemplateOverride{
+		{Template: "project/Dockerfile.tmpl", Scope: interfaces.TemplateScopeProject, Path: path, Active: true},
+		{Template: "project/Dockerfile.tmpl", Scope: interfaces.TemplateScopeUser, Path: filepath.Join(userDir, "project", "Dockerfile.tmpl")},
+		{Template: "project/Dockerfle.tmpl", Scope: interfaces.TemplateScopeUser, Path: filepath.Join(userDir, "project", "Dockerfle.tmpl")},
+		{Template: "resource/handler.go.tmpl", Scope: interfaces.TemplateScopeUser, Path: filepath.Join(userDir, "resource", "handler.go.tmpl"), Active: true},
+	}, overrides)
+
+	overrides, err = manager.Overrides(ctx, "")
+	require.NoError(t, err)
+	assert.Len(t, overrides, 3)
+	assert.True(t, overrides[0].Active, "user override is active outside a project")
+}
+
+func TestTemplateManager_EjectErrors(t *testing.T) {
+	setUserConfigDir(t)
+	manager := NewTemplateManager()
+
+	_, err := manager.Eject(context.Background(), t.TempDir(), "Nope.tmpl", interfaces.TemplateScopeProject, false)
+	assert.ErrorContains(t, err, `unknown template "project/Nope.tmpl"`)
+
+	_, err = manager.Eject(context.Background(), "", "Dockerfile.tmpl", interfaces.TemplateScopeProject, false)
+	assert.ErrorContains(t, err, "no project template directory")
+}
+
+func TestProjectUpgrader_ProjectOverride(t *testing.T) {
+	setUserConfigDir(t)
+	projectDir := setupUpgradedProject(t)
+	writeTestFile(t, projectDir, ".tracks/templates/project/README.md.tmpl", "# {{.ProjectName}}, our way\n")
+
+	files, err := NewProjectUpgrader().Upgrade(context.Background(), newTestUpgradeConfig(projectDir))
+	require.NoError(t, err)
+
+	assert.Equal(t, DetailUpdated, findFile(t, files, "README.md").Detail)
+	assert.Equal(t, "# testapp, our way\n", readTestFile(t, projectDir, "README.md"))
+}
@@ -0,0 +1,100 @@
+package generator
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	"github.com/rs/zerolog"
+)
+
+// Plan renders every project template in memory and returns the files and
+// external steps Generate would produce for cfg. Nothing is written to disk.
+//
+// The plan lists the files rendered from templates. Generate also writes
+// their base snapshots under .tracks/base, the manifest, and whatever the
+// external steps produce (templUI components, generated code, built assets).
+func (g *projectGenerator) Plan(ctx context.Context, cfg any) (*interfaces.ProjectPlan, error) {
+	logger := zerolog.Ctx(ctx)
+
+	projectCfg, ok := cfg.(ProjectConfig)
+	if !ok {
+		return nil, fmt.Errorf("invalid config type: expected ProjectConfig, got %T", cfg)
+	}
+
+	if projectCfg.Starter != "" {
+		starter, cleanup, err := g.withStarter(projectCfg.Starter, projectCfg.StarterVars)
+		if err != nil {
+			return nil, err
+		}
+		defer cleanup()
+		g = starter
+	}
+
+	data, err := newTemplateData(projectCfg, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	data.Vars = g.vars
+	g = g.withFeatures(data.Features)
+
+	outputs := make(map[string]string)
+	for _, set := range []map[string]string{g.sets.pre, g.sets.post, g.sets.test} {
+		for templateName, outputFile := range set {
+			outputs[templateName] = outputFile
+		}
+	}
+	for _, m := range initialMigrationTemplates {
+		outputs[m.template] = initialMigrationFile(m.dir, data.MigrationTimestamp)
+	}
+
+	plan := &interfaces.ProjectPlan{}
+	for templateName, outputFile := range outputs {
+		content, err := g.renderer.Render(templateName, data)
+		if err != nil {
+			logger.Error().
+				Err(err).
+				Str("template", templateName).
+				Msg("template rendering failed")
+			return nil, fmt.Errorf("failed to render %s: %w", templateName, err)
+		}
+		plan.Files = append(plan.Files, interfaces.PlannedFile{
+			Path:     outputFile,
+			Template: templateName,
+			Size:     len(content),
+		})
+	}
+	sort.Slice(plan.Files, func(i, j int) bool { return plan.Files[i].Path < plan.Files[j].Path })
+
+	// The steps are only listed, so their Run functions never use the root,
+	// rendered map or cache passed here.
+	projectRoot := filepath.Join(projectCfg.OutputPath, projectCfg.ProjectName)
+	gitAfter := []string{stepTestTidy}
+	for _, step := range g.externalSteps(projectRoot, data, nil, stepCache{}) {
+		plan.Steps = append(plan.Steps, interfaces.PlannedStep{
+			Name:      step.Name,
+			DependsOn: step.DependsOn,
+			Optional:  step.Optional,
+		})
+		if step.Name == stepAssets {
+			gitAfter = []string{stepAssets, stepTestTidy}
+		}
+	}
+	if projectCfg.InitGit {
+		plan.Steps = append(plan.Steps, interfaces.PlannedStep{
+			Name:      "git init",
+			DependsOn: gitAfter,
+			Optional:  true,
+		})
+	}
+
+	logger.Debug().
+		Int("file_count", len(plan.Files)).
+		Int("step_count", len(plan.Steps)).
+		Msg("project plan rendered")
+
+	return plan, nil
+}
@@ -0,0 +1,81 @@
+package generator
+
+import (
+	"context"
+	"os"
+	"sort"
+	"testing"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	"github.com/anomalousventures/tracks/internal/process"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectGenerator_Plan(t *testing.T) {
+	outputDir := t.TempDir()
+	cfg := ProjectConfig{
+		ProjectName:    "testapp",
+		ModulePath:     "github.com/example/testapp",
+		DatabaseDriver: "postgres",
+		EnvPrefix:      "APP",
+		InitGit:        true,
+		OutputPath:     outputDir,
+	}
+
+	plan, err := NewProjectGenerator(process.NewRunner()).Plan(context.Background(), cfg)
+	require.NoError(t, err)
+
+	sets := builtinTemplateSets().withFeatures(orFull(interfaces.ProjectFeatures{}))
+	wantFiles := len(sets.pre) + len(sets.post) + len(sets.test) + len(initialMigrationTemplates)
+	assert.Len(t, plan.Files, wantFiles)
+	assert.True(t, sort.SliceIsSorted(plan.Files, func(i, j int) bool { return plan.Files[i].Path < plan.Files[j].Path }), "files should be sorted by path")
+	for _, f := range plan.Files {
+		if f.Path == "go.mod" {
+			assert.Equal(t, "go.mod.tmpl", f.Template)
+			assert.Positive(t, f.Size)
+		}
+	}
+
+	var names []string
+	for _, step := range plan.Steps {
+		names = append(names, step.Name)
+		if step.Name == stepNPMInstall {
+			assert.Empty(t, step.DependsOn, "npm install should not wait for the Go steps")
+		}
+	}
+	assert.Contains(t, names, stepGenerate)
+	assert.Contains(t, names, "git init")
+
+	entries, err := os.ReadDir(outputDir)
+	require.NoError(t, err)
+	assert.Empty(t, entries, "plan should not write to disk")
+}
+
+func TestProjectGenerator_Plan_DriverChangesContent(t *testing.T) {
+	sizes := make(map[string]int)
+	for _, driver := range []string{"go-libsql", "postgres"} {
+		plan, err := NewProjectGenerator(process.NewRunner()).Plan(context.Background(), ProjectConfig{
+			ProjectName:    "testapp",
+			ModulePath:     "github.com/example/testapp",
+			DatabaseDriver: driver,
+			EnvPrefix:      "APP",
+			OutputPath:     t.TempDir(),
+		})
+		require.NoError(t, err)
+		for _, f := range plan.Files {
+			if f.Path == "go.mod" {
+				sizes[driver] = f.Size
+			}
+		}
+		for _, step := range plan.Steps {
+			assert.NotEqual(t, "git init", step.Name, "git init should only be planned when enabled")
+		}
+	}
+	assert.NotEqual(t, sizes["go-libsql"], sizes["postgres"])
+}
+
+func TestProjectGenerator_Plan_InvalidConfig(t *testing.T) {
+	_, err := NewProjectGenerator(process.NewRunner()).Plan(context.Background(), "not a config")
+	assert.ErrorContains(t, err, "invalid config type")
+}
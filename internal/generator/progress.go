@@ -0,0 +1,132 @@
+package generator
+
+import (
+	"context"
+	"sync"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	"github.com/anomalousventures/tracks/internal/generator/steps"
+)
+
+// Generation phases, reported as the progress status.
+const (
+	phaseTemplates    = "templates"
+	phaseDependencies = "dependencies"
+	phaseTemplUI      = "templUI"
+	phaseCodegen      = "codegen"
+	phaseAssets       = "assets"
+	phaseGit          = "git"
+)
+
+// progressLabel labels the generation progress bar.
+const progressLabel = "Generating project"
+
+// stepPhases maps each external step to its phase. A phase is complete when
+// all of its steps have finished.
+var stepPhases = map[string]string{
+	stepTidy:          phaseDependencies,
+	stepDownload:      phaseDependencies,
+	stepNPMInstall:    phaseDependencies,
+	stepTemplUI:       phaseTemplUI,
+	stepFormat:        phaseTemplUI,
+	stepGenerate:      phaseCodegen,
+	stepPostTemplates: phaseCodegen,
+	stepPostTidy:      phaseCodegen,
+	stepTestTemplates: phaseCodegen,
+	stepTestTidy:      phaseCodegen,
+	stepAssets:        phaseAssets,
+}
+
+// phaseProgress reports generation phases to the Progress created by
+// ProjectConfig.NewProgress. The status names the phase that started most
+// recently, since steps of different phases run concurrently, and the
+// progress advances by one as each phase completes. Without NewProgress
+// every method is a no-op.
+type phaseProgress struct {
+	mu        sync.Mutex
+	progress  interfaces.Progress
+	remaining map[string]int
+	started   map[string]bool
+}
+
+// newPhaseProgress creates the progress of a generation running the
+// external steps of plan.
+func newPhaseProgress(cfg ProjectConfig, plan []steps.Step) *phaseProgress {
+	p := &phaseProgress{
+		remaining: map[string]int{phaseTemplates: 1},
+		started:   make(map[string]bool),
+	}
+	for _, step := range plan {
+		p.remaining[stepPhases[step.Name]]++
+	}
+	if cfg.InitGit {
+		p.remaining[phaseGit] = 1
+	}
+
+	if cfg.NewProgress != nil {
+		p.progress = cfg.NewProgress(interfaces.ProgressSpec{
+			Label: progressLabel,
+			Total: int64(len(p.remaining)),
+		})
+	}
+	return p
+}
+
+// start reports that work in phase has begun.
+func (p *phaseProgress) start(phase string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.progress == nil || p.started[phase] {
+		return
+	}
+	p.started[phase] = true
+	p.progress.Status(phase)
+}
+
+// finish reports that one unit of work in phase has finished.
+func (p *phaseProgress) finish(phase string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.progress == nil || p.remaining[phase] == 0 {
+		return
+	}
+	p.remaining[phase]--
+	if p.remaining[phase] == 0 {
+		p.progress.Increment(1)
+	}
+}
+
+// track wraps each step of plan to start its phase when it runs.
+func (p *phaseProgress) track(plan []steps.Step) []steps.Step {
+	tracked := make([]steps.Step, len(plan))
+	for i, step := range plan {
+		run, phase := step.Run, stepPhases[step.Name]
+		step.Run = func(ctx context.Context) error {
+			p.start(phase)
+			return run(ctx)
+		}
+		tracked[i] = step
+	}
+	return tracked
+}
+
+// stepDone finishes the phase of a step that ran. Steps are only skipped
+// when generation is failing, so their phases are left incomplete.
+func (p *phaseProgress) stepDone(result steps.Result) {
+	if result.Skipped {
+		return
+	}
+	p.finish(stepPhases[result.Name])
+}
+
+// done completes the progress, whether or not every phase finished.
+func (p *phaseProgress) done() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.progress != nil {
+		p.progress.Done()
+	}
+}
@@ -0,0 +1,109 @@
+package generator
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	"github.com/anomalousventures/tracks/internal/generator/template"
+	"github.com/anomalousventures/tracks/internal/process/processtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingProgress records the updates made to it.
+type recordingProgress struct {
+	mu       sync.Mutex
+	spec     interfaces.ProgressSpec
+	statuses []string
+	current  int64
+	done     int
+}
+
+func (p *recordingProgress) Increment(n int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.current += n
+}
+
+func (p *recordingProgress) Status(s string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.statuses = append(p.statuses, s)
+}
+
+func (p *recordingProgress) Done() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.done++
+}
+
+func (p *recordingProgress) newProgress(spec interfaces.ProgressSpec) interfaces.Progress {
+	p.spec = spec
+	return p
+}
+
+func TestProjectGenerator_Generate_ReportsPhases(t *testing.T) {
+	progress := &recordingProgress{}
+	cfg := fakeProjectConfig(t)
+	cfg.InitGit = true
+	cfg.NewProgress = progress.newProgress
+
+	err := NewProjectGenerator(processtest.NewRunner()).Generate(context.Background(), cfg)
+	require.NoError(t, err)
+
+	assert.Equal(t, interfaces.ProgressSpec{Label: progressLabel, Total: 6}, progress.spec)
+	assert.Equal(t, phaseTemplates, progress.statuses[0])
+	assert.ElementsMatch(t,
+		[]string{phaseTemplates, phaseDependencies, phaseTemplUI, phaseCodegen, phaseAssets, phaseGit},
+		progress.statuses, "each phase should be reported once")
+	assert.Equal(t, phaseGit, progress.statuses[len(progress.statuses)-1])
+	assert.Equal(t, int64(6), progress.current)
+	assert.Equal(t, 1, progress.done)
+}
+
+func TestProjectGenerator_Generate_ReportsPhasesWithoutGit(t *testing.T) {
+	progress := &recordingProgress{}
+	cfg := fakeProjectConfig(t)
+	cfg.NewProgress = progress.newProgress
+
+	err := NewProjectGenerator(processtest.NewRunner()).Generate(context.Background(), cfg)
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(5), progress.spec.Total)
+	assert.NotContains(t, progress.statuses, phaseGit)
+	assert.Equal(t, int64(5), progress.current)
+}
+
+func TestProjectGenerator_Generate_ProgressDoneOnFailure(t *testing.T) {
+	runner := processtest.NewRunner()
+	runner.Respond("make generate", processtest.Response{Err: errors.New("exit status 2")})
+	progress := &recordingProgress{}
+	cfg := fakeProjectConfig(t)
+	cfg.NewProgress = progress.newProgress
+
+	err := NewProjectGenerator(runner).Generate(context.Background(), cfg)
+	require.Error(t, err)
+
+	assert.Less(t, progress.current, progress.spec.Total, "a failed generation should not complete every phase")
+	assert.Equal(t, 1, progress.done, "progress should be finished even when generation fails")
+}
+
+func TestPhaseProgress_WithoutNewProgress(t *testing.T) {
+	p := newPhaseProgress(ProjectConfig{}, nil)
+
+	assert.NotPanics(t, func() {
+		p.start(phaseTemplates)
+		p.finish(phaseTemplates)
+		p.done()
+	})
+}
+
+func TestStepPhases_CoverEveryStep(t *testing.T) {
+	g := NewProjectGenerator(processtest.NewRunner()).(*projectGenerator)
+	for _, step := range g.externalSteps(t.TempDir(), template.TemplateData{Features: orFull(interfaces.ProjectFeatures{})}, nil, stepCache{}) {
+		assert.Contains(t, stepPhases, step.Name, "step %q should belong to a phase", step.Name)
+	}
+}
@@ -0,0 +1,227 @@
+package generator
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	"github.com/anomalousventures/tracks/internal/generator/template"
+	"golang.org/x/mod/modfile"
+)
+
+// DefaultGoVersion is the Go version written to generated go.mod files
+// unless ProjectConfig.GoVersion is set.
+const DefaultGoVersion = "1.25"
+
+// projectGoVersion returns the Go version to render an existing project's
+// templates with: recorded, the go_version from .tracks.yaml, if set, else
+// the go directive of the project's go.mod. DefaultGoVersion is only used
+// when the project has neither.
+func projectGoVersion(projectDir, recorded string) string {
+	if recorded != "" {
+		return recorded
+	}
+	content, err := os.ReadFile(filepath.Join(projectDir, "go.mod"))
+	if err != nil {
+		return DefaultGoVersion
+	}
+	file, err := modfile.ParseLax("go.mod", content, nil)
+	if err != nil || file.Go == nil {
+		return DefaultGoVersion
+	}
+	return file.Go.Version
+}
+
+// tracksConfigFile is the project metadata file written by `tracks new`.
+const tracksConfigFile = ".tracks.yaml"
+
+// baseSnapshotDir holds, relative to the project root, the rendered output of
+// every upgradable template as last written by `tracks new` or
+// `tracks upgrade`. Upgrades use it as the common ancestor when merging
+// template changes with local edits.
+const baseSnapshotDir = ".tracks/base"
+
+// preGenerateTemplates are rendered before dependencies are downloaded and
+// code is generated, keyed by template name with the output path as value.
+var preGenerateTemplates = map[string]string{
+	".env.example.tmpl":                      ".env.example",
+	".env.tmpl":                              ".env",
+	".gitignore.tmpl":                        ".gitignore",
+	".golangci.yml.tmpl":                     ".golangci.yml",
+	".mockery.yaml.tmpl":                     ".mockery.yaml",
+	".tracks.yaml.tmpl":                      ".tracks.yaml",
+	".air.toml.tmpl":                         ".air.toml",
+	".dockerignore.tmpl":                     ".dockerignore",
+	".github/workflows/ci.yml.tmpl":          ".github/workflows/ci.yml",
+	".gitlab-ci.yml.tmpl":                    ".gitlab-ci.yml",
+	"go.mod.tmpl":                            "go.mod",
+	"Makefile.tmpl":                          "Makefile",
+	"README.md.tmpl":                         "README.md",
+	"Dockerfile.tmpl":                        "Dockerfile",
+	"sqlc.yaml.tmpl":                         "sqlc.yaml",
+	"docker-compose.yml.tmpl":                "docker-compose.yml",
+	"package.json.tmpl":                      "package.json",
+	"internal/config/config.go.tmpl":         "internal/config/config.go",
+	"internal/interfaces/health.go.tmpl":     "internal/interfaces/health.go",
+	"internal/interfaces/logger.go.tmpl":     "internal/interfaces/logger.go",
+	"internal/logging/logger.go.tmpl":        "internal/logging/logger.go",
+	"internal/assets/embed.go.tmpl":          "internal/assets/embed.go",
+	"internal/domain/health/service.go.tmpl": "internal/domain/health/service.go",
+	"internal/http/routes/routes.go.tmpl":    "internal/http/routes/routes.go",
+	"internal/http/routes/health.go.tmpl":    "internal/http/routes/health.go",
+	"internal/http/routes/web.go.tmpl":       "internal/http/routes/web.go",
+	// "internal/http/routes/users.go.tmpl":       "internal/http/routes/users.go", // Example: HYPERMEDIA routes with helpers (not generated by default)
+	"internal/http/handlers/health.go.tmpl":                 "internal/http/handlers/health.go",
+	"internal/http/helpers/context.go.tmpl":                 "internal/http/helpers/context.go",
+	"internal/http/views/helpers.go.tmpl":                   "internal/http/views/helpers.go",
+	"internal/http/views/layouts/base.templ.tmpl":           "internal/http/views/layouts/base.templ",
+	"internal/http/views/components/nav.templ.tmpl":         "internal/http/views/components/nav.templ",
+	"internal/http/views/components/footer.templ.tmpl":      "internal/http/views/components/footer.templ",
+	"internal/http/views/components/meta.templ.tmpl":        "internal/http/views/components/meta.templ",
+	"internal/http/views/components/counter.templ.tmpl":     "internal/http/views/components/counter.templ",
+	"internal/http/views/components/htmx_config.templ.tmpl": "internal/http/views/components/htmx_config.templ",
+	"internal/http/views/pages/home.templ.tmpl":             "internal/http/views/pages/home.templ",
+	"internal/http/views/pages/about.templ.tmpl":            "internal/http/views/pages/about.templ",
+	"internal/http/views/pages/error.templ.tmpl":            "internal/http/views/pages/error.templ",
+	"internal/http/middleware/logging.go.tmpl":              "internal/http/middleware/logging.go",
+	"internal/http/middleware/security.go.tmpl":             "internal/http/middleware/security.go",
+	"internal/http/middleware/compress.go.tmpl":             "internal/http/middleware/compress.go",
+	"internal/http/middleware/cache.go.tmpl":                "internal/http/middleware/cache.go",
+	"internal/http/middleware/timeout.go.tmpl":              "internal/http/middleware/timeout.go",
+	"internal/http/middleware/throttle.go.tmpl":             "internal/http/middleware/throttle.go",
+	"internal/http/middleware/cors.go.tmpl":                 "internal/http/middleware/cors.go",
+	"internal/http/middleware/security_headers.go.tmpl":     "internal/http/middleware/security_headers.go",
+	"internal/http/middleware/middleware.go.tmpl":           "internal/http/middleware/middleware.go",
+	"internal/db/db.go.tmpl":                                "internal/db/db.go",
+	"internal/db/migrate.go.tmpl":                           "internal/db/migrate.go",
+	"cmd/migrate/main.go.tmpl":                              "cmd/migrate/main.go",
+	"internal/db/queries/.gitkeep.tmpl":                     "internal/db/queries/.gitkeep",
+	"internal/db/queries/health.sql.tmpl":                   "internal/db/queries/health.sql",
+	"internal/assets/web/images/.gitkeep.tmpl":              "internal/assets/web/images/.gitkeep",
+	"internal/assets/web/css/app.css.tmpl":                  "internal/assets/web/css/app.css",
+	"internal/assets/web/js/lib/htmx.js.tmpl":               "internal/assets/web/js/lib/htmx.js",
+	"internal/assets/web/js/app.js.tmpl":                    "internal/assets/web/js/app.js",
+	"internal/assets/dist/.gitkeep.tmpl":                    "internal/assets/dist/.gitkeep",
+	"internal/assets/dist/images/.gitkeep.tmpl":             "internal/assets/dist/images/.gitkeep",
+	"internal/assets/dist/css/app.css.tmpl":                 "internal/assets/dist/css/app.css",
+	"internal/assets/dist/js/app.js.tmpl":                   "internal/assets/dist/js/app.js",
+	".templui.json.tmpl":                                    ".templui.json",
+	"internal/pkg/identifier/uuid.go.tmpl":                  "internal/pkg/identifier/uuid.go",
+	"internal/pkg/slug/slug.go.tmpl":                        "internal/pkg/slug/slug.go",
+}
+
+// postGenerateTemplates depend on code produced by `make generate`.
+var postGenerateTemplates = map[string]string{
+	"cmd/server/main.go.tmpl":                   "cmd/server/main.go",
+	"internal/domain/health/repository.go.tmpl": "internal/domain/health/repository.go",
+	"internal/http/server.go.tmpl":              "internal/http/server.go",
+	"internal/http/routes.go.tmpl":              "internal/http/routes.go",
+	"internal/http/helpers/render.go.tmpl":      "internal/http/helpers/render.go",
+	"internal/http/handlers/home.go.tmpl":       "internal/http/handlers/home.go",
+	"internal/http/handlers/about.go.tmpl":      "internal/http/handlers/about.go",
+	"internal/http/handlers/error.go.tmpl":      "internal/http/handlers/error.go",
+	"internal/http/handlers/counter.go.tmpl":    "internal/http/handlers/counter.go",
+}
+
+// testTemplates are rendered last so `go mod tidy` picks up test-only
+// dependencies.
+var testTemplates = map[string]string{
+	"internal/config/config_test.go.tmpl":                 "internal/config/config_test.go",
+	"internal/logging/logger_test.go.tmpl":                "internal/logging/logger_test.go",
+	"internal/assets/embed_test.go.tmpl":                  "internal/assets/embed_test.go",
+	"internal/domain/health/service_test.go.tmpl":         "internal/domain/health/service_test.go",
+	"internal/http/server_test.go.tmpl":                   "internal/http/server_test.go",
+	"internal/http/handlers/health_test.go.tmpl":          "internal/http/handlers/health_test.go",
+	"internal/http/views/components/nav_test.go.tmpl":     "internal/http/views/components/nav_test.go",
+	"internal/http/views/components/footer_test.go.tmpl":  "internal/http/views/components/footer_test.go",
+	"internal/http/views/components/meta_test.go.tmpl":    "internal/http/views/components/meta_test.go",
+	"internal/http/views/components/counter_test.go.tmpl": "internal/http/views/components/counter_test.go",
+	"tests/integration/pages_test.go.tmpl":                "tests/integration/pages_test.go",
+	"tests/integration/error_test.go.tmpl":                "tests/integration/error_test.go",
+	"internal/http/middleware/cache_test.go.tmpl":         "internal/http/middleware/cache_test.go",
+	"internal/pkg/identifier/uuid_test.go.tmpl":           "internal/pkg/identifier/uuid_test.go",
+	"internal/pkg/slug/slug_test.go.tmpl":                 "internal/pkg/slug/slug_test.go",
+	// "internal/http/routes/users_test.go.tmpl":     "internal/http/routes/users_test.go", // Example: HYPERMEDIA route tests (not generated by default)
+}
+
+// templateSets are the project templates rendered in each generation phase,
+// keyed by template name with the output path as value.
+type templateSets struct {
+	pre  map[string]string
+	post map[string]string
+	test map[string]string
+}
+
+// builtinTemplateSets returns the embedded project templates.
+func builtinTemplateSets() templateSets {
+	return templateSets{
+		pre:  preGenerateTemplates,
+		post: postGenerateTemplates,
+		test: testTemplates,
+	}
+}
+
+// initialMigrationTemplates render the initial schema for each migration
+// dialect. Their output names carry the generation timestamp; see
+// initialMigrationFile.
+var initialMigrationTemplates = []struct {
+	template string
+	dir      string
+}{
+	{"internal/db/migrations/sqlite/initial_schema.sql.tmpl", "internal/db/migrations/sqlite"},
+	{"internal/db/migrations/postgres/initial_schema.sql.tmpl", "internal/db/migrations/postgres"},
+}
+
+// initialMigrationFile returns the output path of an initial migration.
+func initialMigrationFile(dir, timestamp string) string {
+	return fmt.Sprintf("%s/%s_initial_schema.sql", dir, timestamp)
+}
+
+// upgradeExcluded lists outputs that upgrades never touch: .env holds the
+// project's secret key and local settings, and .tracks.yaml is updated in
+// place rather than re-rendered.
+var upgradeExcluded = map[string]bool{
+	".env":         true,
+	".tracks.yaml": true,
+}
+
+// isUpgradable reports whether an output path is managed by upgrades. Built
+// assets under internal/assets/dist are overwritten by `make assets` and
+// would otherwise always look locally modified.
+func isUpgradable(outputFile string) bool {
+	return !upgradeExcluded[outputFile] && !strings.HasPrefix(outputFile, "internal/assets/dist/")
+}
+
+// upgradableTemplates returns the project templates managed by upgrades
+// for a project with features, keyed by template name with the output path
+// as value.
+func upgradableTemplates(features interfaces.ProjectFeatures) map[string]string {
+	result := make(map[string]string)
+	for _, set := range []map[string]string{preGenerateTemplates, postGenerateTemplates, testTemplates} {
+		for templateName, outputFile := range set {
+			if isUpgradable(outputFile) && hasOutput(outputFile, features) {
+				result[templateName] = outputFile
+			}
+		}
+	}
+	return result
+}
+
+// baseSnapshotPath returns where the base snapshot of outputFile is stored.
+func baseSnapshotPath(projectRoot, outputFile string) string {
+	return filepath.Join(projectRoot, filepath.FromSlash(baseSnapshotDir), filepath.FromSlash(outputFile))
+}
+
+// renderProjectFile renders a project template to outputFile and, for
+// upgradable files, records the rendered content as the base snapshot.
+func (g *projectGenerator) renderProjectFile(templateName string, data template.TemplateData, projectRoot, outputFile string) error {
+	if err := g.renderer.RenderToFile(templateName, data, filepath.Join(projectRoot, outputFile)); err != nil {
+		return err
+	}
+	if !isUpgradable(outputFile) {
+		return nil
+	}
+	return g.renderer.RenderToFile(templateName, data, baseSnapshotPath(projectRoot, outputFile))
+}
@@ -0,0 +1,343 @@
+package generator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	"github.com/anomalousventures/tracks/internal/database"
+	generatorinterfaces "github.com/anomalousventures/tracks/internal/generator/interfaces"
+	"github.com/anomalousventures/tracks/internal/generator/naming"
+	"github.com/anomalousventures/tracks/internal/generator/template"
+	"github.com/rs/zerolog"
+)
+
+// ResourceConfig holds the inputs for `tracks generate resource`.
+type ResourceConfig struct {
+	// Name is the resource name in any case or number (e.g., "post", "BlogPosts").
+	Name string `json:"name"`
+
+	// Fields are the user-declared columns, parsed with ParseFields.
+	Fields []Field `json:"fields"`
+
+	// ProjectDir is the root of the Tracks project being extended.
+	ProjectDir string `json:"project_dir"`
+
+	// ModulePath is the project's Go module path from .tracks.yaml.
+	ModulePath string `json:"module_path"`
+
+	// DatabaseDriver is the project's driver from .tracks.yaml.
+	DatabaseDriver string `json:"database_driver"`
+
+	// Force overwrites existing resource files.
+	Force bool `json:"force"`
+
+	// SkipGenerate skips `make generate` and the test templates that depend on it.
+	SkipGenerate bool `json:"skip_generate"`
+}
+
+// resourceFile maps a resource template onto its output path. The output path
+// is itself a template so it can include derived names.
+type resourceFile struct {
+	template string
+	output   string
+}
+
+// resourceAppFiles are rendered before `make generate`; resourceTestFiles
+// depend on generated mocks and are rendered after it (ADR-008).
+var (
+	resourceAppFiles = []resourceFile{
+		{"resource/queries.sql.tmpl", "internal/db/queries/{{.Table}}.sql"},
+		{"resource/interfaces.go.tmpl", "internal/interfaces/{{.Singular}}.go"},
+		{"resource/repository.go.tmpl", "internal/domain/{{.Package}}/repository.go"},
+		{"resource/service.go.tmpl", "internal/domain/{{.Package}}/service.go"},
+		{"resource/handler.go.tmpl", "internal/http/handlers/{{.Singular}}.go"},
+		{"resource/routes.go.tmpl", "internal/http/routes/{{.Table}}.go"},
+		{"resource/pages.templ.tmpl", "internal/http/views/pages/{{.Table}}.templ"},
+	}
+
+	resourceTestFiles = []resourceFile{
+		{"resource/service_test.go.tmpl", "internal/domain/{{.Package}}/service_test.go"},
+		{"resource/handler_test.go.tmpl", "internal/http/handlers/{{.Singular}}_test.go"},
+		{"resource/routes_test.go.tmpl", "internal/http/routes/{{.Table}}_test.go"},
+	}
+)
+
+// reservedResourceNames would shadow imports or locals in the generated code.
+var reservedResourceNames = map[string]bool{
+	"action": true, "assert": true, "chi": true, "config": true, "context": true,
+	"db": true, "err": true, "errors": true, "errmsg": true, "fmt": true,
+	"generated": true, "handler": true, "handlers": true, "helpers": true,
+	"http": true, "id": true, "identifier": true, "input": true,
+	"interfaces": true, "layouts": true, "mock": true, "mocks": true,
+	"pages": true, "repository": true, "require": true, "routes": true,
+	"service": true, "strconv": true, "testing": true, "time": true,
+	"values": true,
+}
+
+type resourceGenerator struct {
+	// runner runs make generate.
+	runner   interfaces.CommandRunner
+	renderer generatorinterfaces.TemplateRenderer
+	now      func() time.Time
+}
+
+// NewResourceGenerator creates a generator for CRUD resources in existing
+// projects that runs external commands through runner.
+func NewResourceGenerator(runner interfaces.CommandRunner) interfaces.ResourceGenerator {
+	return &resourceGenerator{
+		runner: runner,
+		now:    time.Now,
+	}
+}
+
+func (g *resourceGenerator) Validate(cfg any) error {
+	resourceCfg, ok := cfg.(ResourceConfig)
+	if !ok {
+		return fmt.Errorf("invalid config type: expected ResourceConfig, got %T", cfg)
+	}
+
+	names, err := newResourceNames(resourceCfg.Name)
+	if err != nil {
+		return err
+	}
+
+	if len(resourceCfg.Fields) == 0 {
+		return errors.New("at least one field is required (e.g., title:string)")
+	}
+
+	if resourceCfg.ModulePath == "" {
+		return errors.New("module path is required")
+	}
+
+	switch resourceCfg.DatabaseDriver {
+	case "go-libsql", "sqlite3", "postgres":
+	default:
+		return fmt.Errorf("unsupported database driver %q", resourceCfg.DatabaseDriver)
+	}
+
+	if resourceCfg.Force {
+		return nil
+	}
+
+	for _, file := range append(append([]resourceFile{}, resourceAppFiles...), resourceTestFiles...) {
+		rel := names.expand(file.output)
+		if _, err := os.Stat(filepath.Join(resourceCfg.ProjectDir, filepath.FromSlash(rel))); err == nil {
+			return fmt.Errorf("%s already exists (use --force to overwrite)", rel)
+		}
+	}
+
+	migrationsDir := database.GetMigrationsDir(resourceCfg.ProjectDir, resourceCfg.DatabaseDriver)
+	matches, _ := filepath.Glob(filepath.Join(migrationsDir, "*_create_"+names.Table+".sql"))
+	if len(matches) > 0 {
+		return fmt.Errorf("a migration creating %s already exists (use --force to generate another)", names.Table)
+	}
+
+	return nil
+}
+
+func (g *resourceGenerator) Generate(ctx context.Context, cfg any) ([]interfaces.GeneratedFile, error) {
+	logger := zerolog.Ctx(ctx)
+
+	if err := g.Validate(cfg); err != nil {
+		return nil, err
+	}
+	resourceCfg := cfg.(ResourceConfig)
+
+	data := newResourceData(resourceCfg)
+	names, _ := newResourceNames(resourceCfg.Name)
+
+	logger.Info().
+		Str("resource", names.Singular).
+		Str("path", resourceCfg.ProjectDir).
+		Msg("starting resource generation")
+
+	var files []interfaces.GeneratedFile
+
+	migration := path.Join(
+		filepath.ToSlash(database.GetMigrationsDir("", resourceCfg.DatabaseDriver)),
+		fmt.Sprintf("%s_create_%s.sql", g.now().Format("20060102150405"), names.Table),
+	)
+	file, err := g.renderFile(ctx, resourceCfg.ProjectDir, "migration/create_table.sql.tmpl", migration, data)
+	if err != nil {
+		return files, err
+	}
+	files = append(files, file)
+
+	for _, rf := range resourceAppFiles {
+		file, err := g.renderFile(ctx, resourceCfg.ProjectDir, rf.template, names.expand(rf.output), data)
+		if err != nil {
+			return files, err
+		}
+		files = append(files, file)
+	}
+
+	wired, err := wireResource(resourceCfg.ProjectDir, data)
+	files = append(files, wired...)
+	if err != nil {
+		return files, err
+	}
+
+	if resourceCfg.SkipGenerate {
+		logger.Info().Msg("skipping make generate and test templates")
+		return files, nil
+	}
+
+	logger.Info().Msg("generating mocks, templates and SQL code")
+	result, err := g.runner.Run(ctx, interfaces.Command{Name: "make", Args: []string{"generate"}, Dir: resourceCfg.ProjectDir, Step: stepGenerate})
+	if err != nil {
+		logger.Error().
+			Err(err).
+			Str("output", string(result.Output)).
+			Msg("make generate failed")
+		return files, fmt.Errorf("failed to generate mocks and SQL code: %w", err)
+	}
+
+	for _, rf := range resourceTestFiles {
+		file, err := g.renderFile(ctx, resourceCfg.ProjectDir, rf.template, names.expand(rf.output), data)
+		if err != nil {
+			return files, err
+		}
+		files = append(files, file)
+	}
+
+	logger.Info().
+		Int("file_count", len(files)).
+		Msg("resource generated successfully")
+
+	return files, nil
+}
+
+// renderFile renders a resource template to rel inside projectDir. The
+// renderer formats Go and templ output, so generated files are gofmt-clean
+// regardless of field name lengths.
+func (g *resourceGenerator) renderFile(ctx context.Context, projectDir, templateName, rel string, data template.ResourceData) (interfaces.GeneratedFile, error) {
+	logger := zerolog.Ctx(ctx)
+	outputPath := filepath.Join(projectDir, filepath.FromSlash(rel))
+
+	logger.Debug().
+		Str("template", templateName).
+		Str("output", outputPath).
+		Msg("rendering template")
+
+	content, err := rendererOr(g.renderer, projectDir).Render(templateName, data)
+	if err != nil {
+		return interfaces.GeneratedFile{}, fmt.Errorf("failed to render %s: %w", templateName, err)
+	}
+
+	action := interfaces.FileActionCreate
+	if _, err := os.Stat(outputPath); err == nil {
+		action = interfaces.FileActionUpdate
+	}
+
+	if err := os.MkdirAll(filepath.Dir(outputPath), 0755); err != nil {
+		return interfaces.GeneratedFile{}, fmt.Errorf("failed to create directory for %s: %w", rel, err)
+	}
+	if err := os.WriteFile(outputPath, []byte(content), 0644); err != nil {
+		return interfaces.GeneratedFile{}, fmt.Errorf("failed to write %s: %w", rel, err)
+	}
+
+	return interfaces.GeneratedFile{Path: rel, Action: action}, nil
+}
+
+// resourceNames holds every name derived from a resource name.
+type resourceNames struct {
+	Singular   string
+	Table      string
+	Type       string
+	TypePlural string
+	Var        string
+	VarPlural  string
+	Package    string
+	Path       string
+}
+
+func newResourceNames(name string) (resourceNames, error) {
+	singular := naming.Singularize(naming.Snake(name))
+	if singular == "" || !isIdentifier(singular) {
+		return resourceNames{}, fmt.Errorf("invalid resource name %q: must start with a letter and contain only letters, digits, and underscores", name)
+	}
+
+	plural := naming.Pluralize(singular)
+	names := resourceNames{
+		Singular:   singular,
+		Table:      plural,
+		Type:       naming.Pascal(singular),
+		TypePlural: naming.Pascal(plural),
+		Var:        naming.Camel(singular),
+		VarPlural:  naming.Camel(plural),
+		Package:    naming.Package(plural),
+		Path:       naming.Kebab(plural),
+	}
+
+	for _, ident := range []string{names.Var, names.VarPlural, names.Package} {
+		if naming.IsGoKeyword(ident) || reservedResourceNames[strings.ToLower(ident)] {
+			return resourceNames{}, fmt.Errorf("invalid resource name %q: %q is reserved in generated code", name, ident)
+		}
+	}
+
+	return names, nil
+}
+
+// expand substitutes {{.Field}} placeholders in an output path.
+func (n resourceNames) expand(pattern string) string {
+	return strings.NewReplacer(
+		"{{.Singular}}", n.Singular,
+		"{{.Table}}", n.Table,
+		"{{.Package}}", n.Package,
+	).Replace(pattern)
+}
+
+func newResourceData(cfg ResourceConfig) template.ResourceData {
+	names, _ := newResourceNames(cfg.Name)
+
+	timestampType := "string"
+	if cfg.DatabaseDriver == "postgres" {
+		timestampType = "time.Time"
+	}
+
+	label := naming.Humanize(names.Singular)
+	labelPlural := naming.Humanize(names.Table)
+
+	return template.ResourceData{
+		ModuleName:      cfg.ModulePath,
+		DBDriver:        cfg.DatabaseDriver,
+		Table:           names.Table,
+		Type:            names.Type,
+		TypePlural:      names.TypePlural,
+		Var:             names.Var,
+		VarPlural:       names.VarPlural,
+		Package:         names.Package,
+		Path:            names.Path,
+		Label:           label,
+		LabelPlural:     labelPlural,
+		Noun:            strings.ToLower(label),
+		NounPlural:      strings.ToLower(labelPlural),
+		TimestampGoType: timestampType,
+		Fields:          newResourceFields(cfg.Fields, cfg.DatabaseDriver),
+	}
+}
+
+// newResourceFields precomputes the template view of each field for driver.
+func newResourceFields(fields []Field, driver string) []template.ResourceField {
+	out := make([]template.ResourceField, len(fields))
+	for i, f := range fields {
+		out[i] = template.ResourceField{
+			Name:      f.Name,
+			Type:      string(f.Type),
+			GoName:    f.GoName(),
+			Label:     f.Label(),
+			Noun:      strings.ToLower(f.Label()),
+			GoType:    f.GoType(driver),
+			SQLType:   f.SQLType(driver),
+			InputType: f.InputType(),
+		}
+	}
+	return out
+}
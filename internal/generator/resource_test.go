@@ -0,0 +1,324 @@
+package generator
+
+import (
+	"context"
+	"errors"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	"github.com/anomalousventures/tracks/internal/generator/template"
+	"github.com/anomalousventures/tracks/internal/process"
+	"github.com/anomalousventures/tracks/internal/process/processtest"
+	"github.com/anomalousventures/tracks/internal/templates"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupResourceProject renders the files a resource is wired into, standing in
+// for a project created by `tracks new`.
+func setupResourceProject(t *testing.T) string {
+	t.Helper()
+	projectDir := t.TempDir()
+
+	renderer := template.NewRenderer(templates.FS)
+	data := template.TemplateData{ModuleName: "github.com/test/app", ProjectName: "app"}
+
+	for _, name := range []string{"cmd/server/main.go", "internal/http/server.go", "internal/http/routes.go"} {
+		err := renderer.RenderToFile(name+".tmpl", data, filepath.Join(projectDir, name))
+		require.NoError(t, err)
+	}
+
+	return projectDir
+}
+
+func newTestResourceGenerator() *resourceGenerator {
+	return &resourceGenerator{
+		runner:   processtest.NewRunner(),
+		renderer: template.NewRenderer(templates.FS),
+		now:      func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) },
+	}
+}
+
+func testResourceConfig(projectDir, driver string) ResourceConfig {
+	return ResourceConfig{
+		Name:           "BlogPosts",
+		Fields:         []Field{{Name: "title", Type: FieldString}, {Name: "views", Type: FieldInt}, {Name: "published_at", Type: FieldTime}},
+		ProjectDir:     projectDir,
+		ModulePath:     "github.com/test/app",
+		DatabaseDriver: driver,
+		SkipGenerate:   true,
+	}
+}
+
+func TestNewResourceGenerator(t *testing.T) {
+	gen := NewResourceGenerator(processtest.NewRunner())
+	assert.NotNil(t, gen)
+}
+
+func TestResourceGenerator_Generate_MakeGenerate(t *testing.T) {
+	projectDir := setupResourceProject(t)
+	runner := processtest.NewRunner()
+	gen := newTestResourceGenerator()
+	gen.runner = runner
+
+	cfg := testResourceConfig(projectDir, "go-libsql")
+	cfg.SkipGenerate = false
+	_, err := gen.Generate(context.Background(), cfg)
+	require.NoError(t, err)
+
+	calls := runner.Calls()
+	require.Len(t, calls, 1)
+	assert.Equal(t, interfaces.Command{Name: "make", Args: []string{"generate"}, Dir: projectDir, Step: stepGenerate}, calls[0])
+	assert.FileExists(t, filepath.Join(projectDir, "internal/domain/blogposts/service_test.go"),
+		"test templates are rendered after make generate")
+}
+
+func TestResourceGenerator_Generate_MakeGenerateFails(t *testing.T) {
+	projectDir := setupResourceProject(t)
+	runner := processtest.NewRunner()
+	runner.Respond("make generate", processtest.Response{
+		Stderr: "templ: syntax error\n",
+		Err:    errors.New("exit status 2"),
+	})
+	gen := newTestResourceGenerator()
+	gen.runner = runner
+
+	cfg := testResourceConfig(projectDir, "go-libsql")
+	cfg.SkipGenerate = false
+	files, err := gen.Generate(context.Background(), cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to generate mocks and SQL code")
+	assert.Contains(t, err.Error(), "templ: syntax error")
+
+	var procErr *process.Error
+	require.ErrorAs(t, err, &procErr)
+	assert.Equal(t, "make generate", procErr.Command)
+
+	assert.NotEmpty(t, files, "files written before the failure are still reported")
+	assert.NoFileExists(t, filepath.Join(projectDir, "internal/domain/blogposts/service_test.go"),
+		"test templates are not rendered when make generate fails")
+}
+
+func TestResourceGenerator_Generate(t *testing.T) {
+	for _, driver := range []string{"go-libsql", "sqlite3", "postgres"} {
+		t.Run(driver, func(t *testing.T) {
+			projectDir := setupResourceProject(t)
+			gen := newTestResourceGenerator()
+
+			files, err := gen.Generate(context.Background(), testResourceConfig(projectDir, driver))
+			require.NoError(t, err)
+
+			migrationDir := "sqlite"
+			if driver == "postgres" {
+				migrationDir = "postgres"
+			}
+
+			expected := map[string]string{
+				"internal/db/migrations/" + migrationDir + "/20250102030405_create_blog_posts.sql": interfaces.FileActionCreate,
+				"internal/db/queries/blog_posts.sql":                                               interfaces.FileActionCreate,
+				"internal/interfaces/blog_post.go":                                                 interfaces.FileActionCreate,
+				"internal/domain/blogposts/repository.go":                                          interfaces.FileActionCreate,
+				"internal/domain/blogposts/service.go":                                             interfaces.FileActionCreate,
+				"internal/http/handlers/blog_post.go":                                              interfaces.FileActionCreate,
+				"internal/http/routes/blog_posts.go":                                               interfaces.FileActionCreate,
+				"internal/http/views/pages/blog_posts.templ":                                       interfaces.FileActionCreate,
+				"internal/http/server.go":                                                          interfaces.FileActionUpdate,
+				"internal/http/routes.go":                                                          interfaces.FileActionUpdate,
+				"cmd/server/main.go":                                                               interfaces.FileActionUpdate,
+			}
+
+			got := make(map[string]string, len(files))
+			for _, f := range files {
+				got[f.Path] = f.Action
+			}
+			assert.Equal(t, expected, got)
+
+			for path := range expected {
+				content, err := os.ReadFile(filepath.Join(projectDir, path))
+				require.NoError(t, err, path)
+
+				if strings.HasSuffix(path, ".go") {
+					_, err := parser.ParseFile(token.NewFileSet(), path, content, parser.AllErrors)
+					assert.NoError(t, err, "%s should be valid Go", path)
+				}
+			}
+
+			assert.NoFileExists(t, filepath.Join(projectDir, "internal/domain/blogposts/service_test.go"),
+				"test templates depend on generated mocks and are skipped with SkipGenerate")
+		})
+	}
+}
+
+func TestResourceGenerator_Generate_Wiring(t *testing.T) {
+	projectDir := setupResourceProject(t)
+	gen := newTestResourceGenerator()
+
+	_, err := gen.Generate(context.Background(), testResourceConfig(projectDir, "go-libsql"))
+	require.NoError(t, err)
+
+	main, err := os.ReadFile(filepath.Join(projectDir, "cmd/server/main.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(main), `"github.com/test/app/internal/domain/blogposts"`)
+	assert.Contains(t, string(main), "blogPostRepo := blogposts.NewRepository(queries)")
+	assert.Contains(t, string(main), "blogPostService := blogposts.NewService(blogPostRepo)")
+	assert.Contains(t, string(main), "WithBlogPostService(blogPostService).")
+
+	server, err := os.ReadFile(filepath.Join(projectDir, "internal/http/server.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(server), "blogPostService interfaces.BlogPostService")
+	assert.Contains(t, string(server), "func (s *Server) WithBlogPostService(svc interfaces.BlogPostService) *Server")
+
+	routes, err := os.ReadFile(filepath.Join(projectDir, "internal/http/routes.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(routes), "blogPostHandler := handlers.NewBlogPostHandler(s.blogPostService, s.logger)")
+	assert.Contains(t, string(routes), "s.router.Delete(routes.BlogPostDelete, blogPostHandler.Delete)")
+
+	webRoutes := string(routes)[strings.Index(string(routes), "TRACKS:WEB_ROUTES:BEGIN"):strings.Index(string(routes), "TRACKS:WEB_ROUTES:END")]
+	assert.Contains(t, webRoutes, "blogPostHandler", "routes should be inserted inside the WEB_ROUTES markers")
+}
+
+func TestResourceGenerator_Generate_Idempotent(t *testing.T) {
+	projectDir := setupResourceProject(t)
+	gen := newTestResourceGenerator()
+
+	cfg := testResourceConfig(projectDir, "postgres")
+	_, err := gen.Generate(context.Background(), cfg)
+	require.NoError(t, err)
+
+	before := map[string][]byte{}
+	for _, path := range []string{"cmd/server/main.go", "internal/http/server.go", "internal/http/routes.go"} {
+		before[path], err = os.ReadFile(filepath.Join(projectDir, path))
+		require.NoError(t, err)
+	}
+
+	cfg.Force = true
+	files, err := gen.Generate(context.Background(), cfg)
+	require.NoError(t, err)
+
+	for path, content := range before {
+		after, err := os.ReadFile(filepath.Join(projectDir, path))
+		require.NoError(t, err)
+		assert.Equal(t, string(content), string(after), "%s should not change when regenerating", path)
+	}
+
+	for _, f := range files {
+		if _, ok := before[f.Path]; ok {
+			assert.Equal(t, interfaces.FileActionSkip, f.Action, f.Path)
+		}
+	}
+}
+
+func TestResourceGenerator_Generate_MissingMarkers(t *testing.T) {
+	projectDir := setupResourceProject(t)
+
+	serverPath := filepath.Join(projectDir, "internal/http/server.go")
+	content, err := os.ReadFile(serverPath)
+	require.NoError(t, err)
+	stripped := strings.NewReplacer(
+		"// TRACKS:SERVICE_SETTERS:BEGIN\n", "",
+		"// TRACKS:SERVICE_SETTERS:END\n", "",
+	).Replace(string(content))
+	require.NoError(t, os.WriteFile(serverPath, []byte(stripped), 0644))
+
+	mainBefore, err := os.ReadFile(filepath.Join(projectDir, "cmd/server/main.go"))
+	require.NoError(t, err)
+
+	gen := newTestResourceGenerator()
+	files, err := gen.Generate(context.Background(), testResourceConfig(projectDir, "go-libsql"))
+	require.NoError(t, err)
+
+	for _, f := range files {
+		if f.Path == "cmd/server/main.go" || f.Path == "internal/http/server.go" || f.Path == "internal/http/routes.go" {
+			assert.Equal(t, interfaces.FileActionSkip, f.Action, f.Path)
+			assert.Contains(t, f.Detail, "TRACKS:SERVICE_SETTERS")
+		}
+	}
+
+	mainAfter, err := os.ReadFile(filepath.Join(projectDir, "cmd/server/main.go"))
+	require.NoError(t, err)
+	assert.Equal(t, string(mainBefore), string(mainAfter), "no file should be wired when any marker is missing")
+}
+
+func TestResourceGenerator_Validate(t *testing.T) {
+	gen := newTestResourceGenerator()
+	projectDir := setupResourceProject(t)
+
+	tests := []struct {
+		name    string
+		modify  func(*ResourceConfig)
+		wantErr string
+	}{
+		{"valid", func(*ResourceConfig) {}, ""},
+		{"empty name", func(c *ResourceConfig) { c.Name = "" }, "invalid resource name"},
+		{"invalid name", func(c *ResourceConfig) { c.Name = "1posts" }, "invalid resource name"},
+		{"keyword", func(c *ResourceConfig) { c.Name = "type" }, "reserved"},
+		{"shadows import", func(c *ResourceConfig) { c.Name = "route" }, "reserved"},
+		{"no fields", func(c *ResourceConfig) { c.Fields = nil }, "at least one field"},
+		{"no module", func(c *ResourceConfig) { c.ModulePath = "" }, "module path"},
+		{"bad driver", func(c *ResourceConfig) { c.DatabaseDriver = "mysql" }, "unsupported database driver"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := testResourceConfig(projectDir, "go-libsql")
+			tt.modify(&cfg)
+
+			err := gen.Validate(cfg)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestResourceGenerator_Validate_ExistingFiles(t *testing.T) {
+	projectDir := setupResourceProject(t)
+	gen := newTestResourceGenerator()
+
+	cfg := testResourceConfig(projectDir, "go-libsql")
+	_, err := gen.Generate(context.Background(), cfg)
+	require.NoError(t, err)
+
+	err = gen.Validate(cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "already exists")
+
+	cfg.Force = true
+	assert.NoError(t, gen.Validate(cfg))
+}
+
+func TestResourceGenerator_Validate_InvalidConfig(t *testing.T) {
+	gen := newTestResourceGenerator()
+
+	err := gen.Validate("not a config")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid config type")
+}
+
+func TestNewResourceNames(t *testing.T) {
+	tests := []struct {
+		input string
+		want  resourceNames
+	}{
+		{"post", resourceNames{Singular: "post", Table: "posts", Type: "Post", TypePlural: "Posts", Var: "post", VarPlural: "posts", Package: "posts", Path: "posts"}},
+		{"BlogPosts", resourceNames{Singular: "blog_post", Table: "blog_posts", Type: "BlogPost", TypePlural: "BlogPosts", Var: "blogPost", VarPlural: "blogPosts", Package: "blogposts", Path: "blog-posts"}},
+		{"category", resourceNames{Singular: "category", Table: "categories", Type: "Category", TypePlural: "Categories", Var: "category", VarPlural: "categories", Package: "categories", Path: "categories"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := newResourceNames(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
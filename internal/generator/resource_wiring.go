@@ -0,0 +1,76 @@
+package generator
+
+import (
+	"fmt"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	"github.com/anomalousventures/tracks/internal/generator/injector"
+	"github.com/anomalousventures/tracks/internal/generator/template"
+)
+
+// resourceWiring returns the edits that register a resource's repository,
+// service, server setter and routes with the generated application.
+func resourceWiring(data template.ResourceData) []injector.File {
+	t, v := data.Type, data.Var
+
+	return []injector.File{
+		{
+			Path: "internal/http/server.go",
+			Edits: []injector.Edit{
+				{Marker: "SERVICE_FIELDS", Lines: []string{
+					fmt.Sprintf("%sService interfaces.%sService", v, t),
+				}},
+				{Marker: "SERVICE_SETTERS", Separate: true, Lines: []string{
+					fmt.Sprintf("func (s *Server) With%sService(svc interfaces.%sService) *Server {", t, t),
+					fmt.Sprintf("\ts.%sService = svc", v),
+					"\treturn s",
+					"}",
+				}},
+			},
+		},
+		{
+			Path: "internal/http/routes.go",
+			Edits: []injector.Edit{
+				{Marker: "WEB_ROUTES", Separate: true, Lines: []string{
+					fmt.Sprintf("%sHandler := handlers.New%sHandler(s.%sService, s.logger)", v, t, v),
+					fmt.Sprintf("s.router.Get(routes.%sIndex, %sHandler.Index)", t, v),
+					fmt.Sprintf("s.router.Get(routes.%sNew, %sHandler.New)", t, v),
+					fmt.Sprintf("s.router.Post(routes.%sCreate, %sHandler.Create)", t, v),
+					fmt.Sprintf("s.router.Get(routes.%sShow, %sHandler.Show)", t, v),
+					fmt.Sprintf("s.router.Get(routes.%sEdit, %sHandler.Edit)", t, v),
+					fmt.Sprintf("s.router.Post(routes.%sUpdate, %sHandler.Update)", t, v),
+					fmt.Sprintf("s.router.Delete(routes.%sDelete, %sHandler.Delete)", t, v),
+				}},
+			},
+		},
+		{
+			Path:    "cmd/server/main.go",
+			Imports: []string{data.ModuleName + "/internal/domain/" + data.Package},
+			Edits: []injector.Edit{
+				{Marker: "REPOSITORIES", Lines: []string{
+					fmt.Sprintf("%sRepo := %s.NewRepository(queries)", v, data.Package),
+				}},
+				{Marker: "SERVICES", Lines: []string{
+					fmt.Sprintf("%sService := %s.NewService(%sRepo)", v, data.Package, v),
+				}},
+				{Marker: "SERVER_SERVICES", Lines: []string{
+					fmt.Sprintf("With%sService(%sService).", t, v),
+				}},
+			},
+		},
+	}
+}
+
+// wireResource applies resourceWiring to the project. The edits depend on each
+// other, so if any file or marker is missing (for example in projects generated
+// before the markers existed) no file is changed and every file is reported as
+// skipped for manual wiring.
+func wireResource(projectDir string, data template.ResourceData) ([]interfaces.GeneratedFile, error) {
+	files, conflicts, err := injector.New(projectDir).Apply(resourceWiring(data))
+	if len(conflicts) > 0 {
+		for i := range files {
+			files[i].Detail += "; wire the resource manually"
+		}
+	}
+	return files, err
+}
@@ -0,0 +1,339 @@
+package generator
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"maps"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	"github.com/anomalousventures/tracks/internal/generator/template"
+	"github.com/rs/zerolog"
+	"gopkg.in/yaml.v3"
+)
+
+// starterManifestFile is the manifest at the root of a starter kit.
+const starterManifestFile = "starter.yaml"
+
+// starterTemplatesDir holds a starter kit's templates, laid out like the
+// embedded templates: a template in templates/project/Dockerfile.tmpl
+// replaces the built-in Dockerfile.tmpl.
+const starterTemplatesDir = "templates"
+
+// starterManifest is the format of starter.yaml.
+type starterManifest struct {
+	Name        string                       `yaml:"name"`
+	Description string                       `yaml:"description"`
+	Variables   []interfaces.StarterVariable `yaml:"variables"`
+
+	// Templates map template names to output paths for each phase, like
+	// preGenerateTemplates, postGenerateTemplates and testTemplates. A
+	// template whose output matches a built-in file replaces it.
+	Templates struct {
+		Pre  map[string]string `yaml:"pre"`
+		Post map[string]string `yaml:"post"`
+		Test map[string]string `yaml:"test"`
+	} `yaml:"templates"`
+}
+
+// starterKit is a starter kit opened for generation.
+type starterKit struct {
+	manifest starterManifest
+
+	// dir is the kit's root directory, extracted for archives.
+	dir string
+
+	// cleanup removes the extracted copy of an archive.
+	cleanup func()
+}
+
+var starterVariableName = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+
+func (g *projectGenerator) LoadStarter(ctx context.Context, source string) (*interfaces.StarterKit, error) {
+	kit, err := openStarter(source)
+	if err != nil {
+		return nil, err
+	}
+	defer kit.cleanup()
+
+	zerolog.Ctx(ctx).Debug().
+		Str("starter", kit.manifest.Name).
+		Str("source", source).
+		Msg("starter kit loaded")
+
+	return &interfaces.StarterKit{
+		Name:        kit.manifest.Name,
+		Description: kit.manifest.Description,
+		Variables:   kit.manifest.Variables,
+	}, nil
+}
+
+// withStarter returns a copy of g that renders the starter kit at source on
+// top of the built-in templates, with vars as its variables. The returned
+// cleanup function must be called once generation is done.
+func (g *projectGenerator) withStarter(source string, vars map[string]string) (*projectGenerator, func(), error) {
+	kit, err := openStarter(source)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resolved, err := kit.resolveVars(vars)
+	if err != nil {
+		kit.cleanup()
+		return nil, nil, err
+	}
+
+	kitTemplates := filepath.Join(kit.dir, starterTemplatesDir)
+	sources := templateFS("", kitTemplates)
+	starter := *g
+	starter.renderer = template.NewRenderer(sources)
+	starter.templates = sources
+	starter.sets = templateSets{
+		pre:  mergeTemplateSet(g.sets.pre, kit.manifest.Templates.Pre),
+		post: mergeTemplateSet(g.sets.post, kit.manifest.Templates.Post),
+		test: mergeTemplateSet(g.sets.test, kit.manifest.Templates.Test),
+	}
+	starter.vars = resolved
+	starter.starterTemplates = os.DirFS(kitTemplates)
+
+	for _, set := range []map[string]string{starter.sets.pre, starter.sets.post, starter.sets.test} {
+		for templateName := range set {
+			if _, err := fs.Stat(sources, template.ResolvePath(templateName)); err != nil {
+				kit.cleanup()
+				return nil, nil, fmt.Errorf("starter kit %s: template %s not found", kit.manifest.Name, templateName)
+			}
+		}
+	}
+
+	return &starter, kit.cleanup, nil
+}
+
+// resolveVars fills in defaults and checks that vars only sets declared
+// variables and every required variable has a value.
+func (k *starterKit) resolveVars(vars map[string]string) (map[string]string, error) {
+	resolved := make(map[string]string, len(k.manifest.Variables))
+	declared := make(map[string]bool, len(k.manifest.Variables))
+	for _, v := range k.manifest.Variables {
+		declared[v.Name] = true
+		value, ok := vars[v.Name]
+		if !ok {
+			value = v.Default
+		}
+		if v.Required && value == "" {
+			return nil, fmt.Errorf("starter kit %s requires a value for %s", k.manifest.Name, v.Name)
+		}
+		resolved[v.Name] = value
+	}
+	for name := range vars {
+		if !declared[name] {
+			return nil, fmt.Errorf("starter kit %s has no variable %s", k.manifest.Name, name)
+		}
+	}
+	return resolved, nil
+}
+
+// mergeTemplateSet returns builtin with extra added. Built-in templates
+// whose output extra also writes are dropped.
+func mergeTemplateSet(builtin, extra map[string]string) map[string]string {
+	outputs := make(map[string]bool, len(extra))
+	for _, outputFile := range extra {
+		outputs[outputFile] = true
+	}
+
+	merged := make(map[string]string, len(builtin)+len(extra))
+	for templateName, outputFile := range builtin {
+		if !outputs[outputFile] {
+			merged[templateName] = outputFile
+		}
+	}
+	maps.Copy(merged, extra)
+	return merged
+}
+
+// openStarter opens the starter kit at source and validates its manifest.
+// Archives are extracted to a temporary directory; a single top-level
+// directory in the archive is treated as the kit's root.
+func openStarter(source string) (*starterKit, error) {
+	info, err := os.Stat(source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open starter kit: %w", err)
+	}
+
+	kit := &starterKit{dir: source, cleanup: func() {}}
+	if !info.IsDir() {
+		tmp, err := os.MkdirTemp("", "tracks-starter-")
+		if err != nil {
+			return nil, fmt.Errorf("failed to extract starter kit: %w", err)
+		}
+		kit.cleanup = func() { _ = os.RemoveAll(tmp) }
+
+		if err := extractArchive(source, tmp); err != nil {
+			kit.cleanup()
+			return nil, err
+		}
+		kit.dir = archiveRoot(tmp)
+	}
+
+	if err := kit.readManifest(); err != nil {
+		kit.cleanup()
+		return nil, err
+	}
+	return kit, nil
+}
+
+func (k *starterKit) readManifest() error {
+	content, err := os.ReadFile(filepath.Join(k.dir, starterManifestFile))
+	if err != nil {
+		return fmt.Errorf("failed to read starter kit manifest: %w", err)
+	}
+	if err := yaml.Unmarshal(content, &k.manifest); err != nil {
+		return fmt.Errorf("failed to parse %s: %w", starterManifestFile, err)
+	}
+
+	m := &k.manifest
+	if m.Name == "" {
+		return fmt.Errorf("%s: name is required", starterManifestFile)
+	}
+
+	seen := make(map[string]bool)
+	for _, v := range m.Variables {
+		if !starterVariableName.MatchString(v.Name) {
+			return fmt.Errorf("%s: invalid variable name %q", starterManifestFile, v.Name)
+		}
+		if seen[v.Name] {
+			return fmt.Errorf("%s: variable %s declared twice", starterManifestFile, v.Name)
+		}
+		seen[v.Name] = true
+	}
+
+	for _, set := range []map[string]string{m.Templates.Pre, m.Templates.Post, m.Templates.Test} {
+		for templateName, outputFile := range set {
+			if !isRelativePath(outputFile) {
+				return fmt.Errorf("%s: output %q of %s must be a relative path inside the project", starterManifestFile, outputFile, templateName)
+			}
+		}
+	}
+	return nil
+}
+
+// isRelativePath reports whether p, using forward slashes, stays inside the
+// directory it is relative to.
+func isRelativePath(p string) bool {
+	return p != "" && !path.IsAbs(p) && !filepath.IsAbs(p) && filepath.IsLocal(filepath.FromSlash(p))
+}
+
+// archiveRoot returns dir, or its only entry if that is a directory.
+func archiveRoot(dir string) string {
+	entries, err := os.ReadDir(dir)
+	if err != nil || len(entries) != 1 || !entries[0].IsDir() {
+		return dir
+	}
+	if _, err := os.Stat(filepath.Join(dir, starterManifestFile)); err == nil {
+		return dir
+	}
+	return filepath.Join(dir, entries[0].Name())
+}
+
+// extractArchive extracts a .zip, .tar.gz or .tgz archive into dir. Entries
+// that would land outside dir, and anything other than regular files and
+// directories, are rejected.
+func extractArchive(archive, dir string) error {
+	switch {
+	case strings.HasSuffix(archive, ".zip"):
+		return extractZip(archive, dir)
+	case strings.HasSuffix(archive, ".tar.gz"), strings.HasSuffix(archive, ".tgz"):
+		return extractTarGz(archive, dir)
+	}
+	return fmt.Errorf("unsupported starter kit %s: expected a directory, .zip, .tar.gz or .tgz", archive)
+}
+
+func extractZip(archive, dir string) error {
+	r, err := zip.OpenReader(archive)
+	if err != nil {
+		return fmt.Errorf("failed to open starter kit archive: %w", err)
+	}
+	defer r.Close()
+
+	for _, f := range r.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		if !f.Mode().IsRegular() {
+			return fmt.Errorf("starter kit archive entry %s is not a regular file", f.Name)
+		}
+		src, err := f.Open()
+		if err != nil {
+			return fmt.Errorf("failed to read %s from starter kit archive: %w", f.Name, err)
+		}
+		err = extractFile(dir, f.Name, src)
+		src.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func extractTarGz(archive, dir string) error {
+	file, err := os.Open(archive)
+	if err != nil {
+		return fmt.Errorf("failed to open starter kit archive: %w", err)
+	}
+	defer file.Close()
+
+	gz, err := gzip.NewReader(file)
+	if err != nil {
+		return fmt.Errorf("failed to open starter kit archive: %w", err)
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read starter kit archive: %w", err)
+		}
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			continue
+		case tar.TypeReg:
+			if err := extractFile(dir, hdr.Name, tr); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("starter kit archive entry %s is not a regular file", hdr.Name)
+		}
+	}
+}
+
+func extractFile(dir, name string, src io.Reader) error {
+	if !isRelativePath(name) {
+		return fmt.Errorf("starter kit archive entry %s escapes the archive", name)
+	}
+	dst := filepath.Join(dir, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return err
+	}
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, src); err != nil {
+		out.Close()
+		return fmt.Errorf("failed to extract %s: %w", name, err)
+	}
+	return out.Close()
+}
@@ -0,0 +1,314 @@
+package generator
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	"github.com/anomalousventures/tracks/internal/generator/template"
+	"github.com/anomalousventures/tracks/internal/process"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testStarterManifest = `name: saas
+description: Billing and teams
+variables:
+  - name: Company
+    prompt: Company name
+    required: true
+  - name: Plan
+    default: free
+templates:
+  pre:
+    billing.go.tmpl: internal/billing/billing.go
+    saas-readme.md.tmpl: README.md
+`
+
+// testStarterFiles is a starter kit adding a file, replacing the README
+// mapping and overriding the built-in Dockerfile template.
+var testStarterFiles = map[string]string{
+	"starter.yaml":                            testStarterManifest,
+	"templates/project/billing.go.tmpl":       "package billing\n\nconst Plan = \"{{.Vars.Plan}}\"\n",
+	"templates/project/saas-readme.md.tmpl":   "# {{.ProjectName}} by {{.Vars.Company}}\n",
+	"templates/project/Dockerfile.tmpl":       "FROM {{.Vars.Company}}\n",
+	"templates/project/unused/notes.txt.tmpl": "not mapped\n",
+}
+
+func writeStarterDir(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+	return dir
+}
+
+func writeStarterTarGz(t *testing.T, files map[string]string) string {
+	t.Helper()
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for name, content := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{
+			Name:     name,
+			Mode:     0644,
+			Size:     int64(len(content)),
+			Typeflag: tar.TypeReg,
+		}))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+
+	archive := filepath.Join(t.TempDir(), "kit.tar.gz")
+	require.NoError(t, os.WriteFile(archive, buf.Bytes(), 0644))
+	return archive
+}
+
+func writeStarterZip(t *testing.T, files map[string]string) string {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+
+	archive := filepath.Join(t.TempDir(), "kit.zip")
+	require.NoError(t, os.WriteFile(archive, buf.Bytes(), 0644))
+	return archive
+}
+
+// prefixed returns files under a single top-level directory, as archives
+// made with `tar czf kit.tar.gz kit/` are.
+func prefixed(files map[string]string) map[string]string {
+	out := make(map[string]string, len(files))
+	for name, content := range files {
+		out["saas-kit/"+name] = content
+	}
+	return out
+}
+
+func TestProjectGenerator_LoadStarter(t *testing.T) {
+	sources := map[string]string{
+		"directory": writeStarterDir(t, testStarterFiles),
+		"tar.gz":    writeStarterTarGz(t, prefixed(testStarterFiles)),
+		"zip":       writeStarterZip(t, testStarterFiles),
+	}
+
+	for name, source := range sources {
+		t.Run(name, func(t *testing.T) {
+			kit, err := NewProjectGenerator(process.NewRunner()).LoadStarter(context.Background(), source)
+			require.NoError(t, err)
+
+			assert.Equal(t, "saas", kit.Name)
+			assert.Equal(t, "Billing and teams", kit.Description)
+			assert.Equal(t, []interfaces.StarterVariable{
+				{Name: "Company", Prompt: "Company name", Required: true},
+				{Name: "Plan", Default: "free"},
+			}, kit.Variables)
+		})
+	}
+}
+
+func TestProjectGenerator_LoadStarter_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   map[string]string
+		wantErr string
+	}{
+		{
+			name:    "missing manifest",
+			files:   map[string]string{"templates/project/a.tmpl": ""},
+			wantErr: "failed to read starter kit manifest",
+		},
+		{
+			name:    "missing name",
+			files:   map[string]string{"starter.yaml": "description: nameless\n"},
+			wantErr: "name is required",
+		},
+		{
+			name:    "invalid variable name",
+			files:   map[string]string{"starter.yaml": "name: kit\nvariables:\n  - name: my-var\n"},
+			wantErr: `invalid variable name "my-var"`,
+		},
+		{
+			name:    "duplicate variable",
+			files:   map[string]string{"starter.yaml": "name: kit\nvariables:\n  - name: A\n  - name: A\n"},
+			wantErr: "variable A declared twice",
+		},
+		{
+			name:    "output outside project",
+			files:   map[string]string{"starter.yaml": "name: kit\ntemplates:\n  pre:\n    a.tmpl: ../a.go\n"},
+			wantErr: "must be a relative path inside the project",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewProjectGenerator(process.NewRunner()).LoadStarter(context.Background(), writeStarterDir(t, tt.files))
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestProjectGenerator_LoadStarter_UnsafeArchive(t *testing.T) {
+	archive := writeStarterTarGz(t, map[string]string{
+		"starter.yaml":  "name: kit\n",
+		"../escaped.go": "package escaped\n",
+	})
+
+	_, err := NewProjectGenerator(process.NewRunner()).LoadStarter(context.Background(), archive)
+	assert.ErrorContains(t, err, "escapes the archive")
+	assert.NoFileExists(t, filepath.Join(filepath.Dir(archive), "escaped.go"))
+}
+
+func TestProjectGenerator_LoadStarter_UnsupportedFile(t *testing.T) {
+	source := filepath.Join(t.TempDir(), "kit.rar")
+	require.NoError(t, os.WriteFile(source, []byte("rar"), 0644))
+
+	_, err := NewProjectGenerator(process.NewRunner()).LoadStarter(context.Background(), source)
+	assert.ErrorContains(t, err, "unsupported starter kit")
+}
+
+func TestProjectGenerator_Plan_Starter(t *testing.T) {
+	setUserConfigDir(t)
+	cfg := ProjectConfig{
+		ProjectName:    "testapp",
+		ModulePath:     "github.com/example/testapp",
+		DatabaseDriver: "go-libsql",
+		EnvPrefix:      "APP",
+		OutputPath:     t.TempDir(),
+		Starter:        writeStarterTarGz(t, prefixed(testStarterFiles)),
+		StarterVars:    map[string]string{"Company": "Acme"},
+	}
+
+	plan, err := NewProjectGenerator(process.NewRunner()).Plan(context.Background(), cfg)
+	require.NoError(t, err)
+
+	files := make(map[string]interfaces.PlannedFile)
+	for _, f := range plan.Files {
+		files[f.Path] = f
+	}
+
+	require.Contains(t, files, "internal/billing/billing.go")
+	assert.Equal(t, len("package billing\n\nconst Plan = \"free\"\n"), files["internal/billing/billing.go"].Size)
+	assert.Equal(t, "saas-readme.md.tmpl", files["README.md"].Template, "the starter's README should replace the built-in one")
+	assert.Equal(t, len("FROM Acme\n"), files["Dockerfile"].Size, "the starter's Dockerfile template should override the embedded one")
+	assert.Contains(t, files, "go.mod", "built-in files the starter doesn't replace should still be generated")
+	assert.NotContains(t, files, "unused/notes.txt")
+}
+
+func TestProjectGenerator_WithStarter_Render(t *testing.T) {
+	setUserConfigDir(t)
+	g := NewProjectGenerator(process.NewRunner()).(*projectGenerator)
+
+	starter, cleanup, err := g.withStarter(writeStarterDir(t, testStarterFiles), map[string]string{
+		"Company": "Acme",
+		"Plan":    "pro",
+	})
+	require.NoError(t, err)
+	defer cleanup()
+
+	content, err := starter.renderer.Render("saas-readme.md.tmpl", template.TemplateData{
+		ProjectName: "testapp",
+		Vars:        starter.vars,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "# testapp by Acme\n", content)
+
+	assert.Equal(t, "README.md.tmpl", func() string {
+		for name, out := range g.sets.pre {
+			if out == "README.md" {
+				return name
+			}
+		}
+		return ""
+	}(), "withStarter should not modify the original generator")
+}
+
+func TestProjectGenerator_WithStarter_Vars(t *testing.T) {
+	setUserConfigDir(t)
+	source := writeStarterDir(t, testStarterFiles)
+	g := NewProjectGenerator(process.NewRunner()).(*projectGenerator)
+
+	t.Run("defaults", func(t *testing.T) {
+		starter, cleanup, err := g.withStarter(source, map[string]string{"Company": "Acme"})
+		require.NoError(t, err)
+		defer cleanup()
+		assert.Equal(t, map[string]string{"Company": "Acme", "Plan": "free"}, starter.vars)
+	})
+
+	t.Run("missing required", func(t *testing.T) {
+		_, _, err := g.withStarter(source, nil)
+		assert.ErrorContains(t, err, "requires a value for Company")
+	})
+
+	t.Run("unknown variable", func(t *testing.T) {
+		_, _, err := g.withStarter(source, map[string]string{"Company": "Acme", "Seats": "5"})
+		assert.ErrorContains(t, err, "has no variable Seats")
+	})
+}
+
+func TestProjectGenerator_WithStarter_MissingTemplate(t *testing.T) {
+	setUserConfigDir(t)
+	source := writeStarterDir(t, map[string]string{
+		"starter.yaml": "name: kit\ntemplates:\n  post:\n    missing.go.tmpl: internal/missing.go\n",
+	})
+
+	_, _, err := NewProjectGenerator(process.NewRunner()).(*projectGenerator).withStarter(source, nil)
+	assert.ErrorContains(t, err, "template missing.go.tmpl not found")
+}
+
+func TestProjectUpgrader_StarterKit(t *testing.T) {
+	setUserConfigDir(t)
+	g := NewProjectGenerator(process.NewRunner()).(*projectGenerator)
+	starter, cleanup, err := g.withStarter(writeStarterDir(t, testStarterFiles), map[string]string{"Company": "Acme"})
+	require.NoError(t, err)
+	defer cleanup()
+
+	projectDir := t.TempDir()
+	data := template.TemplateData{
+		ModuleName:  "github.com/example/testapp",
+		ProjectName: "testapp",
+		DBDriver:    "go-libsql",
+		GoVersion:   DefaultGoVersion,
+		EnvPrefix:   "APP",
+		Features:    orFull(interfaces.ProjectFeatures{}),
+		Vars:        starter.vars,
+	}
+	rendered := make(map[string]string)
+	require.NoError(t, starter.renderTemplateSet(context.Background(), starter.sets.pre, data, projectDir, rendered))
+	require.NoError(t, writeGenerationManifest(starter.templates, starter.starterTemplates, projectDir, rendered, time.Now()))
+
+	files, err := NewProjectUpgrader().Upgrade(context.Background(), newTestUpgradeConfig(projectDir))
+	require.NoError(t, err)
+
+	for _, path := range []string{"Dockerfile", "README.md"} {
+		got := findFile(t, files, path)
+		assert.Equal(t, interfaces.FileActionSkip, got.Action, path)
+		assert.Equal(t, DetailStarterKit, got.Detail, path)
+	}
+	assert.Equal(t, "FROM Acme\n", readTestFile(t, projectDir, "Dockerfile"))
+	assert.Equal(t, "# testapp by Acme\n", readTestFile(t, projectDir, "README.md"))
+	assert.Equal(t, DetailUpToDate, findFile(t, files, "go.mod").Detail, "built-in files are still upgraded")
+
+	m, err := ReadManifest(projectDir)
+	require.NoError(t, err)
+	assert.True(t, m.file("Dockerfile").Starter, "the starter mark must survive the upgrade")
+	assert.False(t, m.file("go.mod").Starter)
+}
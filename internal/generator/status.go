@@ -0,0 +1,60 @@
+package generator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	"github.com/rs/zerolog"
+)
+
+type statusChecker struct{}
+
+// NewStatusChecker creates a checker that compares projects with their
+// generation manifest.
+func NewStatusChecker() interfaces.StatusChecker {
+	return &statusChecker{}
+}
+
+func (c *statusChecker) Status(ctx context.Context, projectDir string) ([]interfaces.FileStatus, error) {
+	logger := zerolog.Ctx(ctx)
+
+	m, err := ReadManifest(projectDir)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]interfaces.FileStatus, 0, len(m.Files))
+	for _, f := range m.Files {
+		state, err := fileState(projectDir, f)
+		if err != nil {
+			return nil, err
+		}
+		statuses = append(statuses, interfaces.FileStatus{Path: f.Path, State: state})
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Path < statuses[j].Path })
+
+	logger.Debug().
+		Int("file_count", len(statuses)).
+		Msg("compared project with manifest")
+
+	return statuses, nil
+}
+
+func fileState(projectDir string, f ManifestFile) (string, error) {
+	content, err := os.ReadFile(filepath.Join(projectDir, filepath.FromSlash(f.Path)))
+	if errors.Is(err, os.ErrNotExist) {
+		return interfaces.FileStateDeleted, nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s: %w", f.Path, err)
+	}
+	if contentHash(content) != f.SHA256 {
+		return interfaces.FileStateModified, nil
+	}
+	return interfaces.FileStateUntouched, nil
+}
@@ -0,0 +1,89 @@
+package generator
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	"github.com/anomalousventures/tracks/internal/templates"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteGenerationManifest(t *testing.T) {
+	projectDir := t.TempDir()
+	writeTestFile(t, projectDir, "go.mod", "module example\n")
+	writeTestFile(t, projectDir, "README.md", "# app\n")
+
+	rendered := map[string]string{"README.md": "README.md.tmpl", "go.mod": "go.mod.tmpl"}
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	require.NoError(t, writeGenerationManifest(templates.FS, nil, projectDir, rendered, now))
+
+	m, err := ReadManifest(projectDir)
+	require.NoError(t, err)
+	assert.Equal(t, manifestSchemaVersion, m.SchemaVersion)
+	assert.Equal(t, now, m.UpdatedAt)
+	require.Len(t, m.Files, 2)
+
+	assert.Equal(t, "README.md", m.Files[0].Path, "files should be sorted by path")
+	assert.Equal(t, "README.md.tmpl", m.Files[0].Template)
+	assert.Equal(t, contentHash([]byte("# app\n")), m.Files[0].SHA256)
+
+	version, err := templateVersion(templates.FS, "README.md.tmpl")
+	require.NoError(t, err)
+	assert.Equal(t, version, m.Files[0].TemplateVersion)
+	assert.NotEqual(t, m.Files[0].TemplateVersion, m.Files[1].TemplateVersion)
+}
+
+func TestWriteGenerationManifest_MissingFile(t *testing.T) {
+	err := writeGenerationManifest(templates.FS, nil, t.TempDir(), map[string]string{"README.md": "README.md.tmpl"}, time.Now())
+	assert.ErrorContains(t, err, "README.md")
+}
+
+func TestReadManifest_Errors(t *testing.T) {
+	_, err := ReadManifest(t.TempDir())
+	assert.ErrorIs(t, err, ErrNoManifest)
+
+	projectDir := t.TempDir()
+	writeTestFile(t, projectDir, manifestFile, "{not json")
+	_, err = ReadManifest(projectDir)
+	assert.ErrorContains(t, err, "failed to parse")
+
+	writeTestFile(t, projectDir, manifestFile, `{"schema_version": 99, "files": []}`)
+	_, err = ReadManifest(projectDir)
+	assert.ErrorContains(t, err, "unsupported schema version 99")
+}
+
+func TestStatusChecker_Status(t *testing.T) {
+	projectDir := t.TempDir()
+	writeTestFile(t, projectDir, "Makefile", "all:\n")
+	writeTestFile(t, projectDir, "README.md", "# app\n")
+	writeTestFile(t, projectDir, "go.mod", "module example\n")
+
+	rendered := map[string]string{
+		"Makefile":  "Makefile.tmpl",
+		"README.md": "README.md.tmpl",
+		"go.mod":    "go.mod.tmpl",
+	}
+	require.NoError(t, writeGenerationManifest(templates.FS, nil, projectDir, rendered, time.Now()))
+
+	writeTestFile(t, projectDir, "Makefile", "all: build\n")
+	require.NoError(t, os.Remove(filepath.Join(projectDir, "README.md")))
+
+	statuses, err := NewStatusChecker().Status(context.Background(), projectDir)
+	require.NoError(t, err)
+
+	assert.Equal(t, []interfaces.FileStatus{
+		{Path: "Makefile", State: interfaces.FileStateModified},
+		{Path: "README.md", State: interfaces.FileStateDeleted},
+		{Path: "go.mod", State: interfaces.FileStateUntouched},
+	}, statuses)
+}
+
+func TestStatusChecker_NoManifest(t *testing.T) {
+	_, err := NewStatusChecker().Status(context.Background(), t.TempDir())
+	assert.ErrorIs(t, err, ErrNoManifest)
+}
@@ -0,0 +1,22 @@
+// Package steps runs the external commands of project generation as a
+// dependency graph.
+//
+// Each Step names the steps it depends on. Run starts a step as soon as all
+// of its dependencies have finished, so independent work such as
+// `npm install` and the Go module downloads overlaps instead of running one
+// after the other. Every step is timed and reported through an optional
+// callback as it completes.
+//
+// Steps are either required or optional, mirroring how project generation
+// treats its commands: a failed optional step is reported and its dependents
+// still run, while a failed required step cancels the remaining work and
+// makes Run return its error.
+//
+// Example:
+//
+//	results, err := steps.Run(ctx, []steps.Step{
+//	    {Name: "go mod tidy", Optional: true, Run: tidy},
+//	    {Name: "npm install", Optional: true, Run: npmInstall},
+//	    {Name: "make generate", DependsOn: []string{"go mod tidy"}, Run: generate},
+//	}, nil)
+package steps
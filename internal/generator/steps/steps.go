@@ -0,0 +1,189 @@
+package steps
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Step is one unit of work in a generation plan.
+type Step struct {
+	// Name identifies the step in DependsOn lists and results.
+	Name string
+
+	// DependsOn lists the names of steps that must finish first.
+	DependsOn []string
+
+	// Optional steps may fail without stopping the plan.
+	Optional bool
+
+	// Run performs the step. It should stop promptly when ctx is canceled.
+	Run func(ctx context.Context) error
+}
+
+// Result describes how a step ran.
+type Result struct {
+	Name     string
+	Duration time.Duration
+
+	// Err is the error returned by the step, if any.
+	Err error
+
+	// Optional is copied from the step, so callers can tell warnings from
+	// failures.
+	Optional bool
+
+	// Skipped is set when the step did not run because a required step
+	// failed or the context was canceled.
+	Skipped bool
+}
+
+// Run executes the steps, each as soon as its dependencies have finished,
+// and returns their results in the order the steps were given. onDone, if
+// not nil, is called as each step finishes or is skipped; calls are never
+// concurrent.
+//
+// The returned error is the first failure of a required step, or the
+// context's error if it was canceled. A plan with unknown dependencies or a
+// cycle is rejected before any step runs.
+func Run(ctx context.Context, plan []Step, onDone func(Result)) ([]Result, error) {
+	index, err := validate(plan)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		mu       sync.Mutex
+		firstErr error
+		wg       sync.WaitGroup
+	)
+
+	results := make([]Result, len(plan))
+	failed := make([]bool, len(plan))
+	done := make([]chan struct{}, len(plan))
+	for i := range plan {
+		done[i] = make(chan struct{})
+	}
+
+	finish := func(i int, result Result) {
+		results[i] = result
+		mu.Lock()
+		if onDone != nil {
+			onDone(result)
+		}
+		mu.Unlock()
+		close(done[i])
+	}
+
+	for i, step := range plan {
+		wg.Add(1)
+		go func(i int, step Step) {
+			defer wg.Done()
+
+			blocked := false
+			for _, dep := range step.DependsOn {
+				d := index[dep]
+				<-done[d]
+				blocked = blocked || failed[d]
+			}
+
+			if blocked || ctx.Err() != nil {
+				failed[i] = true
+				finish(i, Result{Name: step.Name, Optional: step.Optional, Skipped: true})
+				return
+			}
+
+			start := time.Now()
+			stepErr := step.Run(ctx)
+			result := Result{
+				Name:     step.Name,
+				Duration: time.Since(start),
+				Err:      stepErr,
+				Optional: step.Optional,
+			}
+
+			if stepErr != nil && !step.Optional {
+				failed[i] = true
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = fmt.Errorf("%s: %w", step.Name, stepErr)
+				}
+				mu.Unlock()
+				cancel()
+			}
+			finish(i, result)
+		}(i, step)
+	}
+
+	wg.Wait()
+
+	if firstErr != nil {
+		return results, firstErr
+	}
+	// The parent context, not our own cancel, stopped the plan.
+	if err := ctx.Err(); err != nil {
+		return results, err
+	}
+	return results, nil
+}
+
+// validate checks that step names are unique, every dependency exists and
+// the graph has no cycles. It returns the position of each step by name.
+func validate(plan []Step) (map[string]int, error) {
+	index := make(map[string]int, len(plan))
+	for i, step := range plan {
+		if step.Name == "" {
+			return nil, fmt.Errorf("step %d has no name", i)
+		}
+		if _, dup := index[step.Name]; dup {
+			return nil, fmt.Errorf("duplicate step %q", step.Name)
+		}
+		if step.Run == nil {
+			return nil, fmt.Errorf("step %q has no Run function", step.Name)
+		}
+		index[step.Name] = i
+	}
+
+	for _, step := range plan {
+		for _, dep := range step.DependsOn {
+			if _, ok := index[dep]; !ok {
+				return nil, fmt.Errorf("step %q depends on unknown step %q", step.Name, dep)
+			}
+		}
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make([]int, len(plan))
+	var visit func(i int) error
+	visit = func(i int) error {
+		switch state[i] {
+		case visiting:
+			return fmt.Errorf("dependency cycle through step %q", plan[i].Name)
+		case visited:
+			return nil
+		}
+		state[i] = visiting
+		for _, dep := range plan[i].DependsOn {
+			if err := visit(index[dep]); err != nil {
+				return err
+			}
+		}
+		state[i] = visited
+		return nil
+	}
+	for i := range plan {
+		if err := visit(i); err != nil {
+			return nil, err
+		}
+	}
+
+	return index, nil
+}
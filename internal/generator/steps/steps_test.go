@@ -0,0 +1,198 @@
+package steps
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func noop(context.Context) error { return nil }
+
+// recorder records the order in which steps start.
+type recorder struct {
+	mu    sync.Mutex
+	order []string
+}
+
+func (r *recorder) step(name string, deps ...string) Step {
+	return Step{Name: name, DependsOn: deps, Run: func(context.Context) error {
+		r.mu.Lock()
+		r.order = append(r.order, name)
+		r.mu.Unlock()
+		return nil
+	}}
+}
+
+func (r *recorder) position(name string) int {
+	for i, n := range r.order {
+		if n == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func TestRun_RespectsDependencies(t *testing.T) {
+	r := &recorder{}
+	plan := []Step{
+		r.step("generate", "tidy", "templui"),
+		r.step("tidy"),
+		r.step("templui", "tidy"),
+		r.step("assets", "generate"),
+	}
+
+	results, err := Run(context.Background(), plan, nil)
+	require.NoError(t, err)
+
+	require.Len(t, results, 4)
+	for i, result := range results {
+		assert.Equal(t, plan[i].Name, result.Name, "results should follow plan order")
+		assert.NoError(t, result.Err)
+		assert.False(t, result.Skipped)
+	}
+
+	assert.Less(t, r.position("tidy"), r.position("templui"))
+	assert.Less(t, r.position("templui"), r.position("generate"))
+	assert.Less(t, r.position("generate"), r.position("assets"))
+}
+
+func TestRun_IndependentStepsOverlap(t *testing.T) {
+	var running, maxRunning atomic.Int32
+	slow := func(context.Context) error {
+		n := running.Add(1)
+		for {
+			m := maxRunning.Load()
+			if n <= m || maxRunning.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		time.Sleep(50 * time.Millisecond)
+		running.Add(-1)
+		return nil
+	}
+
+	_, err := Run(context.Background(), []Step{
+		{Name: "npm install", Run: slow},
+		{Name: "go mod download", Run: slow},
+	}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), maxRunning.Load())
+}
+
+func TestRun_OptionalFailureContinues(t *testing.T) {
+	warning := errors.New("templui add failed")
+	r := &recorder{}
+	plan := []Step{
+		{Name: "templui", Optional: true, Run: func(context.Context) error { return warning }},
+		r.step("generate", "templui"),
+	}
+
+	results, err := Run(context.Background(), plan, nil)
+	require.NoError(t, err)
+
+	assert.ErrorIs(t, results[0].Err, warning)
+	assert.True(t, results[0].Optional)
+	assert.False(t, results[1].Skipped)
+	assert.Equal(t, []string{"generate"}, r.order)
+}
+
+func TestRun_RequiredFailureSkipsDependents(t *testing.T) {
+	failure := errors.New("exit status 2")
+	r := &recorder{}
+	plan := []Step{
+		{Name: "generate", Run: func(context.Context) error { return failure }},
+		r.step("assets", "generate"),
+		r.step("tests", "assets"),
+	}
+
+	var reported []string
+	results, err := Run(context.Background(), plan, func(result Result) {
+		reported = append(reported, result.Name)
+	})
+
+	require.ErrorIs(t, err, failure)
+	assert.ErrorContains(t, err, "generate: exit status 2")
+	assert.True(t, results[1].Skipped)
+	assert.True(t, results[2].Skipped)
+	assert.Empty(t, r.order)
+	assert.ElementsMatch(t, []string{"generate", "assets", "tests"}, reported)
+}
+
+func TestRun_RequiredFailureCancelsRunningSteps(t *testing.T) {
+	plan := []Step{
+		{Name: "generate", Run: func(context.Context) error { return errors.New("failed") }},
+		{Name: "npm install", Optional: true, Run: func(ctx context.Context) error {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(5 * time.Second):
+				return nil
+			}
+		}},
+	}
+
+	start := time.Now()
+	results, err := Run(context.Background(), plan, nil)
+	require.Error(t, err)
+	assert.Less(t, time.Since(start), 2*time.Second)
+	assert.ErrorIs(t, results[1].Err, context.Canceled)
+}
+
+func TestRun_ParentCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	results, err := Run(ctx, []Step{{Name: "tidy", Run: noop}}, nil)
+	require.ErrorIs(t, err, context.Canceled)
+	assert.True(t, results[0].Skipped)
+}
+
+func TestRun_InvalidPlan(t *testing.T) {
+	tests := []struct {
+		name    string
+		plan    []Step
+		wantErr string
+	}{
+		{
+			name:    "unknown dependency",
+			plan:    []Step{{Name: "generate", DependsOn: []string{"tidy"}, Run: noop}},
+			wantErr: `step "generate" depends on unknown step "tidy"`,
+		},
+		{
+			name:    "duplicate",
+			plan:    []Step{{Name: "tidy", Run: noop}, {Name: "tidy", Run: noop}},
+			wantErr: `duplicate step "tidy"`,
+		},
+		{
+			name: "cycle",
+			plan: []Step{
+				{Name: "a", DependsOn: []string{"b"}, Run: noop},
+				{Name: "b", DependsOn: []string{"a"}, Run: noop},
+			},
+			wantErr: "dependency cycle",
+		},
+		{
+			name:    "missing run",
+			plan:    []Step{{Name: "tidy"}},
+			wantErr: `step "tidy" has no Run function`,
+		},
+		{
+			name:    "missing name",
+			plan:    []Step{{Run: noop}},
+			wantErr: "step 0 has no name",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Run(context.Background(), tt.plan, nil)
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
@@ -12,7 +12,7 @@ import (
 func renderAirConfigTemplate(t *testing.T, moduleName string) string {
 	t.Helper()
 	renderer := NewRenderer(templates.FS)
-	data := TemplateData{ModuleName: moduleName}
+	data := TemplateData{ModuleName: moduleName, Features: fullFeatures}
 	result, err := renderer.Render(".air.toml.tmpl", data)
 	require.NoError(t, err)
 	return result
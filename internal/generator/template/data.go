@@ -1,5 +1,7 @@
 package template
 
+import "github.com/anomalousventures/tracks/internal/cli/interfaces"
+
 // TemplateData contains all variables available to templates during rendering.
 // This struct defines the complete schema of data that can be used in template files.
 type TemplateData struct {
@@ -35,4 +37,146 @@ type TemplateData struct {
 	// Format: YYYYMMDDHHMMSS (e.g., "20251130143022")
 	// Used to generate unique, sortable migration filenames.
 	MigrationTimestamp string
+
+	// Vars holds the variables declared by a starter kit, keyed by name.
+	// Empty without a starter kit.
+	// Example: {{.Vars.TeamName}}
+	Vars map[string]string
+
+	// Features are the optional parts of the project. Templates shared by
+	// several features branch on them.
+	// Example: {{if .Features.UI}}
+	Features interfaces.ProjectFeatures
+}
+
+// ResourceData contains the variables available to resource templates rendered
+// by `tracks generate resource`. All names are derived from the resource name
+// so that generated files agree with each other and with sqlc's output.
+type ResourceData struct {
+	// ModuleName is the Go module path of the project being extended.
+	// Example: "github.com/user/myapp"
+	ModuleName string
+
+	// DBDriver is the project's database driver from .tracks.yaml.
+	// Valid values: "go-libsql", "sqlite3", "postgres"
+	DBDriver string
+
+	// Table is the snake_case plural table name.
+	// Example: "blog_posts"
+	Table string
+
+	// Type is the PascalCase singular type name, matching the sqlc model.
+	// Example: "BlogPost"
+	Type string
+
+	// TypePlural is the PascalCase plural name.
+	// Example: "BlogPosts"
+	TypePlural string
+
+	// Var is the camelCase singular variable name.
+	// Example: "blogPost"
+	Var string
+
+	// VarPlural is the camelCase plural variable name.
+	// Example: "blogPosts"
+	VarPlural string
+
+	// Package is the domain package name.
+	// Example: "blogposts"
+	Package string
+
+	// Path is the kebab-case URL path segment.
+	// Example: "blog-posts"
+	Path string
+
+	// Label is the human-readable singular name.
+	// Example: "Blog post"
+	Label string
+
+	// LabelPlural is the human-readable plural name.
+	// Example: "Blog posts"
+	LabelPlural string
+
+	// Noun is the lower-case singular name used in sentences.
+	// Example: "blog post"
+	Noun string
+
+	// NounPlural is the lower-case plural name used in sentences.
+	// Example: "blog posts"
+	NounPlural string
+
+	// TimestampGoType is the Go type sqlc generates for created_at/updated_at.
+	// Postgres uses TIMESTAMPTZ (time.Time); SQLite stores TEXT (string).
+	TimestampGoType string
+
+	// Fields are the user-declared columns, in declaration order.
+	Fields []ResourceField
+}
+
+// UsesGoType reports whether any field has one of the given Go types. Templates
+// use it to decide which imports a generated file needs.
+func (d ResourceData) UsesGoType(goTypes ...string) bool {
+	for _, f := range d.Fields {
+		for _, t := range goTypes {
+			if f.GoType == t {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ResourceField describes a single column of a generated resource with every
+// derived name precomputed for the target database driver.
+type ResourceField struct {
+	// Name is the snake_case column name.
+	// Example: "published_at"
+	Name string
+
+	// Type is the canonical field type.
+	// Valid values: "string", "text", "int", "float", "bool", "time"
+	Type string
+
+	// GoName is the exported Go field name, matching sqlc.
+	// Example: "PublishedAt"
+	GoName string
+
+	// Label is the human-readable form label.
+	// Example: "Published at"
+	Label string
+
+	// Noun is the lower-case label used in messages.
+	// Example: "published at"
+	Noun string
+
+	// GoType is the Go type for the column on the project's driver.
+	// Example: "time.Time"
+	GoType string
+
+	// SQLType is the column type for the project's driver.
+	// Example: "TIMESTAMPTZ"
+	SQLType string
+
+	// InputType is the HTML input type used in forms.
+	// Example: "datetime-local"
+	InputType string
+}
+
+// MigrationData contains the variables available to migration templates
+// rendered by `tracks generate migration`.
+type MigrationData struct {
+	// Name is the snake_case migration name used in the file name.
+	// Example: "add_slug_to_posts"
+	Name string
+
+	// DBDriver selects the SQL dialect (go-libsql, sqlite3, or postgres).
+	DBDriver string
+
+	// Table is the table created by the create_table shorthand. Empty for
+	// blank migrations.
+	// Example: "posts"
+	Table string
+
+	// Fields are the columns of the created table.
+	Fields []ResourceField
 }
@@ -1,6 +1,14 @@
 package template
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+)
+
+// fullFeatures are the features of a project generated with the full
+// profile, the default of 'tracks new'.
+var fullFeatures = interfaces.ProjectFeatures{UI: true, Node: true, Docker: true, CI: "github", Examples: true}
 
 func TestTemplateDataStruct(t *testing.T) {
 	data := TemplateData{
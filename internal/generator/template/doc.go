@@ -50,6 +50,19 @@
 //	{{.GoVersion}}   - Go version (e.g., 1.25)
 //	{{.Year}}        - Current year for copyright
 //
+// # Template Functions
+//
+// Templates can call the functions in funcMap to derive names and types
+// from their data:
+//
+//	type {{pascal .Name}} struct{}                        // BlogPost
+//	CREATE TABLE {{pluralize (snake .Name)}} (...)        // blog_posts
+//	created_at {{sqlType .DBDriver "time"}}               // TIMESTAMPTZ
+//	import "{{importPath .ModuleName "internal/db"}}"
+//
+// The case and inflection functions come from the naming package, so a
+// template derives the same identifiers as the generators.
+//
 // # Adding New Templates
 //
 // To add a new template:
@@ -59,6 +72,16 @@
 //  3. Add tests in templates_test.go
 //  4. Template path structure is preserved in output (cmd/server/main.go.tmpl → cmd/server/main.go)
 //
+// # Template Overrides
+//
+// NewOverlayFS layers directories over the embedded templates so a file
+// with the same path replaces the embedded template:
+//
+//	fsys := template.NewOverlayFS(templates.FS, projectOverrides, userOverrides)
+//	renderer := template.NewRenderer(fsys)
+//
+// ResolvePath gives a template's path in either filesystem.
+//
 // # Error Handling
 //
 // The package provides two error types:
@@ -8,6 +8,11 @@ type TemplateError struct {
 	// Template is the name of the template that caused the error
 	Template string
 
+	// Line and Column locate a syntax error in the rendered output of a Go
+	// or templ template. Both are 1-based, and zero for other errors.
+	Line   int
+	Column int
+
 	// Err is the underlying error
 	Err error
 }
@@ -15,6 +20,9 @@ type TemplateError struct {
 // Error implements the error interface for TemplateError.
 // It returns a formatted error message that includes the template name and underlying error.
 func (e *TemplateError) Error() string {
+	if e.Line > 0 {
+		return fmt.Sprintf("template %s: rendered output line %d, column %d: %v", e.Template, e.Line, e.Column, e.Err)
+	}
 	return fmt.Sprintf("template %s: %v", e.Template, e.Err)
 }
 
@@ -0,0 +1,74 @@
+package template
+
+import (
+	"bytes"
+	"errors"
+	"go/format"
+	"go/scanner"
+	"strings"
+
+	"github.com/a-h/parse"
+	templparser "github.com/a-h/templ/parser/v2"
+)
+
+// formatOutput formats rendered Go and templ files the way gofmt and
+// templ fmt would, so generated code needs no separate formatting pass.
+// Other files are returned unchanged. Output that doesn't parse is reported
+// as a TemplateError with the position in the rendered output.
+func formatOutput(name, content string) (string, error) {
+	switch {
+	case strings.HasSuffix(name, ".go.tmpl"):
+		return formatGo(name, content)
+	case strings.HasSuffix(name, ".templ.tmpl"):
+		return formatTempl(name, content)
+	}
+	return content, nil
+}
+
+func formatGo(name, content string) (string, error) {
+	formatted, err := format.Source([]byte(content))
+	if err == nil {
+		return string(formatted), nil
+	}
+
+	var list scanner.ErrorList
+	if errors.As(err, &list) && len(list) > 0 {
+		first := list[0]
+		return "", newOutputError(name, content, first.Pos.Line, first.Pos.Column, first.Msg)
+	}
+	return "", &TemplateError{Template: name, Err: err}
+}
+
+func formatTempl(name, content string) (string, error) {
+	tf, err := templparser.ParseString(content)
+	if err != nil {
+		var perr parse.ParseError
+		if errors.As(err, &perr) {
+			// templ positions are zero-based.
+			return "", newOutputError(name, content, perr.Pos.Line+1, perr.Pos.Col+1, perr.Msg)
+		}
+		return "", &TemplateError{Template: name, Err: err}
+	}
+
+	var buf bytes.Buffer
+	if err := tf.Write(&buf); err != nil {
+		return "", &TemplateError{Template: name, Err: err}
+	}
+	return buf.String(), nil
+}
+
+// newOutputError reports a parse error at line and column of the rendered
+// content, quoting the offending line so it can be traced back to the
+// template.
+func newOutputError(name, content string, line, column int, msg string) *TemplateError {
+	lines := strings.Split(content, "\n")
+	if line >= 1 && line <= len(lines) {
+		msg += ": " + strings.TrimSpace(lines[line-1])
+	}
+	return &TemplateError{
+		Template: name,
+		Line:     line,
+		Column:   column,
+		Err:      errors.New(msg),
+	}
+}
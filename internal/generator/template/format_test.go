@@ -0,0 +1,72 @@
+package template
+
+import (
+	"errors"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderFormatsGo(t *testing.T) {
+	renderer := NewRenderer(fstest.MapFS{
+		"project/main.go.tmpl": &fstest.MapFile{Data: []byte("package main\nfunc  main( ) {\nx:=1\n_ = x}\n")},
+	})
+
+	out, err := renderer.Render("main.go.tmpl", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "package main\n\nfunc main() {\n\tx := 1\n\t_ = x\n}\n", out)
+}
+
+func TestRenderFormatsTempl(t *testing.T) {
+	renderer := NewRenderer(fstest.MapFS{
+		"project/page.templ.tmpl": &fstest.MapFile{Data: []byte("package pages\n\ntempl Page() {\n<div><p>{{.}}</p></div>\n}\n")},
+	})
+
+	out, err := renderer.Render("page.templ.tmpl", "hello")
+	require.NoError(t, err)
+	assert.Equal(t, "package pages\n\ntempl Page() {\n\t<div><p>hello</p></div>\n}\n", out)
+}
+
+func TestRenderLeavesOtherFilesUnformatted(t *testing.T) {
+	renderer := NewRenderer(fstest.MapFS{
+		"project/Makefile.tmpl": &fstest.MapFile{Data: []byte("build:\n    go  build\n")},
+	})
+
+	out, err := renderer.Render("Makefile.tmpl", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "build:\n    go  build\n", out)
+}
+
+func TestRenderInvalidGo(t *testing.T) {
+	renderer := NewRenderer(fstest.MapFS{
+		"project/main.go.tmpl": &fstest.MapFile{Data: []byte("package main\n\nfunc {{.}}( {\n}\n")},
+	})
+
+	_, err := renderer.Render("main.go.tmpl", "Run")
+	require.Error(t, err)
+
+	var tmplErr *TemplateError
+	require.True(t, errors.As(err, &tmplErr))
+	assert.Equal(t, "main.go.tmpl", tmplErr.Template)
+	assert.Equal(t, 3, tmplErr.Line)
+	assert.Equal(t, 11, tmplErr.Column)
+	assert.Contains(t, err.Error(), "rendered output line 3, column 11")
+	assert.Contains(t, err.Error(), "func Run( {", "error should quote the offending line")
+}
+
+func TestRenderInvalidTempl(t *testing.T) {
+	renderer := NewRenderer(fstest.MapFS{
+		"project/page.templ.tmpl": &fstest.MapFile{Data: []byte("package pages\n\ntempl Page() {\n\t<div>\n}\n")},
+	})
+
+	_, err := renderer.Render("page.templ.tmpl", nil)
+	require.Error(t, err)
+
+	var tmplErr *TemplateError
+	require.True(t, errors.As(err, &tmplErr))
+	assert.Positive(t, tmplErr.Line)
+	assert.Positive(t, tmplErr.Column)
+	assert.Contains(t, err.Error(), "rendered output line")
+}
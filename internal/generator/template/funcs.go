@@ -0,0 +1,116 @@
+package template
+
+import (
+	"path"
+	"strings"
+	"text/template"
+
+	"github.com/anomalousventures/tracks/internal/generator/naming"
+)
+
+// funcMap is the function library available to every template. Names
+// follow the naming package, so templates derive identifiers the same way
+// the generators do.
+//
+//	{{pluralize "post"}}                     posts
+//	{{singularize "categories"}}             category
+//	{{snake "BlogPost"}}                     blog_post
+//	{{kebab "BlogPost"}}                     blog-post
+//	{{camel "blog_post"}}                    blogPost
+//	{{pascal "user_id"}}                     UserID
+//	{{packageName "blog-post"}}              blogpost
+//	{{humanize "author_id"}}                 Author ID
+//	{{goIdent "type"}}                       typeValue
+//	{{goExportedIdent "2fa"}}                X2fa
+//	{{sqlType .DBDriver "time"}}             TIMESTAMPTZ (postgres), TEXT (sqlite)
+//	{{goType .DBDriver "time"}}              time.Time (postgres), string (sqlite)
+//	{{importPath .ModuleName "internal/db"}} github.com/user/app/internal/db
+var funcMap = template.FuncMap{
+	"pluralize":       naming.Pluralize,
+	"singularize":     naming.Singularize,
+	"snake":           naming.Snake,
+	"kebab":           naming.Kebab,
+	"camel":           naming.Camel,
+	"pascal":          naming.Pascal,
+	"packageName":     naming.Package,
+	"humanize":        naming.Humanize,
+	"goIdent":         naming.Identifier,
+	"goExportedIdent": naming.ExportedIdentifier,
+	"sqlType":         SQLType,
+	"goType":          GoType,
+	"importPath":      ImportPath,
+}
+
+// Field types understood by SQLType and GoType. They match the types
+// accepted by `tracks generate resource`.
+const (
+	fieldInt   = "int"
+	fieldFloat = "float"
+	fieldBool  = "bool"
+	fieldTime  = "time"
+)
+
+// SQLType returns the column type for a field type on the given database
+// driver. Unknown types map to TEXT.
+func SQLType(driver, fieldType string) string {
+	postgres := driver == "postgres"
+
+	switch fieldType {
+	case fieldInt:
+		if postgres {
+			return "BIGINT"
+		}
+		return "INTEGER"
+	case fieldFloat:
+		if postgres {
+			return "DOUBLE PRECISION"
+		}
+		return "REAL"
+	case fieldBool:
+		return "BOOLEAN"
+	case fieldTime:
+		if postgres {
+			return "TIMESTAMPTZ"
+		}
+		return "TEXT"
+	default:
+		return "TEXT"
+	}
+}
+
+// GoType returns the Go type sqlc generates for a column of the field type
+// on the given database driver. SQLite stores timestamps as TEXT, so time
+// fields are strings there.
+func GoType(driver, fieldType string) string {
+	switch fieldType {
+	case fieldInt:
+		return "int64"
+	case fieldFloat:
+		return "float64"
+	case fieldBool:
+		return "bool"
+	case fieldTime:
+		if driver == "postgres" {
+			return "time.Time"
+		}
+		return "string"
+	default:
+		return "string"
+	}
+}
+
+// ImportPath joins a module path and package path elements into an import
+// path, dropping empty elements and stray slashes.
+//
+// Example:
+//
+//	ImportPath("github.com/user/app/", "internal", "/db") // "github.com/user/app/internal/db"
+func ImportPath(module string, elems ...string) string {
+	parts := make([]string, 0, len(elems)+1)
+	for _, p := range append([]string{module}, elems...) {
+		if p = strings.Trim(p, "/"); p != "" {
+			parts = append(parts, p)
+		}
+	}
+	return path.Join(parts...)
+}
@@ -0,0 +1,132 @@
+package template
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// renderString renders src as a template through the renderer, so the test
+// covers the functions as templates see them.
+func renderString(t *testing.T, src string, data any) string {
+	t.Helper()
+	renderer := NewRenderer(fstest.MapFS{
+		"project/test.tmpl": &fstest.MapFile{Data: []byte(src)},
+	})
+	out, err := renderer.Render("test.tmpl", data)
+	require.NoError(t, err)
+	return out
+}
+
+func TestFuncMap(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{"pluralize", `{{pluralize "blog_post"}}`, "blog_posts"},
+		{"pluralize irregular", `{{pluralize "person"}}`, "people"},
+		{"singularize", `{{singularize "categories"}}`, "category"},
+		{"snake", `{{snake "BlogPost"}}`, "blog_post"},
+		{"kebab", `{{kebab "BlogPost"}}`, "blog-post"},
+		{"camel", `{{camel "author_id"}}`, "authorID"},
+		{"pascal", `{{pascal "author_id"}}`, "AuthorID"},
+		{"packageName", `{{packageName "blog-post"}}`, "blogpost"},
+		{"humanize", `{{humanize "author_id"}}`, "Author ID"},
+		{"goIdent", `{{goIdent "type"}}`, "typeValue"},
+		{"goExportedIdent", `{{goExportedIdent "2fa"}}`, "X2fa"},
+		{"sqlType", `{{sqlType "postgres" "int"}}`, "BIGINT"},
+		{"goType", `{{goType "postgres" "time"}}`, "time.Time"},
+		{"importPath", `{{importPath "github.com/user/app" "internal" "db"}}`, "github.com/user/app/internal/db"},
+		{"pipeline", `{{"BlogPost" | snake | pluralize}}`, "blog_posts"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, renderString(t, tt.src, nil))
+		})
+	}
+}
+
+func TestFuncMapWithTemplateData(t *testing.T) {
+	data := TemplateData{ModuleName: "github.com/user/app", DBDriver: "sqlite3"}
+
+	out := renderString(t, `{{importPath .ModuleName "internal/db"}} {{sqlType .DBDriver "time"}} {{goType .DBDriver "time"}}`, data)
+	assert.Equal(t, "github.com/user/app/internal/db TEXT string", out)
+}
+
+func TestValidateAcceptsFuncs(t *testing.T) {
+	renderer := NewRenderer(fstest.MapFS{
+		"project/test.tmpl": &fstest.MapFile{Data: []byte(`{{pascal .Name}}`)},
+	})
+	assert.NoError(t, renderer.Validate("test.tmpl"))
+}
+
+func TestSQLType(t *testing.T) {
+	tests := []struct {
+		fieldType string
+		postgres  string
+		sqlite    string
+	}{
+		{"string", "TEXT", "TEXT"},
+		{"text", "TEXT", "TEXT"},
+		{"int", "BIGINT", "INTEGER"},
+		{"float", "DOUBLE PRECISION", "REAL"},
+		{"bool", "BOOLEAN", "BOOLEAN"},
+		{"time", "TIMESTAMPTZ", "TEXT"},
+		{"unknown", "TEXT", "TEXT"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fieldType, func(t *testing.T) {
+			assert.Equal(t, tt.postgres, SQLType("postgres", tt.fieldType))
+			assert.Equal(t, tt.sqlite, SQLType("go-libsql", tt.fieldType))
+			assert.Equal(t, tt.sqlite, SQLType("sqlite3", tt.fieldType))
+		})
+	}
+}
+
+func TestGoType(t *testing.T) {
+	tests := []struct {
+		fieldType string
+		postgres  string
+		sqlite    string
+	}{
+		{"string", "string", "string"},
+		{"int", "int64", "int64"},
+		{"float", "float64", "float64"},
+		{"bool", "bool", "bool"},
+		{"time", "time.Time", "string"},
+		{"unknown", "string", "string"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fieldType, func(t *testing.T) {
+			assert.Equal(t, tt.postgres, GoType("postgres", tt.fieldType))
+			assert.Equal(t, tt.sqlite, GoType("go-libsql", tt.fieldType))
+		})
+	}
+}
+
+func TestImportPath(t *testing.T) {
+	tests := []struct {
+		name   string
+		module string
+		elems  []string
+		want   string
+	}{
+		{"module only", "github.com/user/app", nil, "github.com/user/app"},
+		{"one element", "github.com/user/app", []string{"internal/db"}, "github.com/user/app/internal/db"},
+		{"several elements", "github.com/user/app", []string{"internal", "http", "handlers"}, "github.com/user/app/internal/http/handlers"},
+		{"stray slashes", "github.com/user/app/", []string{"/internal/", "db/"}, "github.com/user/app/internal/db"},
+		{"empty elements", "github.com/user/app", []string{"", "internal", ""}, "github.com/user/app/internal"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ImportPath(tt.module, tt.elems...))
+		})
+	}
+}
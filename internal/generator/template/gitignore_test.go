@@ -41,6 +41,20 @@ func TestGitignoreTemplate(t *testing.T) {
 	}
 }
 
+func TestGitignoreAssetPipeline(t *testing.T) {
+	renderer := NewRenderer(templates.FS)
+
+	withNode, err := renderer.Render(".gitignore.tmpl", TemplateData{Features: fullFeatures})
+	require.NoError(t, err)
+	assert.Contains(t, withNode, "node_modules/")
+	assert.Contains(t, withNode, "internal/assets/dist/")
+
+	withoutNode, err := renderer.Render(".gitignore.tmpl", TemplateData{})
+	require.NoError(t, err)
+	assert.NotContains(t, withoutNode, "node_modules/")
+	assert.NotContains(t, withoutNode, "internal/assets/dist/", "hand-written assets should be committed")
+}
+
 func TestGitignoreIsStatic(t *testing.T) {
 	renderer := NewRenderer(templates.FS)
 
@@ -3,6 +3,7 @@ package template
 import (
 	"testing"
 
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
 	"github.com/anomalousventures/tracks/internal/templates"
 	"github.com/anomalousventures/tracks/internal/testutil"
 	"github.com/stretchr/testify/assert"
@@ -19,6 +20,7 @@ func renderMakefileTemplateWithDriver(t *testing.T, driver string) string {
 	renderer := NewRenderer(templates.FS)
 	data := TemplateData{
 		DBDriver: driver,
+		Features: fullFeatures,
 	}
 	result, err := renderer.Render("Makefile.tmpl", data)
 	require.NoError(t, err)
@@ -38,7 +40,7 @@ func TestMakefileTargets(t *testing.T) {
 		items []string
 	}{
 		{"phony declarations", []string{
-			".PHONY: assets build clean css dev dev-down dev-services generate help js lint migrate-create migrate-down migrate-status migrate-up mocks sqlc templ test",
+			".PHONY: assets build ci clean css dev dev-down dev-services generate help js lint migrate-create migrate-down migrate-status migrate-up mocks sqlc templ test",
 		}},
 		{"help target", []string{
 			"help: ## Show this help message",
@@ -62,6 +64,12 @@ func TestMakefileTargets(t *testing.T) {
 			"@mkdir -p internal/assets/dist/js",
 			"npx esbuild internal/assets/web/js/*.js --bundle --minify --outdir=internal/assets/dist/js/",
 		}},
+		{"ci target", []string{
+			"ci: ## Run the CI checks; every CI pipeline calls this target",
+			"go mod download",
+			"go tool golangci-lint run",
+			"go test -v -race -coverprofile=coverage.out ./...",
+		}},
 		{"clean target", []string{
 			"clean: ## Remove build artifacts",
 			"rm -rf bin/",
@@ -107,6 +115,7 @@ func TestMakefileHelpText(t *testing.T) {
 	testutil.AssertContainsAll(t, result, []string{
 		"assets         - Build all assets (CSS and JS)",
 		"build          - Build the server binary (includes assets)",
+		"ci             - Run the CI checks (lint, tests with race detector and coverage)",
 		"clean          - Remove build artifacts",
 		"css            - Compile TailwindCSS",
 		"dev            - Start development server (auto-starts services if needed)",
@@ -127,6 +136,22 @@ func TestMakefileHelpText(t *testing.T) {
 	})
 }
 
+func TestMakefileWithoutNodeOrDocker(t *testing.T) {
+	renderer := NewRenderer(templates.FS)
+	result, err := renderer.Render("Makefile.tmpl", TemplateData{DBDriver: "sqlite3", Features: interfaces.ProjectFeatures{CI: "none"}})
+	require.NoError(t, err)
+
+	testutil.AssertContainsAll(t, result, []string{
+		".PHONY: assets build ci clean dev generate help lint migrate-create",
+		"assets: ## No-op: assets in internal/assets/dist are served as written",
+		"dev: ## Start development server\n\tgo tool air -c .air.toml",
+		"\trm -rf bin/\n",
+	})
+	for _, absent := range []string{"npx", "docker-compose", "css:", "js:", "dev-down", "internal/assets/dist/\n"} {
+		assert.NotContains(t, result, absent)
+	}
+}
+
 func TestMakefileUsesGoTool(t *testing.T) {
 	result := renderMakefileTemplate(t)
 
@@ -0,0 +1,89 @@
+package template
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/anomalousventures/tracks/internal/templates"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateTableMigrationTemplate(t *testing.T) {
+	renderer := NewRenderer(templates.FS)
+
+	t.Run("postgres", func(t *testing.T) {
+		result, err := renderer.Render("migration/create_table.sql.tmpl", resourceTestData("postgres"))
+		require.NoError(t, err)
+
+		assert.Contains(t, result, "CREATE TABLE blog_posts (")
+		assert.Contains(t, result, "id TEXT PRIMARY KEY")
+		assert.Contains(t, result, "published_at TIMESTAMPTZ NOT NULL")
+		assert.Contains(t, result, "created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()")
+		assert.Contains(t, result, "EXECUTE FUNCTION update_updated_at_column()")
+		assert.Contains(t, result, "DROP TRIGGER IF EXISTS trg_blog_posts_updated_at ON blog_posts;")
+		assert.Contains(t, result, "DROP TABLE IF EXISTS blog_posts;")
+	})
+
+	t.Run("sqlite", func(t *testing.T) {
+		result, err := renderer.Render("migration/create_table.sql.tmpl", resourceTestData("go-libsql"))
+		require.NoError(t, err)
+
+		assert.Contains(t, result, "published_at TEXT NOT NULL")
+		assert.Contains(t, result, "created_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP")
+		assert.Contains(t, result, "AFTER UPDATE ON blog_posts")
+		assert.Contains(t, result, "UPDATE blog_posts SET updated_at = CURRENT_TIMESTAMP WHERE id = NEW.id;")
+		assert.NotContains(t, result, "TIMESTAMPTZ")
+		assert.Contains(t, result, "DROP TRIGGER IF EXISTS trg_blog_posts_updated_at;")
+	})
+
+	t.Run("goose annotations are balanced", func(t *testing.T) {
+		result, err := renderer.Render("migration/create_table.sql.tmpl", resourceTestData("postgres"))
+		require.NoError(t, err)
+
+		assert.Equal(t, strings.Count(result, "-- +goose StatementBegin"), strings.Count(result, "-- +goose StatementEnd"))
+		assert.Equal(t, 1, strings.Count(result, "-- +goose Up"))
+		assert.Equal(t, 1, strings.Count(result, "-- +goose Down"))
+	})
+}
+
+func TestCreateTableMigrationTemplate_MigrationData(t *testing.T) {
+	renderer := NewRenderer(templates.FS)
+
+	data := MigrationData{
+		Name:     "create_tags",
+		DBDriver: "postgres",
+		Table:    "tags",
+		Fields:   []ResourceField{{Name: "label", SQLType: "TEXT"}},
+	}
+
+	result, err := renderer.Render("migration/create_table.sql.tmpl", data)
+	require.NoError(t, err)
+
+	assert.Contains(t, result, "CREATE TABLE tags (")
+	assert.Contains(t, result, "label TEXT NOT NULL,")
+	assert.Contains(t, result, "CREATE TRIGGER trg_tags_updated_at")
+}
+
+func TestBlankMigrationTemplate(t *testing.T) {
+	renderer := NewRenderer(templates.FS)
+
+	for _, driver := range []string{"go-libsql", "sqlite3", "postgres"} {
+		t.Run(driver, func(t *testing.T) {
+			result, err := renderer.Render("migration/blank.sql.tmpl", MigrationData{Name: "add_index", DBDriver: driver})
+			require.NoError(t, err)
+
+			assert.True(t, strings.HasPrefix(result, "-- +goose Up\n-- +goose StatementBegin\n"))
+			assert.Equal(t, 2, strings.Count(result, "-- +goose StatementBegin"))
+			assert.Equal(t, 2, strings.Count(result, "-- +goose StatementEnd"))
+			assert.Contains(t, result, "-- +goose Down")
+			assert.Contains(t, result, "UUIDv7")
+
+			if driver == "postgres" {
+				assert.Contains(t, result, "update_updated_at_column()")
+			} else {
+				assert.Contains(t, result, "CURRENT_TIMESTAMP")
+			}
+		})
+	}
+}
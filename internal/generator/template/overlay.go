@@ -0,0 +1,45 @@
+package template
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+)
+
+// overlayFS serves each file from the first override directory that
+// contains it, falling back to the base filesystem.
+type overlayFS struct {
+	base   fs.FS
+	layers []fs.FS
+}
+
+// NewOverlayFS returns a filesystem that lets the files in dirs shadow
+// those in base by path, so a template can be customized without
+// rebuilding the CLI. dirs are searched in order before base; empty entries
+// and directories that do not exist are ignored.
+//
+// Only files are overlaid: opening a directory returns base's directory,
+// so walking the result lists the base templates.
+func NewOverlayFS(base fs.FS, dirs ...string) fs.FS {
+	o := &overlayFS{base: base}
+	for _, dir := range dirs {
+		if dir != "" {
+			o.layers = append(o.layers, os.DirFS(dir))
+		}
+	}
+	return o
+}
+
+func (o *overlayFS) Open(name string) (fs.File, error) {
+	for _, layer := range o.layers {
+		info, err := fs.Stat(layer, name)
+		if errors.Is(err, fs.ErrNotExist) || (err == nil && info.IsDir()) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		return layer.Open(name)
+	}
+	return o.base.Open(name)
+}
@@ -0,0 +1,62 @@
+package template
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewOverlayFS(t *testing.T) {
+	base := fstest.MapFS{
+		"project/a.tmpl": {Data: []byte("base a")},
+		"project/b.tmpl": {Data: []byte("base b")},
+		"project/c.tmpl": {Data: []byte("base c")},
+	}
+
+	first := t.TempDir()
+	second := t.TempDir()
+	writeOverlayFile(t, first, "project/a.tmpl", "first a")
+	writeOverlayFile(t, second, "project/a.tmpl", "second a")
+	writeOverlayFile(t, second, "project/b.tmpl", "second b")
+	require.NoError(t, os.MkdirAll(filepath.Join(first, "project", "c.tmpl"), 0755))
+
+	overlay := NewOverlayFS(base, first, "", second, filepath.Join(t.TempDir(), "missing"))
+
+	for name, want := range map[string]string{
+		"project/a.tmpl": "first a",
+		"project/b.tmpl": "second b",
+		"project/c.tmpl": "base c",
+	} {
+		got, err := fs.ReadFile(overlay, name)
+		require.NoError(t, err, name)
+		assert.Equal(t, want, string(got), name)
+	}
+
+	_, err := fs.ReadFile(overlay, "project/missing.tmpl")
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+}
+
+func TestRenderer_Overlay(t *testing.T) {
+	dir := t.TempDir()
+	writeOverlayFile(t, dir, "project/go.mod.tmpl", "module {{.ModuleName}} // custom\n")
+
+	renderer := NewRenderer(NewOverlayFS(fstest.MapFS{
+		"project/go.mod.tmpl": {Data: []byte("module {{.ModuleName}}\n")},
+	}, dir))
+
+	got, err := renderer.Render("go.mod.tmpl", TemplateData{ModuleName: "example.com/app"})
+	require.NoError(t, err)
+	assert.Equal(t, "module example.com/app // custom\n", got)
+}
+
+func writeOverlayFile(t *testing.T, dir, name, content string) {
+	t.Helper()
+	path := filepath.Join(dir, filepath.FromSlash(name))
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+}
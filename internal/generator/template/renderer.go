@@ -2,7 +2,6 @@ package template
 
 import (
 	"bytes"
-	"embed"
 	"fmt"
 	"io/fs"
 	"os"
@@ -14,36 +13,32 @@ import (
 	"github.com/anomalousventures/tracks/internal/generator/interfaces"
 )
 
-// templateRenderer implements interfaces.TemplateRenderer using Go's embed.FS.
-// It reads templates from an embedded filesystem and renders them using text/template.
+// templateRenderer implements interfaces.TemplateRenderer using an fs.FS,
+// normally the embedded templates, optionally overlaid with override
+// directories by NewOverlayFS. It renders templates using text/template.
 //
 // This implementation follows ADR-002 by implementing an interface defined by the consumer
 // (generator package) rather than defining its own interface. This allows the generator
 // to depend on abstractions rather than concrete implementations.
 type templateRenderer struct {
-	fs embed.FS
+	fs fs.FS
 }
 
 // NewRenderer creates a new template renderer that implements interfaces.TemplateRenderer.
-// The provided embed.FS should contain template files in a "project" subdirectory.
-func NewRenderer(fs embed.FS) interfaces.TemplateRenderer {
+// The provided filesystem should contain template files in a "project" subdirectory.
+func NewRenderer(fs fs.FS) interfaces.TemplateRenderer {
 	return &templateRenderer{fs: fs}
 }
 
 func (r *templateRenderer) Render(name string, data any) (string, error) {
-	// If the path already starts with a known directory (project/ or examples/), use it as-is.
-	// Otherwise, prepend "project/" for backward compatibility.
-	embedPath := name
-	if !strings.HasPrefix(name, "project/") && !strings.HasPrefix(name, "examples/") {
-		embedPath = path.Join("project", name)
-	}
+	embedPath := ResolvePath(name)
 
 	content, err := fs.ReadFile(r.fs, embedPath)
 	if err != nil {
 		return "", &TemplateError{Template: name, Err: err}
 	}
 
-	tmpl, err := template.New(name).Parse(string(content))
+	tmpl, err := template.New(name).Funcs(funcMap).Parse(string(content))
 	if err != nil {
 		return "", &TemplateError{Template: name, Err: err}
 	}
@@ -54,7 +49,7 @@ func (r *templateRenderer) Render(name string, data any) (string, error) {
 	}
 
 	result := strings.ReplaceAll(buf.String(), "\r\n", "\n")
-	return result, nil
+	return formatOutput(name, result)
 }
 
 func (r *templateRenderer) RenderToFile(templateName string, data any, outputPath string) error {
@@ -76,22 +71,31 @@ func (r *templateRenderer) RenderToFile(templateName string, data any, outputPat
 }
 
 func (r *templateRenderer) Validate(name string) error {
-	// If the path already starts with a known directory (project/ or examples/), use it as-is.
-	// Otherwise, prepend "project/" for backward compatibility.
-	embedPath := name
-	if !strings.HasPrefix(name, "project/") && !strings.HasPrefix(name, "examples/") {
-		embedPath = path.Join("project", name)
-	}
+	embedPath := ResolvePath(name)
 
 	content, err := fs.ReadFile(r.fs, embedPath)
 	if err != nil {
 		return &TemplateError{Template: name, Err: err}
 	}
 
-	_, err = template.New(name).Parse(string(content))
+	_, err = template.New(name).Funcs(funcMap).Parse(string(content))
 	if err != nil {
 		return &ValidationError{Template: name, Message: err.Error()}
 	}
 
 	return nil
 }
+
+// ResolvePath maps a template name to its path in the embedded filesystem,
+// which is also its path below an override directory.
+// Names that already start with a known directory (project/, examples/,
+// resource/ or migration/) are used as-is. Otherwise "project/" is prepended for backward
+// compatibility.
+func ResolvePath(name string) string {
+	for _, dir := range []string{"project/", "examples/", "resource/", "migration/"} {
+		if strings.HasPrefix(name, dir) {
+			return name
+		}
+	}
+	return path.Join("project", name)
+}
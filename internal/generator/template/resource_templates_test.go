@@ -0,0 +1,134 @@
+package template
+
+import (
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"github.com/anomalousventures/tracks/internal/templates"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func resourceTestData(driver string) ResourceData {
+	timeType := "string"
+	timeSQL := "TEXT"
+	timestampType := "string"
+	if driver == "postgres" {
+		timeType = "time.Time"
+		timeSQL = "TIMESTAMPTZ"
+		timestampType = "time.Time"
+	}
+
+	return ResourceData{
+		ModuleName:      "github.com/test/app",
+		DBDriver:        driver,
+		Table:           "blog_posts",
+		Type:            "BlogPost",
+		TypePlural:      "BlogPosts",
+		Var:             "blogPost",
+		VarPlural:       "blogPosts",
+		Package:         "blogposts",
+		Path:            "blog-posts",
+		Label:           "Blog post",
+		LabelPlural:     "Blog posts",
+		Noun:            "blog post",
+		NounPlural:      "blog posts",
+		TimestampGoType: timestampType,
+		Fields: []ResourceField{
+			{Name: "title", Type: "string", GoName: "Title", Label: "Title", Noun: "title", GoType: "string", SQLType: "TEXT", InputType: "text"},
+			{Name: "views", Type: "int", GoName: "Views", Label: "Views", Noun: "views", GoType: "int64", SQLType: "INTEGER", InputType: "number"},
+			{Name: "published_at", Type: "time", GoName: "PublishedAt", Label: "Published at", Noun: "published at", GoType: timeType, SQLType: timeSQL, InputType: "datetime-local"},
+		},
+	}
+}
+
+func TestResourceTemplates_ValidGoCode(t *testing.T) {
+	renderer := NewRenderer(templates.FS)
+	goTemplates := []string{
+		"resource/interfaces.go.tmpl",
+		"resource/repository.go.tmpl",
+		"resource/service.go.tmpl",
+		"resource/service_test.go.tmpl",
+		"resource/handler.go.tmpl",
+		"resource/handler_test.go.tmpl",
+		"resource/routes.go.tmpl",
+		"resource/routes_test.go.tmpl",
+	}
+
+	for _, driver := range []string{"go-libsql", "postgres"} {
+		for _, name := range goTemplates {
+			t.Run(driver+"/"+name, func(t *testing.T) {
+				result, err := renderer.Render(name, resourceTestData(driver))
+				require.NoError(t, err)
+
+				fset := token.NewFileSet()
+				_, err = parser.ParseFile(fset, name, result, parser.AllErrors)
+				require.NoError(t, err, "generated code should be valid Go")
+			})
+		}
+	}
+}
+
+func TestResourceQueriesTemplate(t *testing.T) {
+	renderer := NewRenderer(templates.FS)
+
+	result, err := renderer.Render("resource/queries.sql.tmpl", resourceTestData("go-libsql"))
+	require.NoError(t, err)
+
+	assert.Contains(t, result, "-- name: GetBlogPost :one")
+	assert.Contains(t, result, "-- name: ListBlogPosts :many")
+	assert.Contains(t, result, "-- name: CreateBlogPost :one")
+	assert.Contains(t, result, "-- name: UpdateBlogPost :one")
+	assert.Contains(t, result, "-- name: DeleteBlogPost :exec")
+	assert.Contains(t, result, "sqlc.arg(published_at)")
+	assert.Contains(t, result, "SET title = sqlc.arg(title),\n    views = sqlc.arg(views),\n    published_at = sqlc.arg(published_at)\nWHERE id = sqlc.arg(id)")
+}
+
+func TestResourceRoutesTemplate(t *testing.T) {
+	renderer := NewRenderer(templates.FS)
+
+	result, err := renderer.Render("resource/routes.go.tmpl", resourceTestData("go-libsql"))
+	require.NoError(t, err)
+
+	assert.Contains(t, result, `blogPostsPath     = "blog-posts"`, "output should be gofmt-aligned")
+	assert.Contains(t, result, `BlogPostSlugParam = "id"`)
+	assert.Contains(t, result, `BlogPostShow   = "/" + blogPostsPath + "/{" + BlogPostSlugParam + "}"`)
+	assert.Contains(t, result, "func BlogPostEditURL(id string) string")
+}
+
+func TestResourceHandlerTemplate_Imports(t *testing.T) {
+	renderer := NewRenderer(templates.FS)
+
+	postgres, err := renderer.Render("resource/handler.go.tmpl", resourceTestData("postgres"))
+	require.NoError(t, err)
+	assert.Contains(t, postgres, `"strconv"`)
+	assert.Contains(t, postgres, `"time"`, "postgres time fields are parsed with time.Parse")
+
+	sqlite, err := renderer.Render("resource/handler.go.tmpl", resourceTestData("go-libsql"))
+	require.NoError(t, err)
+	assert.NotContains(t, sqlite, `"time"`, "sqlite stores time fields as TEXT")
+}
+
+func TestResourcePagesTemplate(t *testing.T) {
+	renderer := NewRenderer(templates.FS)
+
+	result, err := renderer.Render("resource/pages.templ.tmpl", resourceTestData("postgres"))
+	require.NoError(t, err)
+
+	for _, component := range []string{"BlogPostIndexPage", "BlogPostShowPage", "BlogPostNewPage", "BlogPostEditPage"} {
+		assert.Contains(t, result, "templ "+component+"(")
+		assert.Contains(t, result, "templ "+component+"Partial(")
+	}
+	assert.Contains(t, result, `<input type="datetime-local" name="published_at"`)
+	assert.Contains(t, result, `"hx-delete":  routes.BlogPostDeleteURL(blogPost.ID)`)
+}
+
+func TestResourceData_UsesGoType(t *testing.T) {
+	data := resourceTestData("go-libsql")
+
+	assert.True(t, data.UsesGoType("int64"))
+	assert.True(t, data.UsesGoType("bool", "string"))
+	assert.False(t, data.UsesGoType("time.Time"))
+	assert.False(t, data.UsesGoType())
+}
@@ -72,7 +72,7 @@ func TestRoutesSharedConstantsOnly(t *testing.T) {
 func TestRoutesCounterConstants(t *testing.T) {
 	renderer := NewRenderer(templates.FS)
 
-	data := TemplateData{}
+	data := TemplateData{Features: fullFeatures}
 
 	result, err := renderer.Render("internal/http/routes/routes.go.tmpl", data)
 	require.NoError(t, err)
@@ -82,6 +82,17 @@ func TestRoutesCounterConstants(t *testing.T) {
 	assert.Contains(t, result, `CounterReset     = "/counter/reset"`, "should define CounterReset route")
 }
 
+func TestRoutesWithoutExamples(t *testing.T) {
+	renderer := NewRenderer(templates.FS)
+
+	result, err := renderer.Render("internal/http/routes/routes.go.tmpl", TemplateData{})
+	require.NoError(t, err)
+
+	assert.NotContains(t, result, "Counter", "counter routes belong to the examples")
+	assert.Contains(t, result, `WellKnownChangePassword = "/.well-known/change-password"
+)`)
+}
+
 func TestRoutesConstBlock(t *testing.T) {
 	renderer := NewRenderer(templates.FS)
 
@@ -219,7 +219,7 @@ func TestServerContextAwareLogging(t *testing.T) {
 }
 
 func TestServerTemplateRegistration(t *testing.T) {
-	generatorFile := "../generator.go"
+	generatorFile := "../project_templates.go"
 	content, err := os.ReadFile(generatorFile)
 	require.NoError(t, err)
 
@@ -333,6 +333,7 @@ func TestHTTPRoutesWebRoutesEmpty(t *testing.T) {
 	renderer := NewRenderer(templates.FS)
 	data := TemplateData{
 		ModuleName: "github.com/example/testapp",
+		Features:   fullFeatures,
 	}
 
 	output, err := renderer.Render("internal/http/routes.go.tmpl", data)
@@ -349,6 +350,20 @@ func TestHTTPRoutesWebRoutesEmpty(t *testing.T) {
 	assert.Contains(t, section, "s.router.Get(routes.About, s.handleAbout())", "should register about route")
 }
 
+func TestHTTPRoutesWithoutExamples(t *testing.T) {
+	renderer := NewRenderer(templates.FS)
+	data := TemplateData{
+		ModuleName: "github.com/example/testapp",
+	}
+
+	output, err := renderer.Render("internal/http/routes.go.tmpl", data)
+	require.NoError(t, err)
+
+	assert.Contains(t, output, "s.router.Get(routes.Home, s.handleHome())")
+	assert.Contains(t, output, "func (s *Server) handleHome() nethttp.HandlerFunc {")
+	assert.NotContains(t, output, "counter", "the counter handler belongs to the examples")
+}
+
 func TestHTTPRoutesProtectedRoutesGroup(t *testing.T) {
 	renderer := NewRenderer(templates.FS)
 	data := TemplateData{
@@ -298,6 +298,7 @@ func TestTracksYamlTemplate(t *testing.T) {
 				ProjectName: tt.projectName,
 				ModuleName:  tt.moduleName,
 				DBDriver:    tt.dbDriver,
+				GoVersion:   "1.24",
 			}
 
 			result, err := renderer.Render(".tracks.yaml.tmpl", data)
@@ -313,6 +314,7 @@ func TestTracksYamlTemplate(t *testing.T) {
 			assert.Contains(t, result, "tracks_version: \"dev\"")
 			assert.Contains(t, result, "last_upgraded_version: \"dev\"")
 			assert.Contains(t, result, "database_driver: \""+tt.dbDriver+"\"")
+			assert.Contains(t, result, "go_version: \"1.24\"")
 		})
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"testing"
 
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
 	"github.com/anomalousventures/tracks/internal/templates"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -14,6 +15,7 @@ func TestTemplUIConfigTemplate(t *testing.T) {
 
 	data := TemplateData{
 		ModuleName: "github.com/user/testapp",
+		Features:   fullFeatures,
 	}
 
 	result, err := renderer.Render(".templui.json.tmpl", data)
@@ -36,6 +38,7 @@ func TestTemplUIConfigDirectories(t *testing.T) {
 
 	data := TemplateData{
 		ModuleName: "github.com/example/myapp",
+		Features:   fullFeatures,
 	}
 
 	result, err := renderer.Render(".templui.json.tmpl", data)
@@ -75,6 +78,7 @@ func TestTemplUIConfigModuleName(t *testing.T) {
 		t.Run(moduleName, func(t *testing.T) {
 			data := TemplateData{
 				ModuleName: moduleName,
+				Features:   fullFeatures,
 			}
 
 			result, err := renderer.Render(".templui.json.tmpl", data)
@@ -94,6 +98,7 @@ func TestTemplUIConfigAssetPipeline(t *testing.T) {
 
 	data := TemplateData{
 		ModuleName: "github.com/user/webapp",
+		Features:   fullFeatures,
 	}
 
 	result, err := renderer.Render(".templui.json.tmpl", data)
@@ -109,3 +114,19 @@ func TestTemplUIConfigAssetPipeline(t *testing.T) {
 	jsPublicPath := config["jsPublicPath"].(string)
 	assert.Equal(t, "/assets/js", jsPublicPath)
 }
+
+func TestTemplUIConfigWithoutNode(t *testing.T) {
+	renderer := NewRenderer(templates.FS)
+
+	data := TemplateData{
+		ModuleName: "github.com/user/webapp",
+		Features:   interfaces.ProjectFeatures{UI: true, CI: "none"},
+	}
+
+	result, err := renderer.Render(".templui.json.tmpl", data)
+	require.NoError(t, err)
+
+	var config map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(result), &config))
+	assert.Equal(t, "internal/assets/dist/js", config["jsDir"], "without a JS build, templUI scripts should go straight to dist")
+}
@@ -0,0 +1,199 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/mod/modfile"
+	"golang.org/x/mod/semver"
+)
+
+// templUIModule is the Go module templUI is installed from.
+const templUIModule = "github.com/templui/templui"
+
+// templUICachedModule stands in for the project's module path in cached
+// templUI files, so components cached by one project can be restored into
+// a project with a different module path.
+const templUICachedModule = "tracks.invalid/module"
+
+// templUICodec caches the files templUI writes independently of the project
+// they were written for. Module path references in components and
+// .templui.json are rewritten, and go.mod and go.sum are reduced to the
+// requirements and checksums templUI added, which are merged into the
+// target project's files on restore instead of replacing them.
+type templUICodec struct {
+	projectRoot string
+	modulePath  string
+	// goMod and goSum hold the project's files before templUI ran, so
+	// encode can tell which entries templUI added.
+	goMod []byte
+	goSum []byte
+}
+
+// newTemplUICodec reads the project's go.mod and go.sum as they are before
+// templUI runs.
+func newTemplUICodec(projectRoot string) (*templUICodec, error) {
+	goMod, err := os.ReadFile(filepath.Join(projectRoot, "go.mod"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read go.mod: %w", err)
+	}
+	modulePath := modfile.ModulePath(goMod)
+	if modulePath == "" {
+		return nil, fmt.Errorf("go.mod has no module path")
+	}
+	goSum, err := os.ReadFile(filepath.Join(projectRoot, "go.sum"))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to read go.sum: %w", err)
+	}
+	return &templUICodec{projectRoot: projectRoot, modulePath: modulePath, goMod: goMod, goSum: goSum}, nil
+}
+
+// key returns the cache key for installing components with the templUI
+// version required by go.mod and the project's .templui.json, or "" if
+// go.mod doesn't require templUI yet, in which case nothing is cached.
+func (c *templUICodec) key(components []string) (string, error) {
+	file, err := modfile.ParseLax("go.mod", c.goMod, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse go.mod: %w", err)
+	}
+	var version string
+	for _, req := range file.Require {
+		if req.Mod.Path == templUIModule {
+			version = req.Mod.Version
+		}
+	}
+	if version == "" {
+		return "", nil
+	}
+
+	config, err := os.ReadFile(filepath.Join(c.projectRoot, ".templui.json"))
+	if err != nil {
+		return "", fmt.Errorf("failed to read .templui.json: %w", err)
+	}
+	config = replaceModulePath(config, c.modulePath, templUICachedModule)
+
+	return cacheKey("templui-v2", version, string(config), strings.Join(components, ",")), nil
+}
+
+func (c *templUICodec) encode(rel string, content []byte) ([]byte, error) {
+	switch rel {
+	case "go.mod":
+		return addedRequires(c.goMod, content)
+	case "go.sum":
+		return addedLines(c.goSum, content), nil
+	default:
+		return replaceModulePath(content, c.modulePath, templUICachedModule), nil
+	}
+}
+
+func (c *templUICodec) decode(rel string, cached []byte) ([]byte, error) {
+	switch rel {
+	case "go.mod":
+		current, err := os.ReadFile(filepath.Join(c.projectRoot, "go.mod"))
+		if err != nil {
+			return nil, err
+		}
+		return mergeRequires(current, cached)
+	case "go.sum":
+		current, err := os.ReadFile(filepath.Join(c.projectRoot, "go.sum"))
+		if err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+		return append(current, addedLines(current, cached)...), nil
+	default:
+		return replaceModulePath(cached, templUICachedModule, c.modulePath), nil
+	}
+}
+
+// replaceModulePath rewrites quoted references to module from, as found in
+// import paths and JSON, to module to.
+func replaceModulePath(content []byte, from, to string) []byte {
+	content = bytes.ReplaceAll(content, []byte(`"`+from+`/`), []byte(`"`+to+`/`))
+	return bytes.ReplaceAll(content, []byte(`"`+from+`"`), []byte(`"`+to+`"`))
+}
+
+// addedRequires returns a go.mod for templUICachedModule listing the
+// requirements in after that are not in before, or nil if there are none.
+func addedRequires(before, after []byte) ([]byte, error) {
+	beforeFile, err := modfile.ParseLax("go.mod", before, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse go.mod: %w", err)
+	}
+	afterFile, err := modfile.ParseLax("go.mod", after, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse go.mod: %w", err)
+	}
+
+	existing := make(map[string]bool, len(beforeFile.Require))
+	for _, req := range beforeFile.Require {
+		existing[req.Mod.String()] = true
+	}
+
+	added := &modfile.File{}
+	if err := added.AddModuleStmt(templUICachedModule); err != nil {
+		return nil, err
+	}
+	for _, req := range afterFile.Require {
+		if existing[req.Mod.String()] {
+			continue
+		}
+		added.AddNewRequire(req.Mod.Path, req.Mod.Version, req.Indirect)
+	}
+	if len(added.Require) == 0 {
+		return nil, nil
+	}
+	return modfile.Format(added.Syntax), nil
+}
+
+// mergeRequires adds the requirements listed in cached to the go.mod in
+// current, keeping any newer version current already requires.
+func mergeRequires(current, cached []byte) ([]byte, error) {
+	file, err := modfile.Parse("go.mod", current, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse go.mod: %w", err)
+	}
+	cachedFile, err := modfile.ParseLax("go.mod", cached, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse cached go.mod: %w", err)
+	}
+	required := make(map[string]string, len(file.Require))
+	for _, req := range file.Require {
+		required[req.Mod.Path] = req.Mod.Version
+	}
+	for _, req := range cachedFile.Require {
+		version, ok := required[req.Mod.Path]
+		if !ok {
+			file.AddNewRequire(req.Mod.Path, req.Mod.Version, req.Indirect)
+			continue
+		}
+		if semver.Compare(req.Mod.Version, version) <= 0 {
+			continue
+		}
+		if err := file.AddRequire(req.Mod.Path, req.Mod.Version); err != nil {
+			return nil, err
+		}
+	}
+	file.Cleanup()
+	return modfile.Format(file.Syntax), nil
+}
+
+// addedLines returns the lines of after that are not in before, or nil if
+// there are none.
+func addedLines(before, after []byte) []byte {
+	existing := make(map[string]bool)
+	for _, line := range strings.Split(string(before), "\n") {
+		existing[line] = true
+	}
+	var added []byte
+	for _, line := range strings.Split(string(after), "\n") {
+		if line == "" || existing[line] {
+			continue
+		}
+		existing[line] = true
+		added = append(added, line+"\n"...)
+	}
+	return added
+}
@@ -0,0 +1,83 @@
+package generator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTemplUITestProject(t *testing.T, module, extraRequire string) string {
+	t.Helper()
+	projectDir := t.TempDir()
+	writeTestFile(t, projectDir, "go.mod", "module "+module+"\n\ngo 1.25\n\nrequire (\n\tgithub.com/templui/templui v1.2.0\n"+extraRequire+")\n")
+	writeTestFile(t, projectDir, "go.sum", "github.com/templui/templui v1.2.0 h1:templui=\n")
+	writeTestFile(t, projectDir, ".templui.json", `{"moduleName": "`+module+`"}`+"\n")
+	return projectDir
+}
+
+func TestTemplUICodec_CrossProject(t *testing.T) {
+	cache := stepCache{dir: t.TempDir()}
+
+	source := writeTemplUITestProject(t, "example.com/first", "")
+	sourceCodec, err := newTemplUICodec(source)
+	require.NoError(t, err)
+	key, err := sourceCodec.key([]string{"button"})
+	require.NoError(t, err)
+
+	// What templUI writes.
+	writeTestFile(t, source, "go.mod", "module example.com/first\n\ngo 1.25\n\nrequire (\n\tgithub.com/templui/templui v1.2.0\n\tgithub.com/Oudwins/tailwind-merge-go v0.2.1\n)\n")
+	writeTestFile(t, source, "go.sum", "github.com/templui/templui v1.2.0 h1:templui=\ngithub.com/Oudwins/tailwind-merge-go v0.2.1 h1:twmerge=\n")
+	writeTestFile(t, source, "internal/http/views/components/ui/button/button.templ", "import \"example.com/first/internal/http/views/components/utils\"\n")
+	files := []string{"go.mod", "go.sum", "internal/http/views/components/ui/button/button.templ"}
+	require.NoError(t, cache.save(key, source, files, sourceCodec))
+
+	target := writeTemplUITestProject(t, "example.org/second", "\tgithub.com/a-h/templ v0.3.0\n")
+	targetCodec, err := newTemplUICodec(target)
+	require.NoError(t, err)
+	targetKey, err := targetCodec.key([]string{"button"})
+	require.NoError(t, err)
+	assert.Equal(t, key, targetKey, "projects with different module paths should share the entry")
+
+	hit, err := cache.restore(targetKey, target, targetCodec)
+	require.NoError(t, err)
+	require.True(t, hit)
+
+	assert.Equal(t, "import \"example.org/second/internal/http/views/components/utils\"\n",
+		readTestFile(t, target, "internal/http/views/components/ui/button/button.templ"))
+
+	goMod := readTestFile(t, target, "go.mod")
+	assert.Contains(t, goMod, "module example.org/second")
+	assert.Contains(t, goMod, "github.com/a-h/templ v0.3.0")
+	assert.Contains(t, goMod, "github.com/Oudwins/tailwind-merge-go v0.2.1")
+	assert.Equal(t, "github.com/templui/templui v1.2.0 h1:templui=\ngithub.com/Oudwins/tailwind-merge-go v0.2.1 h1:twmerge=\n",
+		readTestFile(t, target, "go.sum"))
+}
+
+func TestTemplUICodec_Key(t *testing.T) {
+	projectDir := writeTemplUITestProject(t, "example.com/app", "")
+	codec, err := newTemplUICodec(projectDir)
+	require.NoError(t, err)
+	key, err := codec.key([]string{"button"})
+	require.NoError(t, err)
+
+	other, err := codec.key([]string{"button", "card"})
+	require.NoError(t, err)
+	assert.NotEqual(t, key, other, "different components should miss")
+
+	upgraded := writeTemplUITestProject(t, "example.com/app", "")
+	writeTestFile(t, upgraded, "go.mod", "module example.com/app\n\nrequire github.com/templui/templui v1.3.0\n")
+	codec, err = newTemplUICodec(upgraded)
+	require.NoError(t, err)
+	other, err = codec.key([]string{"button"})
+	require.NoError(t, err)
+	assert.NotEqual(t, key, other, "a different templUI version should miss")
+
+	untidy := writeTemplUITestProject(t, "example.com/app", "")
+	writeTestFile(t, untidy, "go.mod", "module example.com/app\n")
+	codec, err = newTemplUICodec(untidy)
+	require.NoError(t, err)
+	other, err = codec.key([]string{"button"})
+	require.NoError(t, err)
+	assert.Empty(t, other, "without a templUI requirement nothing should be cached")
+}
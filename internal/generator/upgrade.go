@@ -0,0 +1,291 @@
+package generator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	generatorinterfaces "github.com/anomalousventures/tracks/internal/generator/interfaces"
+	"github.com/anomalousventures/tracks/internal/generator/merge"
+	"github.com/anomalousventures/tracks/internal/generator/template"
+	"github.com/rs/zerolog"
+)
+
+// UpgradeConfig holds the inputs for `tracks upgrade`.
+type UpgradeConfig struct {
+	// ProjectDir is the root of the Tracks project being upgraded.
+	ProjectDir string `json:"project_dir"`
+
+	// ProjectName, ModulePath, DatabaseDriver and EnvPrefix come from
+	// .tracks.yaml and are used to re-render the templates.
+	ProjectName    string `json:"project_name"`
+	ModulePath     string `json:"module_path"`
+	DatabaseDriver string `json:"database_driver"`
+	EnvPrefix      string `json:"env_prefix"`
+
+	// GoVersion is the go_version from .tracks.yaml. Projects that predate
+	// it use the go directive of their go.mod.
+	GoVersion string `json:"go_version,omitempty"`
+
+	// Features are the project's features from .tracks.yaml. The zero
+	// value, for projects that predate them, upgrades every template.
+	Features interfaces.ProjectFeatures `json:"features"`
+
+	// Version is the CLI version being upgraded to. It labels conflicts and
+	// is recorded as last_upgraded_version in .tracks.yaml.
+	Version string `json:"version"`
+
+	// DryRun reports what would change without writing any files.
+	DryRun bool `json:"dry_run"`
+}
+
+// Upgrade details for GeneratedFile results.
+const (
+	DetailNewFile        = "new in this version"
+	DetailUpdated        = "updated to the new template"
+	DetailMerged         = "merged with local changes"
+	DetailUpToDate       = "already up to date"
+	DetailLocalKept      = "local changes kept"
+	DetailDeletedLocally = "deleted locally, not restored"
+	DetailUnresolved     = "has unresolved conflict markers from a previous upgrade"
+	DetailStarterKit     = "from the starter kit, not upgraded"
+)
+
+type projectUpgrader struct {
+	renderer generatorinterfaces.TemplateRenderer
+}
+
+// NewProjectUpgrader creates an upgrader that merges the templates embedded
+// in this CLI into existing projects.
+func NewProjectUpgrader() interfaces.ProjectUpgrader {
+	return &projectUpgrader{}
+}
+
+// Upgrade re-renders every upgradable template and three-way merges it with
+// the project's copy, using the base snapshot recorded at generation or the
+// previous upgrade as the common ancestor. Files without a snapshot, from
+// projects generated before snapshots existed, are merged two-way. The
+// generation manifest records the new templates, so `tracks status` reports
+// files that kept local changes as modified.
+//
+// Files are processed independently: a conflict in one file does not stop
+// the others. Conflicts are written into the file with git-style markers and
+// reported with FileActionConflict. Files the manifest records as rendered
+// from a starter kit template are skipped: the embedded templates are not
+// their upstream. In projects adopted with `tracks init`, only the files in
+// the manifest are upgraded.
+func (u *projectUpgrader) Upgrade(ctx context.Context, cfg any) ([]interfaces.GeneratedFile, error) {
+	logger := zerolog.Ctx(ctx)
+
+	upgradeCfg, ok := cfg.(UpgradeConfig)
+	if !ok {
+		return nil, fmt.Errorf("invalid config type: expected UpgradeConfig, got %T", cfg)
+	}
+	if upgradeCfg.ProjectName == "" || upgradeCfg.ModulePath == "" {
+		return nil, errors.New(".tracks.yaml is missing the project name or module path")
+	}
+	switch upgradeCfg.DatabaseDriver {
+	case "go-libsql", "sqlite3", "postgres":
+	default:
+		return nil, fmt.Errorf("unsupported database driver %q", upgradeCfg.DatabaseDriver)
+	}
+
+	data := template.TemplateData{
+		ModuleName:  upgradeCfg.ModulePath,
+		ProjectName: upgradeCfg.ProjectName,
+		DBDriver:    upgradeCfg.DatabaseDriver,
+		GoVersion:   projectGoVersion(upgradeCfg.ProjectDir, upgradeCfg.GoVersion),
+		EnvPrefix:   upgradeCfg.EnvPrefix,
+		Features:    orFull(upgradeCfg.Features),
+	}
+	labels := merge.Labels{Ours: "yours", Theirs: "tracks " + upgradeCfg.Version}
+
+	manifest, err := ReadManifest(upgradeCfg.ProjectDir)
+	if errors.Is(err, ErrNoManifest) {
+		manifest = newManifest()
+	} else if err != nil {
+		return nil, err
+	}
+
+	upgradable := upgradableTemplates(data.Features)
+	if manifest.Adopted {
+		for templateName, outputFile := range upgradable {
+			if manifest.file(outputFile) == nil {
+				delete(upgradable, templateName)
+			}
+		}
+	}
+	templateNames := make([]string, 0, len(upgradable))
+	for templateName := range upgradable {
+		templateNames = append(templateNames, templateName)
+	}
+	sort.Slice(templateNames, func(i, j int) bool {
+		return upgradable[templateNames[i]] < upgradable[templateNames[j]]
+	})
+
+	files := make([]interfaces.GeneratedFile, 0, len(templateNames))
+	renderer := rendererOr(u.renderer, upgradeCfg.ProjectDir)
+	sources := templateFS(upgradeCfg.ProjectDir)
+	for _, templateName := range templateNames {
+		outputFile := upgradable[templateName]
+
+		if entry := manifest.file(outputFile); entry != nil && entry.Starter {
+			files = append(files, interfaces.GeneratedFile{
+				Path:   outputFile,
+				Action: interfaces.FileActionSkip,
+				Detail: DetailStarterKit,
+			})
+			continue
+		}
+
+		rendered, err := renderer.Render(templateName, data)
+		if err != nil {
+			return files, fmt.Errorf("failed to render %s: %w", templateName, err)
+		}
+
+		file, content, err := upgradeFile(upgradeCfg.ProjectDir, outputFile, rendered, labels)
+		if err != nil {
+			return files, err
+		}
+		files = append(files, file)
+
+		logger.Debug().
+			Str("file", outputFile).
+			Str("action", file.Action).
+			Str("detail", file.Detail).
+			Msg("upgraded file")
+
+		if upgradeCfg.DryRun || file.Detail == DetailUnresolved {
+			continue
+		}
+		if content != nil {
+			if err := writeProjectFile(filepath.Join(upgradeCfg.ProjectDir, outputFile), *content); err != nil {
+				return files, err
+			}
+		}
+		if err := writeProjectFile(baseSnapshotPath(upgradeCfg.ProjectDir, outputFile), rendered); err != nil {
+			return files, err
+		}
+		if err := manifest.set(sources, templateName, outputFile, rendered); err != nil {
+			return files, err
+		}
+	}
+
+	if !upgradeCfg.DryRun {
+		if err := manifest.write(upgradeCfg.ProjectDir, time.Now()); err != nil {
+			return files, err
+		}
+		if err := recordUpgradedVersion(upgradeCfg.ProjectDir, upgradeCfg.Version); err != nil {
+			return files, err
+		}
+	}
+
+	logger.Info().
+		Int("file_count", len(files)).
+		Bool("dry_run", upgradeCfg.DryRun).
+		Msg("project upgrade complete")
+
+	return files, nil
+}
+
+// upgradeFile decides how one file is upgraded. It returns the result and,
+// when the file must be written, its new content.
+func upgradeFile(projectDir, outputFile, rendered string, labels merge.Labels) (interfaces.GeneratedFile, *string, error) {
+	file := interfaces.GeneratedFile{Path: outputFile}
+
+	current, hasCurrent, err := readOptional(filepath.Join(projectDir, outputFile))
+	if err != nil {
+		return file, nil, err
+	}
+	base, hasBase, err := readOptional(baseSnapshotPath(projectDir, outputFile))
+	if err != nil {
+		return file, nil, err
+	}
+
+	switch {
+	case !hasCurrent && hasBase:
+		file.Action, file.Detail = interfaces.FileActionSkip, DetailDeletedLocally
+		return file, nil, nil
+	case !hasCurrent:
+		file.Action, file.Detail = interfaces.FileActionCreate, DetailNewFile
+		return file, &rendered, nil
+	case current == rendered:
+		file.Action, file.Detail = interfaces.FileActionSkip, DetailUpToDate
+		return file, nil, nil
+	case merge.HasConflictMarkers(current):
+		file.Action, file.Detail = interfaces.FileActionConflict, DetailUnresolved
+		return file, nil, nil
+	}
+
+	var result merge.Result
+	if hasBase {
+		result = merge.Merge3(base, current, rendered, labels)
+	} else {
+		result = merge.Merge2(current, rendered, labels)
+	}
+
+	switch {
+	case result.Conflicts > 0:
+		file.Action, file.Detail = interfaces.FileActionConflict, fmt.Sprintf("%d conflict(s)", result.Conflicts)
+	case result.Content == current:
+		file.Action, file.Detail = interfaces.FileActionSkip, DetailLocalKept
+		return file, nil, nil
+	case hasBase && current == base:
+		file.Action, file.Detail = interfaces.FileActionUpdate, DetailUpdated
+	default:
+		file.Action, file.Detail = interfaces.FileActionUpdate, DetailMerged
+	}
+	return file, &result.Content, nil
+}
+
+// lastUpgradedVersionPattern matches the last_upgraded_version entry of
+// .tracks.yaml, keeping its indentation and any trailing comment.
+var lastUpgradedVersionPattern = regexp.MustCompile(`(?m)^(\s*last_upgraded_version:\s*)"[^"]*"`)
+
+// recordUpgradedVersion sets last_upgraded_version in .tracks.yaml, editing
+// the line in place so comments and formatting are preserved.
+func recordUpgradedVersion(projectDir, version string) error {
+	path := filepath.Join(projectDir, tracksConfigFile)
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", tracksConfigFile, err)
+	}
+
+	if !lastUpgradedVersionPattern.Match(content) {
+		return fmt.Errorf("%s has no last_upgraded_version entry", tracksConfigFile)
+	}
+	updated := lastUpgradedVersionPattern.ReplaceAll(content, []byte(`${1}"`+version+`"`))
+
+	if err := os.WriteFile(path, updated, 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", tracksConfigFile, err)
+	}
+	return nil
+}
+
+// readOptional reads a file, reporting whether it exists.
+func readOptional(path string) (string, bool, error) {
+	content, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	return string(content), true, nil
+}
+
+func writeProjectFile(path, content string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,349 @@
+package generator
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	"github.com/anomalousventures/tracks/internal/generator/merge"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testTracksYAML = `project:
+  name: "testapp"
+  tracks_version: "v0.1.0"
+  last_upgraded_version: "v0.1.0"  # updated by tracks upgrade
+`
+
+func newTestUpgradeConfig(projectDir string) UpgradeConfig {
+	return UpgradeConfig{
+		ProjectDir:     projectDir,
+		ProjectName:    "testapp",
+		ModulePath:     "github.com/example/testapp",
+		DatabaseDriver: "go-libsql",
+		EnvPrefix:      "APP",
+		Version:        "v0.2.0",
+	}
+}
+
+// setupUpgradedProject creates a project whose files and base snapshots all
+// match the current templates.
+func setupUpgradedProject(t *testing.T) string {
+	t.Helper()
+	projectDir := t.TempDir()
+	writeTestFile(t, projectDir, tracksConfigFile, testTracksYAML)
+
+	_, err := NewProjectUpgrader().Upgrade(context.Background(), newTestUpgradeConfig(projectDir))
+	require.NoError(t, err)
+	return projectDir
+}
+
+func writeTestFile(t *testing.T, projectDir, rel, content string) {
+	t.Helper()
+	require.NoError(t, writeProjectFile(filepath.Join(projectDir, rel), content))
+}
+
+func readTestFile(t *testing.T, projectDir, rel string) string {
+	t.Helper()
+	content, err := os.ReadFile(filepath.Join(projectDir, rel))
+	require.NoError(t, err)
+	return string(content)
+}
+
+func findFile(t *testing.T, files []interfaces.GeneratedFile, path string) interfaces.GeneratedFile {
+	t.Helper()
+	for _, f := range files {
+		if f.Path == path {
+			return f
+		}
+	}
+	t.Fatalf("%s not in upgrade results", path)
+	return interfaces.GeneratedFile{}
+}
+
+func TestProjectUpgrader_CreatesMissingFiles(t *testing.T) {
+	projectDir := t.TempDir()
+	writeTestFile(t, projectDir, tracksConfigFile, testTracksYAML)
+
+	files, err := NewProjectUpgrader().Upgrade(context.Background(), newTestUpgradeConfig(projectDir))
+	require.NoError(t, err)
+
+	assert.Len(t, files, len(upgradableTemplates(orFull(interfaces.ProjectFeatures{}))))
+	readme := findFile(t, files, "README.md")
+	assert.Equal(t, interfaces.FileActionCreate, readme.Action)
+	assert.Equal(t, DetailNewFile, readme.Detail)
+
+	assert.Equal(t, readTestFile(t, projectDir, "README.md"), readTestFile(t, projectDir, ".tracks/base/README.md"))
+
+	m, err := ReadManifest(projectDir)
+	require.NoError(t, err)
+	assert.Len(t, m.Files, len(files))
+	assert.NoFileExists(t, filepath.Join(projectDir, ".env"))
+	assert.Contains(t, readTestFile(t, projectDir, tracksConfigFile), `last_upgraded_version: "v0.2.0"  # updated by tracks upgrade`)
+}
+
+func TestProjectUpgrader_Features(t *testing.T) {
+	projectDir := t.TempDir()
+	writeTestFile(t, projectDir, tracksConfigFile, testTracksYAML)
+	cfg := newTestUpgradeConfig(projectDir)
+	cfg.Features = interfaces.ProjectFeatures{CI: CINone}
+
+	files, err := NewProjectUpgrader().Upgrade(context.Background(), cfg)
+	require.NoError(t, err)
+
+	assert.Len(t, files, len(upgradableTemplates(cfg.Features)))
+	for _, f := range files {
+		assert.True(t, hasOutput(f.Path, cfg.Features), "%s belongs to a feature the project does not have", f.Path)
+	}
+	assert.NoFileExists(t, filepath.Join(projectDir, "Dockerfile"))
+	assert.NoFileExists(t, filepath.Join(projectDir, "package.json"))
+	assert.NotContains(t, readTestFile(t, projectDir, "go.mod"), "templui")
+}
+
+func TestProjectUpgrader_GoVersion(t *testing.T) {
+	projectDir := t.TempDir()
+	writeTestFile(t, projectDir, tracksConfigFile, testTracksYAML)
+	cfg := newTestUpgradeConfig(projectDir)
+	cfg.GoVersion = "1.24"
+	_, err := NewProjectUpgrader().Upgrade(context.Background(), cfg)
+	require.NoError(t, err)
+	require.Contains(t, readTestFile(t, projectDir, "go.mod"), "\ngo 1.24\n")
+
+	tests := []struct {
+		name      string
+		goVersion string
+	}{
+		{name: "recorded in .tracks.yaml", goVersion: "1.24"},
+		{name: "read from go.mod", goVersion: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg.GoVersion = tt.goVersion
+			files, err := NewProjectUpgrader().Upgrade(context.Background(), cfg)
+			require.NoError(t, err)
+
+			for _, f := range files {
+				assert.Equal(t, DetailUpToDate, f.Detail, f.Path)
+			}
+			assert.Contains(t, readTestFile(t, projectDir, "go.mod"), "\ngo 1.24\n")
+			assert.Contains(t, readTestFile(t, projectDir, "Dockerfile"), "FROM golang:1.24-alpine")
+		})
+	}
+}
+
+func TestProjectUpgrader_Adopted(t *testing.T) {
+	projectDir := t.TempDir()
+	writeTestFile(t, projectDir, "go.mod", testGoMod)
+	writeTestFile(t, projectDir, "Makefile", testMakefile)
+	_, err := NewProjectInitializer().Init(context.Background(), InitConfig{
+		ProjectDir:     projectDir,
+		ProjectName:    "testapp",
+		ModulePath:     "github.com/example/testapp",
+		DatabaseDriver: "go-libsql",
+		EnvPrefix:      "APP",
+	})
+	require.NoError(t, err)
+
+	files, err := NewProjectUpgrader().Upgrade(context.Background(), newTestUpgradeConfig(projectDir))
+	require.NoError(t, err)
+	assert.Empty(t, files)
+	assert.Equal(t, testGoMod, readTestFile(t, projectDir, "go.mod"))
+	assert.Equal(t, testMakefile, readTestFile(t, projectDir, "Makefile"))
+	assert.NoFileExists(t, filepath.Join(projectDir, "README.md"))
+
+	m, err := ReadManifest(projectDir)
+	require.NoError(t, err)
+	require.NoError(t, m.set(templateFS(projectDir), ".github/workflows/ci.yml.tmpl", ".github/workflows/ci.yml", "old pipeline\n"))
+	require.NoError(t, m.write(projectDir, time.Now()))
+	writeTestFile(t, projectDir, ".github/workflows/ci.yml", "old pipeline\n")
+	writeTestFile(t, projectDir, ".tracks/base/.github/workflows/ci.yml", "old pipeline\n")
+
+	files, err = NewProjectUpgrader().Upgrade(context.Background(), newTestUpgradeConfig(projectDir))
+	require.NoError(t, err)
+	require.Len(t, files, 1, "only files Tracks wrote are upgraded")
+	assert.Equal(t, interfaces.GeneratedFile{Path: ".github/workflows/ci.yml", Action: interfaces.FileActionUpdate, Detail: DetailUpdated}, files[0])
+
+	m, err = ReadManifest(projectDir)
+	require.NoError(t, err)
+	assert.True(t, m.Adopted, "upgrades keep the project adopted")
+}
+
+func TestProjectUpgrader_UpToDate(t *testing.T) {
+	projectDir := setupUpgradedProject(t)
+
+	files, err := NewProjectUpgrader().Upgrade(context.Background(), newTestUpgradeConfig(projectDir))
+	require.NoError(t, err)
+
+	for _, f := range files {
+		assert.Equal(t, DetailUpToDate, f.Detail, f.Path)
+	}
+}
+
+func TestProjectUpgrader_Merge(t *testing.T) {
+	const file = "README.md"
+
+	tests := []struct {
+		name       string
+		base       func(current string) string
+		local      func(current string) string
+		wantAction string
+		wantDetail string
+		check      func(t *testing.T, current, result string)
+	}{
+		{
+			name:       "template changed, file untouched",
+			base:       func(c string) string { return "Old intro\n" + c },
+			local:      func(c string) string { return "Old intro\n" + c },
+			wantAction: interfaces.FileActionUpdate,
+			wantDetail: DetailUpdated,
+			check: func(t *testing.T, current, result string) {
+				assert.Equal(t, current, result)
+			},
+		},
+		{
+			name:       "template and file changed in different places",
+			base:       func(c string) string { return "Old intro\n" + c },
+			local:      func(c string) string { return "Old intro\n" + c + "## Our notes\n" },
+			wantAction: interfaces.FileActionUpdate,
+			wantDetail: DetailMerged,
+			check: func(t *testing.T, current, result string) {
+				assert.Equal(t, current+"## Our notes\n", result)
+			},
+		},
+		{
+			name:       "only file changed",
+			base:       func(c string) string { return c },
+			local:      func(c string) string { return c + "## Our notes\n" },
+			wantAction: interfaces.FileActionSkip,
+			wantDetail: DetailLocalKept,
+			check: func(t *testing.T, current, result string) {
+				assert.Equal(t, current+"## Our notes\n", result)
+			},
+		},
+		{
+			name:       "template and file changed the same lines",
+			base:       func(c string) string { return "Old intro\n" + c },
+			local:      func(c string) string { return "Our intro\n" + c },
+			wantAction: interfaces.FileActionConflict,
+			wantDetail: "1 conflict(s)",
+			check: func(t *testing.T, current, result string) {
+				assert.True(t, merge.HasConflictMarkers(result))
+				assert.Contains(t, result, "<<<<<<< yours\nOur intro\n=======\n")
+				assert.Contains(t, result, ">>>>>>> tracks v0.2.0\n")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			projectDir := setupUpgradedProject(t)
+			current := readTestFile(t, projectDir, file)
+			writeTestFile(t, projectDir, filepath.Join(baseSnapshotDir, file), tt.base(current))
+			writeTestFile(t, projectDir, file, tt.local(current))
+
+			files, err := NewProjectUpgrader().Upgrade(context.Background(), newTestUpgradeConfig(projectDir))
+			require.NoError(t, err)
+
+			got := findFile(t, files, file)
+			assert.Equal(t, tt.wantAction, got.Action)
+			assert.Equal(t, tt.wantDetail, got.Detail)
+			tt.check(t, current, readTestFile(t, projectDir, file))
+			assert.Equal(t, current, readTestFile(t, projectDir, filepath.Join(baseSnapshotDir, file)), "base snapshot should match the new template")
+
+			statuses, err := NewStatusChecker().Status(context.Background(), projectDir)
+			require.NoError(t, err)
+			wantState := interfaces.FileStateModified
+			if tt.wantDetail == DetailUpdated {
+				wantState = interfaces.FileStateUntouched
+			}
+			assert.Contains(t, statuses, interfaces.FileStatus{Path: file, State: wantState})
+		})
+	}
+}
+
+func TestProjectUpgrader_NoBaseSnapshot(t *testing.T) {
+	projectDir := setupUpgradedProject(t)
+	current := readTestFile(t, projectDir, "README.md")
+	require.NoError(t, os.RemoveAll(filepath.Join(projectDir, baseSnapshotDir)))
+
+	firstLine, rest, _ := strings.Cut(current, "\n")
+	writeTestFile(t, projectDir, "README.md", "# Our app\n"+rest+"## Our notes\n")
+
+	files, err := NewProjectUpgrader().Upgrade(context.Background(), newTestUpgradeConfig(projectDir))
+	require.NoError(t, err)
+
+	got := findFile(t, files, "README.md")
+	assert.Equal(t, interfaces.FileActionConflict, got.Action)
+	assert.Equal(t, "1 conflict(s)", got.Detail)
+
+	result := readTestFile(t, projectDir, "README.md")
+	assert.Contains(t, result, "<<<<<<< yours\n# Our app\n=======\n"+firstLine+"\n>>>>>>> tracks v0.2.0\n")
+	assert.True(t, strings.HasSuffix(result, "## Our notes\n"), "lines only added locally should merge cleanly")
+	assert.FileExists(t, filepath.Join(projectDir, ".tracks/base/README.md"))
+}
+
+func TestProjectUpgrader_DeletedLocally(t *testing.T) {
+	projectDir := setupUpgradedProject(t)
+	require.NoError(t, os.Remove(filepath.Join(projectDir, "README.md")))
+
+	files, err := NewProjectUpgrader().Upgrade(context.Background(), newTestUpgradeConfig(projectDir))
+	require.NoError(t, err)
+
+	got := findFile(t, files, "README.md")
+	assert.Equal(t, interfaces.FileActionSkip, got.Action)
+	assert.Equal(t, DetailDeletedLocally, got.Detail)
+	assert.NoFileExists(t, filepath.Join(projectDir, "README.md"))
+}
+
+func TestProjectUpgrader_UnresolvedConflict(t *testing.T) {
+	projectDir := setupUpgradedProject(t)
+	unresolved := "<<<<<<< yours\nmine\n=======\ntheirs\n>>>>>>> tracks v0.1.0\n"
+	writeTestFile(t, projectDir, "README.md", unresolved)
+	writeTestFile(t, projectDir, ".tracks/base/README.md", "old base\n")
+
+	files, err := NewProjectUpgrader().Upgrade(context.Background(), newTestUpgradeConfig(projectDir))
+	require.NoError(t, err)
+
+	got := findFile(t, files, "README.md")
+	assert.Equal(t, interfaces.FileActionConflict, got.Action)
+	assert.Equal(t, DetailUnresolved, got.Detail)
+	assert.Equal(t, unresolved, readTestFile(t, projectDir, "README.md"))
+	assert.Equal(t, "old base\n", readTestFile(t, projectDir, ".tracks/base/README.md"))
+}
+
+func TestProjectUpgrader_DryRun(t *testing.T) {
+	projectDir := t.TempDir()
+	writeTestFile(t, projectDir, tracksConfigFile, testTracksYAML)
+
+	cfg := newTestUpgradeConfig(projectDir)
+	cfg.DryRun = true
+	files, err := NewProjectUpgrader().Upgrade(context.Background(), cfg)
+	require.NoError(t, err)
+
+	assert.Equal(t, interfaces.FileActionCreate, findFile(t, files, "README.md").Action)
+	assert.NoFileExists(t, filepath.Join(projectDir, "README.md"))
+	assert.NoDirExists(t, filepath.Join(projectDir, baseSnapshotDir))
+	assert.NoFileExists(t, filepath.Join(projectDir, manifestFile))
+	assert.Equal(t, testTracksYAML, readTestFile(t, projectDir, tracksConfigFile))
+}
+
+func TestProjectUpgrader_InvalidConfig(t *testing.T) {
+	upgrader := NewProjectUpgrader()
+
+	_, err := upgrader.Upgrade(context.Background(), "not a config")
+	assert.ErrorContains(t, err, "invalid config type")
+
+	cfg := newTestUpgradeConfig(t.TempDir())
+	cfg.DatabaseDriver = "mysql"
+	_, err = upgrader.Upgrade(context.Background(), cfg)
+	assert.ErrorContains(t, err, "unsupported database driver")
+
+	cfg = newTestUpgradeConfig(t.TempDir())
+	_, err = upgrader.Upgrade(context.Background(), cfg)
+	assert.ErrorContains(t, err, tracksConfigFile)
+}
@@ -0,0 +1,155 @@
+// Package process runs the external commands Tracks depends on (go, make,
+// npm, git, templUI). NewRunner returns the interfaces.CommandRunner the
+// generators and executors use; package processtest provides a fake.
+//
+// Output is streamed line by line to the OutputFunc in the context, so
+// long-running steps show progress in verbose mode, and captured so a failed
+// command can report what it printed.
+//
+// Example:
+//
+//	runner := process.NewRunner()
+//	ctx = trackscontext.WithOutput(ctx, renderer.Stream)
+//	_, err := runner.Run(ctx, interfaces.Command{
+//	    Name: "go",
+//	    Args: []string{"mod", "tidy"},
+//	    Dir:  projectRoot,
+//	    Step: "go mod tidy",
+//	})
+package process
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+	"sync"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	trackscontext "github.com/anomalousventures/tracks/internal/context"
+)
+
+// errorOutputLines is how many trailing lines of output an Error reports.
+const errorOutputLines = 20
+
+// CommandLine returns the command line of cmd, as reported in errors.
+func CommandLine(cmd interfaces.Command) string {
+	return strings.Join(append([]string{cmd.Name}, cmd.Args...), " ")
+}
+
+// Error reports a command that failed, with the end of its output.
+type Error struct {
+	// Command is the command line that failed.
+	Command string
+
+	// Output is the combined output of the command.
+	Output []byte
+
+	// Err is the underlying error, usually an *exec.ExitError.
+	Err error
+}
+
+// Error returns the command, the underlying error and the last lines of
+// output, indented.
+func (e *Error) Error() string {
+	msg := fmt.Sprintf("%s: %v", e.Command, e.Err)
+
+	lines := strings.Split(strings.TrimRight(string(e.Output), "\n"), "\n")
+	if len(lines) == 1 && lines[0] == "" {
+		return msg
+	}
+	if len(lines) > errorOutputLines {
+		lines = lines[len(lines)-errorOutputLines:]
+	}
+	return msg + "\n    " + strings.Join(lines, "\n    ")
+}
+
+// Unwrap returns the underlying error for error chain unwrapping.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+type runner struct{}
+
+// NewRunner creates a CommandRunner that starts real processes.
+func NewRunner() interfaces.CommandRunner {
+	return &runner{}
+}
+
+// Run runs cmd and waits for it to finish. Each line it writes is passed to
+// the context's OutputFunc as it arrives when cmd.Step is set. A command
+// that fails returns an *Error.
+func (r *runner) Run(ctx context.Context, cmd interfaces.Command) (interfaces.CommandResult, error) {
+	c := exec.CommandContext(ctx, cmd.Name, cmd.Args...)
+	c.Dir = cmd.Dir
+
+	output := trackscontext.GetOutput(ctx)
+	if cmd.Step == "" {
+		output = func(string, string) {}
+	}
+
+	var (
+		mu       sync.Mutex
+		stdout   bytes.Buffer
+		combined bytes.Buffer
+	)
+	emit := func(line string) {
+		output(cmd.Step, line)
+	}
+	stdoutWriter := &lineWriter{mu: &mu, captured: []*bytes.Buffer{&stdout, &combined}, emit: emit}
+	stderrWriter := &lineWriter{mu: &mu, captured: []*bytes.Buffer{&combined}, emit: emit}
+	c.Stdout = stdoutWriter
+	c.Stderr = stderrWriter
+
+	err := c.Run()
+	stdoutWriter.flush()
+	stderrWriter.flush()
+
+	result := interfaces.CommandResult{Stdout: stdout.Bytes(), Output: combined.Bytes()}
+	if err != nil {
+		return result, &Error{Command: CommandLine(cmd), Output: result.Output, Err: err}
+	}
+	return result, nil
+}
+
+// lineWriter captures everything written to it and emits each complete
+// line. Writers of the same command share mu, since exec copies stdout and
+// stderr in separate goroutines.
+type lineWriter struct {
+	mu       *sync.Mutex
+	captured []*bytes.Buffer
+	emit     func(line string)
+	partial  []byte
+}
+
+func (w *lineWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for _, buf := range w.captured {
+		buf.Write(p)
+	}
+
+	w.partial = append(w.partial, p...)
+	for {
+		i := bytes.IndexByte(w.partial, '\n')
+		if i < 0 {
+			break
+		}
+		w.emit(strings.TrimRight(string(w.partial[:i]), "\r"))
+		w.partial = w.partial[i+1:]
+	}
+	return len(p), nil
+}
+
+// flush emits a final line that did not end in a newline.
+func (w *lineWriter) flush() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if len(w.partial) > 0 {
+		w.emit(strings.TrimRight(string(w.partial), "\r"))
+		w.partial = nil
+	}
+}
@@ -0,0 +1,129 @@
+package process
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	trackscontext "github.com/anomalousventures/tracks/internal/context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordOutput returns a context that records streamed lines.
+func recordOutput(t *testing.T) (context.Context, func() []string) {
+	t.Helper()
+	var (
+		mu    sync.Mutex
+		lines []string
+	)
+	ctx := trackscontext.WithOutput(context.Background(), func(step, line string) {
+		mu.Lock()
+		defer mu.Unlock()
+		lines = append(lines, step+"|"+line)
+	})
+	return ctx, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string(nil), lines...)
+	}
+}
+
+func requireShell(t *testing.T) {
+	t.Helper()
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("sh not available")
+	}
+}
+
+func TestRun_StreamsAndCaptures(t *testing.T) {
+	requireShell(t)
+	ctx, lines := recordOutput(t)
+
+	result, err := NewRunner().Run(ctx, interfaces.Command{
+		Name: "sh",
+		Args: []string{"-c", "echo one; echo two >&2; printf three"},
+		Step: "build",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "one\nthree", string(result.Stdout))
+	assert.Contains(t, string(result.Output), "two\n")
+	assert.ElementsMatch(t, []string{"build|one", "build|two", "build|three"}, lines())
+}
+
+func TestRun_NoStepDoesNotStream(t *testing.T) {
+	requireShell(t)
+	ctx, lines := recordOutput(t)
+
+	result, err := NewRunner().Run(ctx, interfaces.Command{Name: "sh", Args: []string{"-c", "echo v1.2.3"}})
+	require.NoError(t, err)
+
+	assert.Equal(t, "v1.2.3\n", string(result.Stdout))
+	assert.Empty(t, lines())
+}
+
+func TestRun_Dir(t *testing.T) {
+	requireShell(t)
+	dir := t.TempDir()
+
+	result, err := NewRunner().Run(context.Background(), interfaces.Command{Name: "sh", Args: []string{"-c", "pwd"}, Dir: dir})
+	require.NoError(t, err)
+	assert.Contains(t, string(result.Stdout), dir)
+}
+
+func TestRun_Failure(t *testing.T) {
+	requireShell(t)
+
+	_, err := NewRunner().Run(context.Background(), interfaces.Command{
+		Name: "sh",
+		Args: []string{"-c", "echo compiling; echo 'main.go:3: undefined: x' >&2; exit 2"},
+		Step: "make generate",
+	})
+	require.Error(t, err)
+
+	var procErr *Error
+	require.True(t, errors.As(err, &procErr))
+	assert.Contains(t, procErr.Command, "sh -c")
+	assert.Contains(t, err.Error(), "exit status 2")
+	assert.Contains(t, err.Error(), "\n    main.go:3: undefined: x", "error should include the indented output")
+
+	var exitErr *exec.ExitError
+	assert.True(t, errors.As(err, &exitErr))
+}
+
+func TestRun_MissingProgram(t *testing.T) {
+	_, err := NewRunner().Run(context.Background(), interfaces.Command{Name: "tracks-no-such-program"})
+	var procErr *Error
+	require.True(t, errors.As(err, &procErr))
+	assert.ErrorIs(t, err, exec.ErrNotFound)
+}
+
+func TestCommandLine(t *testing.T) {
+	cmd := interfaces.Command{Name: "go", Args: []string{"mod", "tidy"}, Dir: "/tmp"}
+	assert.Equal(t, "go mod tidy", CommandLine(cmd))
+}
+
+func TestError_TruncatesOutput(t *testing.T) {
+	var b strings.Builder
+	for i := 1; i <= 30; i++ {
+		fmt.Fprintf(&b, "line %d\n", i)
+	}
+	err := &Error{Command: "npm install", Output: []byte(b.String()), Err: errors.New("exit status 1")}
+
+	msg := err.Error()
+	assert.True(t, strings.HasPrefix(msg, "npm install: exit status 1\n"))
+	assert.NotContains(t, msg, "line 10\n")
+	assert.Contains(t, msg, "line 11")
+	assert.True(t, strings.HasSuffix(msg, "line 30"))
+}
+
+func TestError_NoOutput(t *testing.T) {
+	err := &Error{Command: "git init", Err: errors.New("exit status 128")}
+	assert.Equal(t, "git init: exit status 128", err.Error())
+}
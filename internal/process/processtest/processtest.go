@@ -0,0 +1,134 @@
+// Package processtest provides a fake interfaces.CommandRunner, so code that
+// runs go, make, npm or git can be tested without those programs.
+//
+// Example:
+//
+//	runner := processtest.NewRunner()
+//	runner.Respond("make generate", processtest.Response{
+//	    Stderr: "templ: syntax error",
+//	    Err:    errors.New("exit status 2"),
+//	})
+//	gen := generator.NewProjectGenerator(runner)
+//	err := gen.Generate(ctx, cfg)
+//	// runner.Lines() lists every command Generate ran
+package processtest
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	trackscontext "github.com/anomalousventures/tracks/internal/context"
+	"github.com/anomalousventures/tracks/internal/process"
+)
+
+var _ interfaces.CommandRunner = (*Runner)(nil)
+
+// Response is the canned result of a command.
+type Response struct {
+	// Stdout is written to standard output.
+	Stdout string
+
+	// Stderr is written to standard error, after Stdout.
+	Stderr string
+
+	// Err, if set, makes the command fail with a *process.Error wrapping it.
+	Err error
+
+	// Do, if set, runs before the response is replayed, e.g. to create the
+	// files the real command would write.
+	Do func(cmd interfaces.Command)
+}
+
+// Runner records each command it is asked to run and replays canned
+// responses instead of starting processes. Commands without a response
+// succeed with no output. It is safe for concurrent use.
+type Runner struct {
+	mu        sync.Mutex
+	calls     []interfaces.Command
+	responses map[string]Response
+}
+
+// NewRunner creates a Runner with no responses.
+func NewRunner() *Runner {
+	return &Runner{responses: make(map[string]Response)}
+}
+
+// Respond replays resp for commands whose command line is line or starts
+// with line followed by a space. The longest matching line wins.
+func (r *Runner) Respond(line string, resp Response) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.responses[line] = resp
+}
+
+// Run records cmd and replays its response. Output is streamed to the
+// context's OutputFunc when cmd.Step is set, like the real runner.
+func (r *Runner) Run(ctx context.Context, cmd interfaces.Command) (interfaces.CommandResult, error) {
+	r.mu.Lock()
+	r.calls = append(r.calls, cmd)
+	resp := r.match(process.CommandLine(cmd))
+	r.mu.Unlock()
+
+	if resp.Do != nil {
+		resp.Do(cmd)
+	}
+
+	if cmd.Step != "" {
+		output := trackscontext.GetOutput(ctx)
+		for _, text := range []string{resp.Stdout, resp.Stderr} {
+			for _, line := range strings.Split(strings.TrimSuffix(text, "\n"), "\n") {
+				if line != "" {
+					output(cmd.Step, line)
+				}
+			}
+		}
+	}
+
+	result := interfaces.CommandResult{
+		Stdout: []byte(resp.Stdout),
+		Output: []byte(resp.Stdout + resp.Stderr),
+	}
+	if resp.Err != nil {
+		return result, &process.Error{Command: process.CommandLine(cmd), Output: result.Output, Err: resp.Err}
+	}
+	if err := ctx.Err(); err != nil {
+		return result, &process.Error{Command: process.CommandLine(cmd), Err: err}
+	}
+	return result, nil
+}
+
+// match returns the response registered for the longest prefix of line.
+func (r *Runner) match(line string) Response {
+	var (
+		best  Response
+		found = -1
+	)
+	for prefix, resp := range r.responses {
+		if line != prefix && !strings.HasPrefix(line, prefix+" ") {
+			continue
+		}
+		if len(prefix) > found {
+			best, found = resp, len(prefix)
+		}
+	}
+	return best
+}
+
+// Calls returns the commands run so far, in order.
+func (r *Runner) Calls() []interfaces.Command {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]interfaces.Command(nil), r.calls...)
+}
+
+// Lines returns the command lines run so far, in order.
+func (r *Runner) Lines() []string {
+	calls := r.Calls()
+	lines := make([]string, len(calls))
+	for i, cmd := range calls {
+		lines[i] = process.CommandLine(cmd)
+	}
+	return lines
+}
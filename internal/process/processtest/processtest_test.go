@@ -0,0 +1,91 @@
+package processtest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	trackscontext "github.com/anomalousventures/tracks/internal/context"
+	"github.com/anomalousventures/tracks/internal/process"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunner_RecordsCalls(t *testing.T) {
+	runner := NewRunner()
+
+	_, err := runner.Run(context.Background(), interfaces.Command{Name: "go", Args: []string{"mod", "tidy"}, Dir: "/app"})
+	require.NoError(t, err)
+	_, err = runner.Run(context.Background(), interfaces.Command{Name: "make", Args: []string{"generate"}})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"go mod tidy", "make generate"}, runner.Lines())
+	assert.Equal(t, "/app", runner.Calls()[0].Dir)
+}
+
+func TestRunner_ReplaysResponses(t *testing.T) {
+	runner := NewRunner()
+	runner.Respond("go", Response{Stdout: "any go command\n"})
+	runner.Respond("go mod tidy", Response{Stdout: "tidied\n", Stderr: "warning\n"})
+
+	result, err := runner.Run(context.Background(), interfaces.Command{Name: "go", Args: []string{"mod", "tidy"}})
+	require.NoError(t, err)
+	assert.Equal(t, "tidied\n", string(result.Stdout), "the longest matching line should win")
+	assert.Equal(t, "tidied\nwarning\n", string(result.Output))
+
+	result, err = runner.Run(context.Background(), interfaces.Command{Name: "go", Args: []string{"version"}})
+	require.NoError(t, err)
+	assert.Equal(t, "any go command\n", string(result.Stdout))
+
+	result, err = runner.Run(context.Background(), interfaces.Command{Name: "gofmt"})
+	require.NoError(t, err)
+	assert.Empty(t, result.Output, "a line should only match whole words")
+}
+
+func TestRunner_Failure(t *testing.T) {
+	runner := NewRunner()
+	runner.Respond("npm install", Response{Stderr: "ERR! network\n", Err: errors.New("exit status 1")})
+
+	_, err := runner.Run(context.Background(), interfaces.Command{Name: "npm", Args: []string{"install", "--no-audit"}})
+
+	var procErr *process.Error
+	require.ErrorAs(t, err, &procErr)
+	assert.Equal(t, "npm install --no-audit", procErr.Command)
+	assert.Contains(t, err.Error(), "ERR! network")
+}
+
+func TestRunner_StreamsOutput(t *testing.T) {
+	var lines []string
+	ctx := trackscontext.WithOutput(context.Background(), func(step, line string) {
+		lines = append(lines, step+"|"+line)
+	})
+
+	runner := NewRunner()
+	runner.Respond("make generate", Response{Stdout: "templ generate\n", Stderr: "done"})
+
+	_, err := runner.Run(ctx, interfaces.Command{Name: "make", Args: []string{"generate"}, Step: "make generate"})
+	require.NoError(t, err)
+	_, err = runner.Run(ctx, interfaces.Command{Name: "make", Args: []string{"generate"}})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"make generate|templ generate", "make generate|done"}, lines)
+}
+
+func TestRunner_Do(t *testing.T) {
+	runner := NewRunner()
+	var got interfaces.Command
+	runner.Respond("git init", Response{Do: func(cmd interfaces.Command) { got = cmd }})
+
+	_, err := runner.Run(context.Background(), interfaces.Command{Name: "git", Args: []string{"init"}, Dir: "/app"})
+	require.NoError(t, err)
+	assert.Equal(t, "/app", got.Dir)
+}
+
+func TestRunner_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := NewRunner().Run(ctx, interfaces.Command{Name: "go", Args: []string{"build"}})
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -77,6 +77,15 @@ func (d *detector) loadConfig(configPath string) (*interfaces.TracksProject, err
 		Name:       config.Project.Name,
 		ModulePath: config.Project.ModulePath,
 		DBDriver:   config.Project.DatabaseDriver,
+		EnvPrefix:  config.Project.EnvPrefix,
+		GoVersion:  config.Project.GoVersion,
+		Features: interfaces.ProjectFeatures{
+			UI:       config.Features.UI,
+			Node:     config.Features.Node,
+			Docker:   config.Features.Docker,
+			CI:       config.Features.CI,
+			Examples: config.Features.Examples,
+		},
 	}, nil
 }
 
@@ -90,5 +99,13 @@ type tracksConfig struct {
 		LastUpgradedVersion string `yaml:"last_upgraded_version"`
 		DatabaseDriver      string `yaml:"database_driver"`
 		EnvPrefix           string `yaml:"env_prefix"`
+		GoVersion           string `yaml:"go_version"`
 	} `yaml:"project"`
+	Features struct {
+		UI       bool   `yaml:"ui"`
+		Node     bool   `yaml:"node"`
+		Docker   bool   `yaml:"docker"`
+		CI       string `yaml:"ci"`
+		Examples bool   `yaml:"examples"`
+	} `yaml:"features"`
 }
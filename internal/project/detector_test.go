@@ -6,6 +6,8 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
 )
 
 func TestNewDetector(t *testing.T) {
@@ -47,6 +49,7 @@ project:
   last_upgraded_version: "dev"
   database_driver: "go-libsql"
   env_prefix: "APP"
+  go_version: "1.24"
 `
 	if err := os.WriteFile(configPath, []byte(configContent), 0644); err != nil {
 		t.Fatalf("failed to write config: %v", err)
@@ -66,6 +69,12 @@ project:
 	if proj.DBDriver != "go-libsql" {
 		t.Errorf("expected DBDriver 'go-libsql', got %q", proj.DBDriver)
 	}
+	if proj.EnvPrefix != "APP" {
+		t.Errorf("expected EnvPrefix 'APP', got %q", proj.EnvPrefix)
+	}
+	if proj.GoVersion != "1.24" {
+		t.Errorf("expected GoVersion '1.24', got %q", proj.GoVersion)
+	}
 
 	absDir, _ := filepath.Abs(tmpDir)
 	if dir != absDir {
@@ -194,3 +203,41 @@ project:
 		t.Errorf("expected DBDriver 'sqlite3', got %q", proj.DBDriver)
 	}
 }
+
+func TestLoadConfig_Features(t *testing.T) {
+	d := &detector{}
+
+	tests := []struct {
+		name    string
+		content string
+		want    interfaces.ProjectFeatures
+	}{
+		{
+			name:    "no features block",
+			content: "project:\n  name: \"myapp\"\n",
+			want:    interfaces.ProjectFeatures{},
+		},
+		{
+			name:    "api profile",
+			content: "project:\n  name: \"myapp\"\nfeatures:\n  ui: false\n  node: false\n  docker: true\n  ci: \"gitlab\"\n  examples: false\n",
+			want:    interfaces.ProjectFeatures{Docker: true, CI: "gitlab"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configPath := filepath.Join(t.TempDir(), ".tracks.yaml")
+			if err := os.WriteFile(configPath, []byte(tt.content), 0644); err != nil {
+				t.Fatalf("failed to write config: %v", err)
+			}
+
+			proj, err := d.loadConfig(configPath)
+			if err != nil {
+				t.Fatalf("loadConfig failed: %v", err)
+			}
+			if proj.Features != tt.want {
+				t.Errorf("expected Features %+v, got %+v", tt.want, proj.Features)
+			}
+		})
+	}
+}
@@ -5,12 +5,14 @@ package templates
 
 import "embed"
 
-// FS contains all embedded template files from the project and examples directories.
+// FS contains all embedded template files from the project, examples, resource and migration directories.
 // The all:project pattern embeds all files recursively from the project directory.
-// The all:examples pattern embeds reference templates used for future code generation.
+// The all:examples pattern embeds reference templates for domain-based routing patterns.
+// The all:resource pattern embeds the templates rendered by `tracks generate resource`.
+// The all:migration pattern embeds the templates rendered by `tracks generate migration`.
 //
 // The embedded filesystem uses forward slashes (/) as path separators
 // regardless of the host operating system, ensuring cross-platform compatibility.
 //
-//go:embed all:project all:examples
+//go:embed all:project all:examples all:resource all:migration
 var FS embed.FS
@@ -3,27 +3,30 @@ package templui
 import (
 	"context"
 	"fmt"
-	"os/exec"
 	"strings"
 
 	"github.com/anomalousventures/tracks/internal/cli/interfaces"
 	"github.com/rs/zerolog"
 )
 
-type executor struct{}
+type executor struct {
+	runner interfaces.CommandRunner
+}
 
-// NewExecutor creates a new UIExecutor implementation.
-func NewExecutor() interfaces.UIExecutor {
-	return &executor{}
+// NewExecutor creates a new UIExecutor implementation that runs templUI
+// through runner.
+func NewExecutor(runner interfaces.CommandRunner) interfaces.UIExecutor {
+	return &executor{runner: runner}
 }
 
 func (e *executor) Version(ctx context.Context, projectDir string) (string, error) {
 	logger := zerolog.Ctx(ctx)
 
-	cmd := exec.CommandContext(ctx, "go", "tool", "templui", "--version")
-	cmd.Dir = projectDir
-
-	output, err := cmd.Output()
+	result, err := e.runner.Run(ctx, interfaces.Command{
+		Name: "go",
+		Args: []string{"tool", "templui", "--version"},
+		Dir:  projectDir,
+	})
 	if err != nil {
 		logger.Error().
 			Err(err).
@@ -33,7 +36,7 @@ func (e *executor) Version(ctx context.Context, projectDir string) (string, erro
 		return "", fmt.Errorf("failed to get templui version: %w", err)
 	}
 
-	return strings.TrimSpace(string(output)), nil
+	return strings.TrimSpace(string(result.Stdout)), nil
 }
 
 func (e *executor) Add(ctx context.Context, projectDir, ref string, components []string, force bool) error {
@@ -54,15 +57,12 @@ func (e *executor) Add(ctx context.Context, projectDir, ref string, components [
 	}
 	args = append(args, components...)
 
-	cmd := exec.CommandContext(ctx, "go", args...)
-	cmd.Dir = projectDir
-
-	output, err := cmd.CombinedOutput()
+	result, err := e.runner.Run(ctx, interfaces.Command{Name: "go", Args: args, Dir: projectDir, Step: "templui"})
 	if err != nil {
 		logger.Error().
 			Err(err).
 			Str("command", fmt.Sprintf("go %s", strings.Join(args, " "))).
-			Str("output", string(output)).
+			Str("output", string(result.Output)).
 			Str("dir", projectDir).
 			Msg("failed to add components")
 		return fmt.Errorf("failed to add components: %w", err)
@@ -79,10 +79,11 @@ func (e *executor) List(ctx context.Context, projectDir, ref string) ([]interfac
 		toolName = fmt.Sprintf("templui@%s", ref)
 	}
 
-	cmd := exec.CommandContext(ctx, "go", "tool", toolName, "list")
-	cmd.Dir = projectDir
-
-	output, err := cmd.Output()
+	result, err := e.runner.Run(ctx, interfaces.Command{
+		Name: "go",
+		Args: []string{"tool", toolName, "list"},
+		Dir:  projectDir,
+	})
 	if err != nil {
 		logger.Error().
 			Err(err).
@@ -92,7 +93,7 @@ func (e *executor) List(ctx context.Context, projectDir, ref string) ([]interfac
 		return nil, fmt.Errorf("failed to list components: %w", err)
 	}
 
-	return parseComponentList(string(output)), nil
+	return parseComponentList(string(result.Stdout)), nil
 }
 
 func (e *executor) Upgrade(ctx context.Context, projectDir, ref string) error {
@@ -103,15 +104,17 @@ func (e *executor) Upgrade(ctx context.Context, projectDir, ref string) error {
 		toolName = fmt.Sprintf("templui@%s", ref)
 	}
 
-	cmd := exec.CommandContext(ctx, "go", "tool", toolName, "upgrade")
-	cmd.Dir = projectDir
-
-	output, err := cmd.CombinedOutput()
+	result, err := e.runner.Run(ctx, interfaces.Command{
+		Name: "go",
+		Args: []string{"tool", toolName, "upgrade"},
+		Dir:  projectDir,
+		Step: "templui",
+	})
 	if err != nil {
 		logger.Error().
 			Err(err).
 			Str("command", fmt.Sprintf("go tool %s upgrade", toolName)).
-			Str("output", string(output)).
+			Str("output", string(result.Output)).
 			Str("dir", projectDir).
 			Msg("failed to upgrade templui")
 		return fmt.Errorf("failed to upgrade templui: %w", err)
@@ -121,9 +124,12 @@ func (e *executor) Upgrade(ctx context.Context, projectDir, ref string) error {
 }
 
 func (e *executor) IsAvailable(ctx context.Context, projectDir string) bool {
-	cmd := exec.CommandContext(ctx, "go", "tool", "templui", "--version")
-	cmd.Dir = projectDir
-	return cmd.Run() == nil
+	_, err := e.runner.Run(ctx, interfaces.Command{
+		Name: "go",
+		Args: []string{"tool", "templui", "--version"},
+		Dir:  projectDir,
+	})
+	return err == nil
 }
 
 func parseComponentList(output string) []interfaces.UIComponent {
@@ -1,13 +1,17 @@
 package templui
 
 import (
+	"errors"
+	"strings"
 	"testing"
 
 	"github.com/anomalousventures/tracks/internal/cli/interfaces"
+	"github.com/anomalousventures/tracks/internal/process"
+	"github.com/anomalousventures/tracks/internal/process/processtest"
 )
 
 func TestNewExecutor(t *testing.T) {
-	executor := NewExecutor()
+	executor := NewExecutor(process.NewRunner())
 	if executor == nil {
 		t.Fatal("NewExecutor returned nil")
 	}
@@ -82,7 +86,7 @@ func TestParseComponentList(t *testing.T) {
 }
 
 func TestExecutor_Add_EmptyComponents(t *testing.T) {
-	executor := NewExecutor()
+	executor := NewExecutor(process.NewRunner())
 
 	err := executor.Add(t.Context(), ".", "", []string{}, false)
 	if err == nil {
@@ -93,3 +97,129 @@ func TestExecutor_Add_EmptyComponents(t *testing.T) {
 		t.Errorf("expected error message 'at least one component name required', got %q", err.Error())
 	}
 }
+
+func TestExecutor_Version(t *testing.T) {
+	runner := processtest.NewRunner()
+	runner.Respond("go tool templui --version", processtest.Response{Stdout: "v0.98.0\n"})
+
+	version, err := NewExecutor(runner).Version(t.Context(), "/project")
+	if err != nil {
+		t.Fatalf("Version() error = %v", err)
+	}
+	if version != "v0.98.0" {
+		t.Errorf("Version() = %q, want %q", version, "v0.98.0")
+	}
+}
+
+func TestExecutor_Add(t *testing.T) {
+	runner := processtest.NewRunner()
+
+	err := NewExecutor(runner).Add(t.Context(), "/project", "v0.1.0", []string{"button", "card"}, true)
+	if err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+
+	calls := runner.Calls()
+	if len(calls) != 1 {
+		t.Fatalf("Add() ran %d commands, want 1", len(calls))
+	}
+	if got, want := process.CommandLine(calls[0]), "go tool templui@v0.1.0 add -f button card"; got != want {
+		t.Errorf("Add() ran %q, want %q", got, want)
+	}
+	if calls[0].Dir != "/project" || calls[0].Step != "templui" {
+		t.Errorf("Add() ran in %q with step %q", calls[0].Dir, calls[0].Step)
+	}
+}
+
+func TestExecutor_List(t *testing.T) {
+	runner := processtest.NewRunner()
+	runner.Respond("go tool templui list", processtest.Response{
+		Stdout: "button\ncard\n",
+		Stderr: "checking registry\n",
+	})
+
+	components, err := NewExecutor(runner).List(t.Context(), "/project", "")
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(components) != 2 {
+		t.Fatalf("List() returned %d components, want 2 (stderr should be ignored)", len(components))
+	}
+}
+
+func TestExecutor_Failures(t *testing.T) {
+	tests := []struct {
+		name    string
+		command string
+		run     func(e interfaces.UIExecutor) error
+		wantErr string
+	}{
+		{
+			name:    "version",
+			command: "go tool templui --version",
+			run: func(e interfaces.UIExecutor) error {
+				_, err := e.Version(t.Context(), ".")
+				return err
+			},
+			wantErr: "failed to get templui version",
+		},
+		{
+			name:    "add",
+			command: "go tool templui add",
+			run: func(e interfaces.UIExecutor) error {
+				return e.Add(t.Context(), ".", "", []string{"button"}, false)
+			},
+			wantErr: "failed to add components",
+		},
+		{
+			name:    "list",
+			command: "go tool templui list",
+			run: func(e interfaces.UIExecutor) error {
+				_, err := e.List(t.Context(), ".", "")
+				return err
+			},
+			wantErr: "failed to list components",
+		},
+		{
+			name:    "upgrade",
+			command: "go tool templui upgrade",
+			run: func(e interfaces.UIExecutor) error {
+				return e.Upgrade(t.Context(), ".", "")
+			},
+			wantErr: "failed to upgrade templui",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := processtest.NewRunner()
+			runner.Respond(tt.command, processtest.Response{
+				Stderr: "component not found\n",
+				Err:    errors.New("exit status 1"),
+			})
+
+			err := tt.run(NewExecutor(runner))
+			if err == nil {
+				t.Fatal("expected an error, got nil")
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %q, want it to contain %q", err, tt.wantErr)
+			}
+			if !strings.Contains(err.Error(), "component not found") {
+				t.Errorf("error = %q, want it to include the command output", err)
+			}
+		})
+	}
+}
+
+func TestExecutor_IsAvailable(t *testing.T) {
+	runner := processtest.NewRunner()
+	if !NewExecutor(runner).IsAvailable(t.Context(), ".") {
+		t.Error("IsAvailable() = false, want true when templui --version succeeds")
+	}
+
+	runner.Respond("go tool templui --version", processtest.Response{Err: errors.New("exit status 1")})
+	if NewExecutor(runner).IsAvailable(t.Context(), ".") {
+		t.Error("IsAvailable() = true, want false when templui --version fails")
+	}
+}
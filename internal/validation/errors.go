@@ -23,6 +23,9 @@ var (
 
 	// ErrInvalidEnvPrefix is returned when environment variable prefix is invalid.
 	ErrInvalidEnvPrefix = errors.New("invalid environment variable prefix")
+
+	// ErrInvalidGoVersion is returned when the Go version is not a Go release.
+	ErrInvalidGoVersion = errors.New("invalid Go version")
 )
 
 // ValidationError wraps validation failures with context.
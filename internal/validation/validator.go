@@ -23,6 +23,7 @@ var (
 	projectNameRegex = regexp.MustCompile(`^[a-z0-9_-]+$`)
 	modulePathRegex  = regexp.MustCompile(`^[a-zA-Z0-9._/-]+$`)
 	envPrefixRegex   = regexp.MustCompile(`^[A-Z][A-Z0-9_]*$`)
+	goVersionRegex   = regexp.MustCompile(`^1\.[0-9]+(\.[0-9]+)?$`)
 )
 
 type validatorImpl struct {
@@ -72,6 +73,12 @@ func NewValidator() interfaces.Validator {
 		panic(fmt.Sprintf("failed to register env_prefix validator: %v", err))
 	}
 
+	if err := v.RegisterValidation("go_version", func(fl validator.FieldLevel) bool {
+		return goVersionRegex.MatchString(fl.Field().String())
+	}); err != nil {
+		panic(fmt.Sprintf("failed to register go_version validator: %v", err))
+	}
+
 	return &validatorImpl{
 		validate: v,
 	}
@@ -277,3 +284,32 @@ func (v *validatorImpl) ValidateEnvPrefix(ctx context.Context, prefix string) er
 
 	return nil
 }
+
+func (v *validatorImpl) ValidateGoVersion(ctx context.Context, version string) error {
+	cfg := generator.ProjectConfig{
+		ProjectName:    "placeholder",
+		ModulePath:     "placeholder",
+		DatabaseDriver: "go-libsql",
+		OutputPath:     "placeholder",
+		GoVersion:      version,
+	}
+
+	if version == "" {
+		return &ValidationError{
+			Field:   "go_version",
+			Value:   version,
+			Message: "cannot be empty",
+			Err:     ErrInvalidGoVersion,
+		}
+	}
+	if err := v.validate.StructPartial(cfg, "GoVersion"); err != nil {
+		return &ValidationError{
+			Field:   "go_version",
+			Value:   version,
+			Message: "must be a Go release such as 1.25 or 1.25.1",
+			Err:     ErrInvalidGoVersion,
+		}
+	}
+
+	return nil
+}
@@ -293,6 +293,26 @@ func TestValidateEnvPrefix(t *testing.T) {
 	testStringValidator(t, tests, v.ValidateEnvPrefix, "ValidateEnvPrefix", "env_prefix")
 }
 
+func TestValidateGoVersion(t *testing.T) {
+	v := NewValidator()
+
+	tests := []struct {
+		name    string
+		input   string
+		wantErr bool
+	}{
+		{"valid minor release", "1.25", false},
+		{"valid patch release", "1.24.3", false},
+		{"invalid major version", "2.0", true},
+		{"invalid go prefix", "go1.25", true},
+		{"invalid missing minor", "1", true},
+		{"invalid prerelease", "1.26rc1", true},
+		{"invalid empty", "", true},
+	}
+
+	testStringValidator(t, tests, v.ValidateGoVersion, "ValidateGoVersion", "go_version")
+}
+
 func TestValidationErrorMessages(t *testing.T) {
 	ctx := context.Background()
 	v := NewValidator()
@@ -9,6 +9,7 @@ import (
 
 	"github.com/BurntSushi/toml"
 	"github.com/anomalousventures/tracks/internal/generator"
+	"github.com/anomalousventures/tracks/internal/process"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -30,7 +31,7 @@ func TestAssetPipeline(t *testing.T) {
 		OutputPath:     tmpDir,
 	}
 
-	gen := generator.NewProjectGenerator()
+	gen := generator.NewProjectGenerator(process.NewRunner())
 	ctx := context.Background()
 
 	err := gen.Generate(ctx, cfg)
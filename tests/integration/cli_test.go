@@ -50,7 +50,7 @@ func TestCLINoArgs(t *testing.T) {
 	}
 
 	output := stdout + stderr
-	AssertContains(t, output, "Interactive TUI mode coming in Phase 4")
+	AssertContains(t, output, "Run 'tracks new' to create a project")
 }
 
 func TestCLIInvalidCommand(t *testing.T) {
@@ -12,6 +12,7 @@ import (
 	"testing"
 
 	"github.com/anomalousventures/tracks/internal/generator"
+	"github.com/anomalousventures/tracks/internal/process"
 	"github.com/stretchr/testify/require"
 )
 
@@ -43,100 +44,53 @@ func TestDBRollback_NotInProject(t *testing.T) {
 	AssertContains(t, output, "not in a Tracks project directory")
 }
 
-func TestDBMigrate_UnsupportedDriver_SQLite(t *testing.T) {
-	tmpDir := t.TempDir()
-	projectName := "sqliteapp"
-
-	cfg := generator.ProjectConfig{
-		ProjectName:    projectName,
-		ModulePath:     "github.com/test/sqliteapp",
-		DatabaseDriver: "sqlite3",
-		EnvPrefix:      "APP",
-		InitGit:        false,
-		OutputPath:     tmpDir,
-	}
-
-	gen := generator.NewProjectGenerator()
-	ctx := context.Background()
-	err := gen.Generate(ctx, cfg)
-	require.NoError(t, err, "project generation should succeed")
+func TestDBStatus_DefaultDatabaseURL(t *testing.T) {
+	projectRoot := generateSQLiteProject(t, "sqlite3")
 
-	projectRoot := filepath.Join(tmpDir, projectName)
+	envPath := filepath.Join(projectRoot, ".env")
+	require.NoError(t, os.Remove(envPath))
 
-	stdout, stderr, _ := RunCLIInDirExpectFailure(t, projectRoot, "db", "migrate")
+	stdout, _ := RunCLIInDirExpectSuccess(t, projectRoot, "db", "status")
 
-	output := stdout + stderr
-	AssertContains(t, output, "only supports Postgres")
-	AssertContains(t, output, "make migrate-up")
+	AssertContains(t, stdout, "Source: driver default (APP_DATABASE_URL not set)")
 }
 
-func TestDBRollback_UnsupportedDriver_SQLite(t *testing.T) {
+func TestDBStatus_NotInProject(t *testing.T) {
 	tmpDir := t.TempDir()
-	projectName := "sqliteapp"
-
-	cfg := generator.ProjectConfig{
-		ProjectName:    projectName,
-		ModulePath:     "github.com/test/sqliteapp",
-		DatabaseDriver: "sqlite3",
-		EnvPrefix:      "APP",
-		InitGit:        false,
-		OutputPath:     tmpDir,
-	}
-
-	gen := generator.NewProjectGenerator()
-	ctx := context.Background()
-	err := gen.Generate(ctx, cfg)
-	require.NoError(t, err, "project generation should succeed")
-
-	projectRoot := filepath.Join(tmpDir, projectName)
 
-	stdout, stderr, _ := RunCLIInDirExpectFailure(t, projectRoot, "db", "rollback")
+	stdout, stderr, _ := RunCLIInDirExpectFailure(t, tmpDir, "db", "status")
 
 	output := stdout + stderr
-	AssertContains(t, output, "only supports Postgres")
-	AssertContains(t, output, "make migrate-down")
+	AssertContains(t, output, "not in a Tracks project directory")
 }
 
-func TestDBMigrate_UnsupportedDriver_LibSQL(t *testing.T) {
+func TestDBReset_NotInProject(t *testing.T) {
 	tmpDir := t.TempDir()
-	projectName := "libsqlapp"
-
-	cfg := generator.ProjectConfig{
-		ProjectName:    projectName,
-		ModulePath:     "github.com/test/libsqlapp",
-		DatabaseDriver: "go-libsql",
-		EnvPrefix:      "APP",
-		InitGit:        false,
-		OutputPath:     tmpDir,
-	}
 
-	gen := generator.NewProjectGenerator()
-	ctx := context.Background()
-	err := gen.Generate(ctx, cfg)
-	require.NoError(t, err, "project generation should succeed")
-
-	projectRoot := filepath.Join(tmpDir, projectName)
-
-	stdout, stderr, _ := RunCLIInDirExpectFailure(t, projectRoot, "db", "migrate")
+	stdout, stderr, _ := RunCLIInDirExpectFailure(t, tmpDir, "db", "reset", "--force")
 
 	output := stdout + stderr
-	AssertContains(t, output, "only supports Postgres")
+	AssertContains(t, output, "not in a Tracks project directory")
 }
 
-func TestDBMigrate_MissingDatabaseURL(t *testing.T) {
+// generateSQLiteProject generates a project for a SQLite-based driver with a
+// .env pointing APP_DATABASE_URL at a local database file.
+func generateSQLiteProject(t *testing.T, driver string) string {
+	t.Helper()
+
 	tmpDir := t.TempDir()
-	projectName := "pgapp"
+	projectName := "sqliteapp"
 
 	cfg := generator.ProjectConfig{
 		ProjectName:    projectName,
-		ModulePath:     "github.com/test/pgapp",
-		DatabaseDriver: "postgres",
+		ModulePath:     "github.com/test/sqliteapp",
+		DatabaseDriver: driver,
 		EnvPrefix:      "APP",
 		InitGit:        false,
 		OutputPath:     tmpDir,
 	}
 
-	gen := generator.NewProjectGenerator()
+	gen := generator.NewProjectGenerator(process.NewRunner())
 	ctx := context.Background()
 	err := gen.Generate(ctx, cfg)
 	require.NoError(t, err, "project generation should succeed")
@@ -144,82 +98,33 @@ func TestDBMigrate_MissingDatabaseURL(t *testing.T) {
 	projectRoot := filepath.Join(tmpDir, projectName)
 
 	envPath := filepath.Join(projectRoot, ".env")
-	_ = os.Remove(envPath)
+	require.NoError(t, os.WriteFile(envPath, []byte("APP_DATABASE_URL=file:./data/sqliteapp.db\n"), 0600))
 
-	stdout, stderr, _ := RunCLIInDirExpectFailure(t, projectRoot, "db", "migrate")
-
-	output := stdout + stderr
-	AssertContains(t, output, "DATABASE_URL")
-}
-
-func TestDBStatus_NotInProject(t *testing.T) {
-	tmpDir := t.TempDir()
-
-	stdout, stderr, _ := RunCLIInDirExpectFailure(t, tmpDir, "db", "status")
-
-	output := stdout + stderr
-	AssertContains(t, output, "not in a Tracks project directory")
+	return projectRoot
 }
 
-func TestDBStatus_UnsupportedDriver_SQLite(t *testing.T) {
-	tmpDir := t.TempDir()
-	projectName := "sqlitestatusapp"
-
-	cfg := generator.ProjectConfig{
-		ProjectName:    projectName,
-		ModulePath:     "github.com/test/sqlitestatusapp",
-		DatabaseDriver: "sqlite3",
-		EnvPrefix:      "APP",
-		InitGit:        false,
-		OutputPath:     tmpDir,
-	}
-
-	gen := generator.NewProjectGenerator()
-	ctx := context.Background()
-	err := gen.Generate(ctx, cfg)
-	require.NoError(t, err, "project generation should succeed")
-
-	projectRoot := filepath.Join(tmpDir, projectName)
+func TestDBCommands_SQLite(t *testing.T) {
+	for _, driver := range []string{"sqlite3", "go-libsql"} {
+		t.Run(driver, func(t *testing.T) {
+			projectRoot := generateSQLiteProject(t, driver)
 
-	stdout, stderr, _ := RunCLIInDirExpectFailure(t, projectRoot, "db", "status")
+			stdout, _ := RunCLIInDirExpectSuccess(t, projectRoot, "db", "status")
+			AssertContains(t, stdout, "Source: APP_DATABASE_URL in .env")
+			AssertContains(t, stdout, "initial_schema")
+			AssertContains(t, stdout, "pending")
 
-	output := stdout + stderr
-	AssertContains(t, output, "only supports Postgres")
-	AssertContains(t, output, "make migrate-status")
-}
+			stdout, _ = RunCLIInDirExpectSuccess(t, projectRoot, "db", "migrate")
+			AssertContains(t, stdout, "Successfully applied 1 migration(s)")
+			require.FileExists(t, filepath.Join(projectRoot, "data", "sqliteapp.db"))
 
-func TestDBReset_NotInProject(t *testing.T) {
-	tmpDir := t.TempDir()
+			stdout, _ = RunCLIInDirExpectSuccess(t, projectRoot, "db", "migrate")
+			AssertContains(t, stdout, "No pending migrations")
 
-	stdout, stderr, _ := RunCLIInDirExpectFailure(t, tmpDir, "db", "reset", "--force")
+			stdout, _ = RunCLIInDirExpectSuccess(t, projectRoot, "db", "rollback")
+			AssertContains(t, stdout, "initial_schema")
 
-	output := stdout + stderr
-	AssertContains(t, output, "not in a Tracks project directory")
-}
-
-func TestDBReset_UnsupportedDriver_SQLite(t *testing.T) {
-	tmpDir := t.TempDir()
-	projectName := "sqliteresetapp"
-
-	cfg := generator.ProjectConfig{
-		ProjectName:    projectName,
-		ModulePath:     "github.com/test/sqliteresetapp",
-		DatabaseDriver: "sqlite3",
-		EnvPrefix:      "APP",
-		InitGit:        false,
-		OutputPath:     tmpDir,
+			stdout, _ = RunCLIInDirExpectSuccess(t, projectRoot, "db", "reset", "--force")
+			AssertNotContains(t, stdout, "only supports Postgres")
+		})
 	}
-
-	gen := generator.NewProjectGenerator()
-	ctx := context.Background()
-	err := gen.Generate(ctx, cfg)
-	require.NoError(t, err, "project generation should succeed")
-
-	projectRoot := filepath.Join(tmpDir, projectName)
-
-	stdout, stderr, _ := RunCLIInDirExpectFailure(t, projectRoot, "db", "reset", "--force")
-
-	output := stdout + stderr
-	AssertContains(t, output, "only supports Postgres")
-	AssertContains(t, output, "make migrate-reset")
 }
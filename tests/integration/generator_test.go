@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/anomalousventures/tracks/internal/generator"
+	"github.com/anomalousventures/tracks/internal/process"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -56,7 +57,7 @@ func TestGenerateFullProject(t *testing.T) {
 				OutputPath:     tmpDir,
 			}
 
-			gen := generator.NewProjectGenerator()
+			gen := generator.NewProjectGenerator(process.NewRunner())
 			ctx := context.Background()
 
 			err := gen.Validate(cfg)
@@ -75,6 +76,7 @@ func TestGenerateFullProject(t *testing.T) {
 				".golangci.yml",
 				".mockery.yaml",
 				".tracks.yaml",
+				".tracks/manifest.json",
 				".env.example",
 				".env",
 				"Makefile",
@@ -274,7 +276,7 @@ func TestGenerateFullProject_CustomModuleName(t *testing.T) {
 		OutputPath:     tmpDir,
 	}
 
-	gen := generator.NewProjectGenerator()
+	gen := generator.NewProjectGenerator(process.NewRunner())
 	ctx := context.Background()
 
 	err := gen.Generate(ctx, cfg)
@@ -309,7 +311,7 @@ func TestGenerateFullProject_DirectoryAlreadyExists(t *testing.T) {
 		OutputPath:     tmpDir,
 	}
 
-	gen := generator.NewProjectGenerator()
+	gen := generator.NewProjectGenerator(process.NewRunner())
 
 	err = gen.Validate(cfg)
 	assert.Error(t, err, "validation should fail when directory exists")
@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/anomalousventures/tracks/internal/generator"
+	"github.com/anomalousventures/tracks/internal/process"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -67,7 +68,7 @@ func runE2ETest(t *testing.T, driver string) {
 	}
 
 	t.Log("1. Generating project...")
-	gen := generator.NewProjectGenerator()
+	gen := generator.NewProjectGenerator(process.NewRunner())
 	ctx := context.Background()
 	err := gen.Generate(ctx, cfg)
 	require.NoError(t, err, "project generation should succeed")
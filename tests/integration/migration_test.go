@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/anomalousventures/tracks/internal/generator"
+	"github.com/anomalousventures/tracks/internal/process"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -41,7 +42,7 @@ func TestMigrationFilesGenerated(t *testing.T) {
 				OutputPath:     tmpDir,
 			}
 
-			gen := generator.NewProjectGenerator()
+			gen := generator.NewProjectGenerator(process.NewRunner())
 			ctx := context.Background()
 
 			err := gen.Generate(ctx, cfg)
@@ -115,7 +116,7 @@ func TestMigrationDriverSpecificContent(t *testing.T) {
 				OutputPath:     tmpDir,
 			}
 
-			gen := generator.NewProjectGenerator()
+			gen := generator.NewProjectGenerator(process.NewRunner())
 			err := gen.Generate(context.Background(), cfg)
 			require.NoError(t, err)
 
@@ -164,7 +165,7 @@ func TestMigrationBothDirectoriesCreated(t *testing.T) {
 		OutputPath:     tmpDir,
 	}
 
-	gen := generator.NewProjectGenerator()
+	gen := generator.NewProjectGenerator(process.NewRunner())
 	err := gen.Generate(context.Background(), cfg)
 	require.NoError(t, err)
 
@@ -209,7 +210,7 @@ func TestMigrationTimestampFormat(t *testing.T) {
 		OutputPath:     tmpDir,
 	}
 
-	gen := generator.NewProjectGenerator()
+	gen := generator.NewProjectGenerator(process.NewRunner())
 	err := gen.Generate(context.Background(), cfg)
 	require.NoError(t, err)
 
@@ -274,7 +275,7 @@ func TestMigrateCLIGenerated(t *testing.T) {
 				OutputPath:     tmpDir,
 			}
 
-			gen := generator.NewProjectGenerator()
+			gen := generator.NewProjectGenerator(process.NewRunner())
 			err := gen.Generate(context.Background(), cfg)
 			require.NoError(t, err)
 
@@ -326,7 +327,7 @@ func TestMakefileMigrationTargetsGenerated(t *testing.T) {
 				OutputPath:     tmpDir,
 			}
 
-			gen := generator.NewProjectGenerator()
+			gen := generator.NewProjectGenerator(process.NewRunner())
 			err := gen.Generate(context.Background(), cfg)
 			require.NoError(t, err)
 
@@ -363,7 +364,7 @@ func TestMigrateCLIDirectoryCreated(t *testing.T) {
 		OutputPath:     tmpDir,
 	}
 
-	gen := generator.NewProjectGenerator()
+	gen := generator.NewProjectGenerator(process.NewRunner())
 	err := gen.Generate(context.Background(), cfg)
 	require.NoError(t, err)
 
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/anomalousventures/tracks/internal/generator"
+	"github.com/anomalousventures/tracks/internal/process"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -32,7 +33,7 @@ func TestHealthQueryGenerated(t *testing.T) {
 				OutputPath:     tmpDir,
 			}
 
-			gen := generator.NewProjectGenerator()
+			gen := generator.NewProjectGenerator(process.NewRunner())
 			err := gen.Generate(context.Background(), cfg)
 			require.NoError(t, err, "generation should succeed")
 
@@ -65,7 +66,7 @@ func TestGeneratedDirectoryCreated(t *testing.T) {
 		OutputPath:     tmpDir,
 	}
 
-	gen := generator.NewProjectGenerator()
+	gen := generator.NewProjectGenerator(process.NewRunner())
 	err := gen.Generate(context.Background(), cfg)
 	require.NoError(t, err, "generation should succeed")
 
@@ -118,7 +119,7 @@ func TestSqlcConfigGenerated(t *testing.T) {
 				OutputPath:     tmpDir,
 			}
 
-			gen := generator.NewProjectGenerator()
+			gen := generator.NewProjectGenerator(process.NewRunner())
 			err := gen.Generate(context.Background(), cfg)
 			require.NoError(t, err, "generation should succeed")
 
@@ -153,7 +154,7 @@ func TestQueriesDirectoryStructure(t *testing.T) {
 		OutputPath:     tmpDir,
 	}
 
-	gen := generator.NewProjectGenerator()
+	gen := generator.NewProjectGenerator(process.NewRunner())
 	err := gen.Generate(context.Background(), cfg)
 	require.NoError(t, err, "generation should succeed")
 
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/anomalousventures/tracks/internal/generator"
+	"github.com/anomalousventures/tracks/internal/process"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -45,7 +46,7 @@ func TestTemplUIInitDuringGeneration(t *testing.T) {
 				OutputPath:     tmpDir,
 			}
 
-			gen := generator.NewProjectGenerator()
+			gen := generator.NewProjectGenerator(process.NewRunner())
 			ctx := context.Background()
 
 			err := gen.Generate(ctx, cfg)
@@ -133,7 +134,7 @@ func TestTemplUIPagesRender(t *testing.T) {
 				OutputPath:     tmpDir,
 			}
 
-			gen := generator.NewProjectGenerator()
+			gen := generator.NewProjectGenerator(process.NewRunner())
 			ctx := context.Background()
 
 			err := gen.Generate(ctx, cfg)
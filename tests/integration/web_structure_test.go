@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/anomalousventures/tracks/internal/generator"
+	"github.com/anomalousventures/tracks/internal/process"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -29,7 +30,7 @@ func TestWebDirectoryStructure(t *testing.T) {
 		OutputPath:     tmpDir,
 	}
 
-	gen := generator.NewProjectGenerator()
+	gen := generator.NewProjectGenerator(process.NewRunner())
 	ctx := context.Background()
 
 	err := gen.Validate(cfg)
@@ -181,7 +182,7 @@ func TestWebDirectoryWithDifferentProjectNames(t *testing.T) {
 				OutputPath:     tmpDir,
 			}
 
-			gen := generator.NewProjectGenerator()
+			gen := generator.NewProjectGenerator(process.NewRunner())
 			ctx := context.Background()
 
 			err := gen.Generate(ctx, cfg)
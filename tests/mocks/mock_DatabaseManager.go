@@ -188,6 +188,50 @@ func (_c *MockDatabaseManager_GetDatabaseURL_Call) RunAndReturn(run func() strin
 	return _c
 }
 
+// GetDatabaseURLSource provides a mock function for the type MockDatabaseManager
+func (_mock *MockDatabaseManager) GetDatabaseURLSource() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatabaseURLSource")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockDatabaseManager_GetDatabaseURLSource_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatabaseURLSource'
+type MockDatabaseManager_GetDatabaseURLSource_Call struct {
+	*mock.Call
+}
+
+// GetDatabaseURLSource is a helper method to define mock.On call
+func (_e *MockDatabaseManager_Expecter) GetDatabaseURLSource() *MockDatabaseManager_GetDatabaseURLSource_Call {
+	return &MockDatabaseManager_GetDatabaseURLSource_Call{Call: _e.mock.On("GetDatabaseURLSource")}
+}
+
+func (_c *MockDatabaseManager_GetDatabaseURLSource_Call) Run(run func()) *MockDatabaseManager_GetDatabaseURLSource_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockDatabaseManager_GetDatabaseURLSource_Call) Return(s string) *MockDatabaseManager_GetDatabaseURLSource_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockDatabaseManager_GetDatabaseURLSource_Call) RunAndReturn(run func() string) *MockDatabaseManager_GetDatabaseURLSource_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetDriver provides a mock function for the type MockDatabaseManager
 func (_mock *MockDatabaseManager) GetDriver() string {
 	ret := _mock.Called()
@@ -107,3 +107,43 @@ func (_c *MockProgress_Increment_Call) RunAndReturn(run func(n int64)) *MockProg
 	_c.Run(run)
 	return _c
 }
+
+// Status provides a mock function for the type MockProgress
+func (_mock *MockProgress) Status(s string) {
+	_mock.Called(s)
+	return
+}
+
+// MockProgress_Status_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Status'
+type MockProgress_Status_Call struct {
+	*mock.Call
+}
+
+// Status is a helper method to define mock.On call
+//   - s string
+func (_e *MockProgress_Expecter) Status(s interface{}) *MockProgress_Status_Call {
+	return &MockProgress_Status_Call{Call: _e.mock.On("Status", s)}
+}
+
+func (_c *MockProgress_Status_Call) Run(run func(s string)) *MockProgress_Status_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProgress_Status_Call) Return() *MockProgress_Status_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockProgress_Status_Call) RunAndReturn(run func(s string)) *MockProgress_Status_Call {
+	_c.Run(run)
+	return _c
+}
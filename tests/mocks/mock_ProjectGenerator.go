@@ -7,6 +7,7 @@ package mocks
 import (
 	"context"
 
+	"github.com/anomalousventures/tracks/internal/cli/interfaces"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -94,6 +95,142 @@ func (_c *MockProjectGenerator_Generate_Call) RunAndReturn(run func(ctx context.
 	return _c
 }
 
+// LoadStarter provides a mock function for the type MockProjectGenerator
+func (_mock *MockProjectGenerator) LoadStarter(ctx context.Context, source string) (*interfaces.StarterKit, error) {
+	ret := _mock.Called(ctx, source)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoadStarter")
+	}
+
+	var r0 *interfaces.StarterKit
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*interfaces.StarterKit, error)); ok {
+		return returnFunc(ctx, source)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *interfaces.StarterKit); ok {
+		r0 = returnFunc(ctx, source)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*interfaces.StarterKit)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, source)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectGenerator_LoadStarter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoadStarter'
+type MockProjectGenerator_LoadStarter_Call struct {
+	*mock.Call
+}
+
+// LoadStarter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - source string
+func (_e *MockProjectGenerator_Expecter) LoadStarter(ctx interface{}, source interface{}) *MockProjectGenerator_LoadStarter_Call {
+	return &MockProjectGenerator_LoadStarter_Call{Call: _e.mock.On("LoadStarter", ctx, source)}
+}
+
+func (_c *MockProjectGenerator_LoadStarter_Call) Run(run func(ctx context.Context, source string)) *MockProjectGenerator_LoadStarter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectGenerator_LoadStarter_Call) Return(starterKit *interfaces.StarterKit, err error) *MockProjectGenerator_LoadStarter_Call {
+	_c.Call.Return(starterKit, err)
+	return _c
+}
+
+func (_c *MockProjectGenerator_LoadStarter_Call) RunAndReturn(run func(ctx context.Context, source string) (*interfaces.StarterKit, error)) *MockProjectGenerator_LoadStarter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Plan provides a mock function for the type MockProjectGenerator
+func (_mock *MockProjectGenerator) Plan(ctx context.Context, cfg any) (*interfaces.ProjectPlan, error) {
+	ret := _mock.Called(ctx, cfg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Plan")
+	}
+
+	var r0 *interfaces.ProjectPlan
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, any) (*interfaces.ProjectPlan, error)); ok {
+		return returnFunc(ctx, cfg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, any) *interfaces.ProjectPlan); ok {
+		r0 = returnFunc(ctx, cfg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*interfaces.ProjectPlan)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, any) error); ok {
+		r1 = returnFunc(ctx, cfg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectGenerator_Plan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Plan'
+type MockProjectGenerator_Plan_Call struct {
+	*mock.Call
+}
+
+// Plan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cfg any
+func (_e *MockProjectGenerator_Expecter) Plan(ctx interface{}, cfg interface{}) *MockProjectGenerator_Plan_Call {
+	return &MockProjectGenerator_Plan_Call{Call: _e.mock.On("Plan", ctx, cfg)}
+}
+
+func (_c *MockProjectGenerator_Plan_Call) Run(run func(ctx context.Context, cfg any)) *MockProjectGenerator_Plan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 any
+		if args[1] != nil {
+			arg1 = args[1].(any)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectGenerator_Plan_Call) Return(projectPlan *interfaces.ProjectPlan, err error) *MockProjectGenerator_Plan_Call {
+	_c.Call.Return(projectPlan, err)
+	return _c
+}
+
+func (_c *MockProjectGenerator_Plan_Call) RunAndReturn(run func(ctx context.Context, cfg any) (*interfaces.ProjectPlan, error)) *MockProjectGenerator_Plan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Validate provides a mock function for the type MockProjectGenerator
 func (_mock *MockProjectGenerator) Validate(cfg any) error {
 	ret := _mock.Called(cfg)
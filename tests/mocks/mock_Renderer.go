@@ -133,6 +133,46 @@ func (_c *MockRenderer_Progress_Call) RunAndReturn(run func(spec interfaces.Prog
 	return _c
 }
 
+// Result provides a mock function for the type MockRenderer
+func (_mock *MockRenderer) Result(res interfaces.Result) {
+	_mock.Called(res)
+	return
+}
+
+// MockRenderer_Result_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Result'
+type MockRenderer_Result_Call struct {
+	*mock.Call
+}
+
+// Result is a helper method to define mock.On call
+//   - res interfaces.Result
+func (_e *MockRenderer_Expecter) Result(res interface{}) *MockRenderer_Result_Call {
+	return &MockRenderer_Result_Call{Call: _e.mock.On("Result", res)}
+}
+
+func (_c *MockRenderer_Result_Call) Run(run func(res interfaces.Result)) *MockRenderer_Result_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 interfaces.Result
+		if args[0] != nil {
+			arg0 = args[0].(interfaces.Result)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRenderer_Result_Call) Return() *MockRenderer_Result_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockRenderer_Result_Call) RunAndReturn(run func(res interfaces.Result)) *MockRenderer_Result_Call {
+	_c.Run(run)
+	return _c
+}
+
 // Section provides a mock function for the type MockRenderer
 func (_mock *MockRenderer) Section(sec interfaces.Section) {
 	_mock.Called(sec)
@@ -173,6 +213,52 @@ func (_c *MockRenderer_Section_Call) RunAndReturn(run func(sec interfaces.Sectio
 	return _c
 }
 
+// Stream provides a mock function for the type MockRenderer
+func (_mock *MockRenderer) Stream(source string, line string) {
+	_mock.Called(source, line)
+	return
+}
+
+// MockRenderer_Stream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stream'
+type MockRenderer_Stream_Call struct {
+	*mock.Call
+}
+
+// Stream is a helper method to define mock.On call
+//   - source string
+//   - line string
+func (_e *MockRenderer_Expecter) Stream(source interface{}, line interface{}) *MockRenderer_Stream_Call {
+	return &MockRenderer_Stream_Call{Call: _e.mock.On("Stream", source, line)}
+}
+
+func (_c *MockRenderer_Stream_Call) Run(run func(source string, line string)) *MockRenderer_Stream_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRenderer_Stream_Call) Return() *MockRenderer_Stream_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockRenderer_Stream_Call) RunAndReturn(run func(source string, line string)) *MockRenderer_Stream_Call {
+	_c.Run(run)
+	return _c
+}
+
 // Table provides a mock function for the type MockRenderer
 func (_mock *MockRenderer) Table(t interfaces.Table) {
 	_mock.Called(t)
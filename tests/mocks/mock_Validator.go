@@ -208,6 +208,63 @@ func (_c *MockValidator_ValidateEnvPrefix_Call) RunAndReturn(run func(ctx contex
 	return _c
 }
 
+// ValidateGoVersion provides a mock function for the type MockValidator
+func (_mock *MockValidator) ValidateGoVersion(ctx context.Context, version string) error {
+	ret := _mock.Called(ctx, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateGoVersion")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, version)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockValidator_ValidateGoVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateGoVersion'
+type MockValidator_ValidateGoVersion_Call struct {
+	*mock.Call
+}
+
+// ValidateGoVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - version string
+func (_e *MockValidator_Expecter) ValidateGoVersion(ctx interface{}, version interface{}) *MockValidator_ValidateGoVersion_Call {
+	return &MockValidator_ValidateGoVersion_Call{Call: _e.mock.On("ValidateGoVersion", ctx, version)}
+}
+
+func (_c *MockValidator_ValidateGoVersion_Call) Run(run func(ctx context.Context, version string)) *MockValidator_ValidateGoVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockValidator_ValidateGoVersion_Call) Return(err error) *MockValidator_ValidateGoVersion_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockValidator_ValidateGoVersion_Call) RunAndReturn(run func(ctx context.Context, version string) error) *MockValidator_ValidateGoVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ValidateModulePath provides a mock function for the type MockValidator
 func (_mock *MockValidator) ValidateModulePath(ctx context.Context, path string) error {
 	ret := _mock.Called(ctx, path)
@@ -65,7 +65,7 @@ const sidebars = {
         {
           type: 'category',
           label: 'Commands',
-          items: ['cli/commands', 'cli/new', 'cli/db', 'cli/version', 'cli/help'],
+          items: ['cli/commands', 'cli/new', 'cli/init', 'cli/db', 'cli/upgrade', 'cli/status', 'cli/templates', 'cli/version', 'cli/help'],
         },
       ],
     },